@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
@@ -59,6 +60,12 @@ type AlwaysGetAdapter[ListInput InputType, ListOutput OutputType, GetInput Input
 	// isn't
 	AlwaysSearchARNs bool
 
+	// ARN resource types that Search queries should use the automatic ARN
+	// resolver for, falling back to the `SearchInputMapper` for everything
+	// else. This is for adapters that can be searched by the ARNs of other
+	// resources, where `AlwaysSearchARNs` would catch those too
+	SearchARNTypes []string
+
 	// Maps search terms from an SDP Search request into the relevant input for
 	// the ListFunc. If this is not set, Search() will handle ARNs like most AWS
 	// adapters. Note that this and `SearchGetInputMapper` are mutually exclusive
@@ -302,13 +309,11 @@ func (s *AlwaysGetAdapter[ListInput, ListOutput, GetInput, GetOutput, ClientStru
 	if s.SearchInputMapper == nil && s.SearchGetInputMapper == nil {
 		s.SearchARN(ctx, scope, query, ignoreCache, stream)
 	} else {
+		a, err := ParseARN(query)
+
 		// If we should always look for ARNs first, do that
-		if s.AlwaysSearchARNs {
-			if _, err := ParseARN(query); err == nil {
-				s.SearchARN(ctx, scope, query, ignoreCache, stream)
-			} else {
-				s.SearchCustom(ctx, scope, query, ignoreCache, stream)
-			}
+		if err == nil && (s.AlwaysSearchARNs || slices.Contains(s.SearchARNTypes, a.Type())) {
+			s.SearchARN(ctx, scope, query, ignoreCache, stream)
 		} else {
 			s.SearchCustom(ctx, scope, query, ignoreCache, stream)
 		}
@@ -438,6 +438,86 @@ func TestAlwaysGetSourceSearch(t *testing.T) {
 			}
 		})
 	})
+	t.Run("with Custom & ARN type search", func(t *testing.T) {
+		var searchMapperCalled bool
+
+		lgs := AlwaysGetAdapter[string, string, string, string, struct{}, struct{}]{
+			ItemType:       "test",
+			AccountID:      "foo",
+			Region:         "bar",
+			Client:         struct{}{},
+			MaxParallel:    MaxParallel(1),
+			ListInput:      "",
+			SearchARNTypes: []string{"type"},
+			SearchInputMapper: func(scope, query string) (string, error) {
+				searchMapperCalled = true
+				return query, nil
+			},
+			ListFuncPaginatorBuilder: func(client struct{}, input string) Paginator[string, struct{}] {
+				return &TestPaginator{DataFunc: func() string {
+					return "foo"
+				}}
+			},
+			ListFuncOutputMapper: func(output, input string) ([]string, error) {
+				return []string{}, nil
+			},
+			GetFunc: func(ctx context.Context, client struct{}, scope, input string) (*sdp.Item, error) {
+				if input == "foo.bar.id" {
+					return &sdp.Item{}, nil
+				} else {
+					return nil, sdp.NewQueryError(errors.New("bad query details"))
+				}
+			},
+			GetInputMapper: func(scope, query string) string {
+				return scope + "." + query
+			},
+		}
+
+		t.Run("ARN of a listed type", func(t *testing.T) {
+			searchMapperCalled = false
+			items := make([]*sdp.Item, 0)
+			errs := make([]error, 0)
+			stream := discovery.NewQueryResultStream(
+				func(item *sdp.Item) {
+					items = append(items, item)
+				},
+				func(err error) {
+					errs = append(errs, err)
+				},
+			)
+
+			lgs.SearchStream(context.Background(), "foo.bar", "arn:aws:service:bar:foo:type/id", false, stream)
+			stream.Close()
+
+			if len(errs) != 0 {
+				t.Error(errs[0])
+			}
+
+			if len(items) != 1 {
+				t.Errorf("expected 1 item, got %v", len(items))
+			}
+
+			if searchMapperCalled {
+				t.Error("expected the ARN to be resolved without the search mapper")
+			}
+		})
+
+		t.Run("ARN of another type", func(t *testing.T) {
+			searchMapperCalled = false
+			stream := discovery.NewQueryResultStream(
+				func(item *sdp.Item) {},
+				func(err error) {},
+			)
+
+			lgs.SearchStream(context.Background(), "foo.bar", "arn:aws:otherservice:bar:foo:othertype/id", false, stream)
+			stream.Close()
+
+			if !searchMapperCalled {
+				t.Error("expected the search mapper to be used for other ARNs")
+			}
+		})
+	})
+
 	t.Run("with custom search logic", func(t *testing.T) {
 		var searchMapperCalled bool
 
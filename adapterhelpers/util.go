@@ -44,6 +44,24 @@ func ParseScope(scope string) (string, string, error) {
 	return sections[0], sections[1], nil
 }
 
+// PartitionFromRegion Returns the ARN partition that a region belongs to e.g.
+// "aws-cn" for cn-north-1. This is needed when building ARNs for APIs that only
+// return names
+func PartitionFromRegion(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	case strings.HasPrefix(region, "us-isob-"):
+		return "aws-iso-b"
+	case strings.HasPrefix(region, "us-iso-"):
+		return "aws-iso"
+	default:
+		return "aws"
+	}
+}
+
 // Returns whether or not it makes sense to retry the error. This can be used to
 // decide whether we should cache the error or not. Errors such as the item
 // being not found, or the scope not existing should not be retried for example
@@ -256,3 +256,19 @@ func TestIAMWildcardMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestPartitionFromRegion(t *testing.T) {
+	tests := map[string]string{
+		"eu-west-2":      "aws",
+		"cn-north-1":     "aws-cn",
+		"us-gov-west-1":  "aws-us-gov",
+		"us-iso-east-1":  "aws-iso",
+		"us-isob-east-1": "aws-iso-b",
+	}
+
+	for region, expected := range tests {
+		if partition := PartitionFromRegion(region); partition != expected {
+			t.Errorf("expected partition %v for %v, got %v", expected, region, partition)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func apprunnerAutoScalingConfigurationGetFunc(ctx context.Context, client AppRunnerClient, scope string, input *apprunner.DescribeAutoScalingConfigurationInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "no input provided",
+		}
+	}
+
+	out, err := client.DescribeAutoScalingConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := out.AutoScalingConfiguration
+
+	if config == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "auto scaling configuration was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if config.AutoScalingConfigurationArn != nil {
+		attributes.Set("AutoScalingConfigurationFullName", apprunnerFullName(*config.AutoScalingConfigurationArn))
+	}
+
+	item := sdp.Item{
+		Type:            "apprunner-auto-scaling-configuration",
+		UniqueAttribute: "AutoScalingConfigurationFullName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// The default configuration is owned by AWS and can't be tagged
+	if config.AutoScalingConfigurationArn != nil && (config.IsDefault == nil || !*config.IsDefault) {
+		tags, err := apprunnerListTags(ctx, client, *config.AutoScalingConfigurationArn)
+
+		if err != nil {
+			tags = adapterhelpers.HandleTagsError(ctx, err)
+		}
+
+		item.Tags = tags
+	}
+
+	if config.Status == types.AutoScalingConfigurationStatusActive {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	return &item, nil
+}
+
+func NewAppRunnerAutoScalingConfigurationAdapter(client AppRunnerClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*apprunner.ListAutoScalingConfigurationsInput, *apprunner.ListAutoScalingConfigurationsOutput, *apprunner.DescribeAutoScalingConfigurationInput, *apprunner.DescribeAutoScalingConfigurationOutput, AppRunnerClient, *apprunner.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*apprunner.ListAutoScalingConfigurationsInput, *apprunner.ListAutoScalingConfigurationsOutput, *apprunner.DescribeAutoScalingConfigurationInput, *apprunner.DescribeAutoScalingConfigurationOutput, AppRunnerClient, *apprunner.Options]{
+		ItemType:        "apprunner-auto-scaling-configuration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apprunnerAutoScalingConfigurationAdapterMetadata,
+		GetFunc:         apprunnerAutoScalingConfigurationGetFunc,
+		GetInputMapper: func(scope, query string) *apprunner.DescribeAutoScalingConfigurationInput {
+			// We are using a custom id of {name}/{revision}/{id} e.g.
+			// DefaultConfiguration/1/00000000000000000000000000000001
+			arn, err := apprunnerARN(scope, "autoscalingconfiguration", query)
+
+			if err != nil {
+				return nil
+			}
+
+			return &apprunner.DescribeAutoScalingConfigurationInput{
+				AutoScalingConfigurationArn: &arn,
+			}
+		},
+		ListInput: &apprunner.ListAutoScalingConfigurationsInput{},
+		ListFuncPaginatorBuilder: func(client AppRunnerClient, input *apprunner.ListAutoScalingConfigurationsInput) adapterhelpers.Paginator[*apprunner.ListAutoScalingConfigurationsOutput, *apprunner.Options] {
+			return apprunner.NewListAutoScalingConfigurationsPaginator(client, input)
+		},
+		// ARNs are still resolved directly so that services can link to
+		// a specific revision
+		AlwaysSearchARNs: true,
+		SearchInputMapper: func(scope, query string) (*apprunner.ListAutoScalingConfigurationsInput, error) {
+			// Custom search by name, which returns all revisions
+			return &apprunner.ListAutoScalingConfigurationsInput{
+				AutoScalingConfigurationName: &query,
+			}, nil
+		},
+		ListFuncOutputMapper: func(output *apprunner.ListAutoScalingConfigurationsOutput, _ *apprunner.ListAutoScalingConfigurationsInput) ([]*apprunner.DescribeAutoScalingConfigurationInput, error) {
+			inputs := make([]*apprunner.DescribeAutoScalingConfigurationInput, 0, len(output.AutoScalingConfigurationSummaryList))
+
+			for _, summary := range output.AutoScalingConfigurationSummaryList {
+				inputs = append(inputs, &apprunner.DescribeAutoScalingConfigurationInput{
+					AutoScalingConfigurationArn: summary.AutoScalingConfigurationArn,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var apprunnerAutoScalingConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apprunner-auto-scaling-configuration",
+	DescriptiveName: "App Runner Auto Scaling Configuration",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an auto scaling configuration by full name ({name}/{revision}/{id})",
+		ListDescription:   "List all auto scaling configurations",
+		SearchDescription: "Search for auto scaling configurations by name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apprunner_auto_scaling_configuration_version.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
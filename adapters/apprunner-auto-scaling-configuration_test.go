@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAppRunnerAutoScalingConfigurationGetFunc(t *testing.T) {
+	client := AppRunnerTestClient{
+		DescribeAutoScalingConfigurationOutput: &apprunner.DescribeAutoScalingConfigurationOutput{
+			AutoScalingConfiguration: &types.AutoScalingConfiguration{
+				AutoScalingConfigurationArn:      adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:autoscalingconfiguration/high-traffic/2/6e2d1e3c1a4b4c7f9d2b0a8e5f3c1d7b"),
+				AutoScalingConfigurationName:     adapterhelpers.PtrString("high-traffic"),
+				AutoScalingConfigurationRevision: adapterhelpers.PtrInt32(2),
+				Status:                           types.AutoScalingConfigurationStatusActive,
+				Latest:                           adapterhelpers.PtrBool(true),
+				IsDefault:                        adapterhelpers.PtrBool(false),
+				HasAssociatedService:             adapterhelpers.PtrBool(true),
+				MaxConcurrency:                   adapterhelpers.PtrInt32(100),
+				MaxSize:                          adapterhelpers.PtrInt32(25),
+				MinSize:                          adapterhelpers.PtrInt32(2),
+				CreatedAt:                        adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+		ListTagsForResourceOutput: &apprunner.ListTagsForResourceOutput{
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("billing"),
+				},
+			},
+		},
+	}
+
+	item, err := apprunnerAutoScalingConfigurationGetFunc(context.Background(), client, "123456789012.eu-west-1", &apprunner.DescribeAutoScalingConfigurationInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "high-traffic/2/6e2d1e3c1a4b4c7f9d2b0a8e5f3c1d7b" {
+		t.Errorf("expected unique attribute value high-traffic/2/6e2d1e3c1a4b4c7f9d2b0a8e5f3c1d7b, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "billing" {
+		t.Errorf("expected tag team=billing, got %v", item.GetTags())
+	}
+}
+
+func TestNewAppRunnerAutoScalingConfigurationAdapter(t *testing.T) {
+	client, account, region := apprunnerGetAutoConfig(t)
+
+	adapter := NewAppRunnerAutoScalingConfigurationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,221 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func apprunnerServiceGetFunc(ctx context.Context, client AppRunnerClient, scope string, input *apprunner.DescribeServiceInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "no input provided",
+		}
+	}
+
+	out, err := client.DescribeService(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	service := out.Service
+
+	if service == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "service was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(service)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if service.ServiceArn != nil {
+		attributes.Set("ServiceFullName", apprunnerFullName(*service.ServiceArn))
+	}
+
+	item := sdp.Item{
+		Type:            "apprunner-service",
+		UniqueAttribute: "ServiceFullName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if service.ServiceArn != nil {
+		tags, err := apprunnerListTags(ctx, client, *service.ServiceArn)
+
+		if err != nil {
+			tags = adapterhelpers.HandleTagsError(ctx, err)
+		}
+
+		item.Tags = tags
+	}
+
+	switch service.Status {
+	case types.ServiceStatusRunning:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ServiceStatusOperationInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ServiceStatusPaused:
+		// The service exists but isn't serving requests
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.ServiceStatusCreateFailed, types.ServiceStatusDeleteFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case types.ServiceStatusDeleted:
+		item.Health = nil
+	}
+
+	if service.ServiceUrl != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *service.ServiceUrl,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if source := service.SourceConfiguration; source != nil {
+		if source.ImageRepository != nil && source.ImageRepository.ImageIdentifier != nil {
+			// Public ECR images and source code repositories aren't linked
+			if link := batchImageLink(*source.ImageRepository.ImageIdentifier); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if source.AuthenticationConfiguration != nil && source.AuthenticationConfiguration.AccessRoleArn != nil {
+			// The role is used to pull the image from ECR
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*source.AuthenticationConfiguration.AccessRoleArn, scope))
+		}
+	}
+
+	if service.InstanceConfiguration != nil && service.InstanceConfiguration.InstanceRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*service.InstanceConfiguration.InstanceRoleArn, scope))
+	}
+
+	if service.NetworkConfiguration != nil && service.NetworkConfiguration.EgressConfiguration != nil && service.NetworkConfiguration.EgressConfiguration.VpcConnectorArn != nil {
+		connectorArn := *service.NetworkConfiguration.EgressConfiguration.VpcConnectorArn
+
+		if a, err := adapterhelpers.ParseARN(connectorArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apprunner-vpc-connector",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  connectorArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the connector will affect the service's access
+					// to the VPC
+					In: true,
+					// The service can't affect the connector
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if service.AutoScalingConfigurationSummary != nil && service.AutoScalingConfigurationSummary.AutoScalingConfigurationArn != nil {
+		configArn := *service.AutoScalingConfigurationSummary.AutoScalingConfigurationArn
+
+		if a, err := adapterhelpers.ParseARN(configArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "apprunner-auto-scaling-configuration",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  configArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The configuration controls how many instances the
+					// service can run
+					In: true,
+					// The service can't affect the configuration
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if service.EncryptionConfiguration != nil && service.EncryptionConfiguration.KmsKey != nil {
+		// Used to encrypt the source code or image
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*service.EncryptionConfiguration.KmsKey, scope))
+	}
+
+	return &item, nil
+}
+
+func NewAppRunnerServiceAdapter(client AppRunnerClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*apprunner.ListServicesInput, *apprunner.ListServicesOutput, *apprunner.DescribeServiceInput, *apprunner.DescribeServiceOutput, AppRunnerClient, *apprunner.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*apprunner.ListServicesInput, *apprunner.ListServicesOutput, *apprunner.DescribeServiceInput, *apprunner.DescribeServiceOutput, AppRunnerClient, *apprunner.Options]{
+		ItemType:        "apprunner-service",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apprunnerServiceAdapterMetadata,
+		GetFunc:         apprunnerServiceGetFunc,
+		GetInputMapper: func(scope, query string) *apprunner.DescribeServiceInput {
+			// We are using a custom id of {serviceName}/{serviceId} e.g.
+			// my-service/8fe1e10304f84fd2b0df550fe98a71fa
+			arn, err := apprunnerARN(scope, "service", query)
+
+			if err != nil {
+				return nil
+			}
+
+			return &apprunner.DescribeServiceInput{
+				ServiceArn: &arn,
+			}
+		},
+		ListInput: &apprunner.ListServicesInput{},
+		ListFuncPaginatorBuilder: func(client AppRunnerClient, input *apprunner.ListServicesInput) adapterhelpers.Paginator[*apprunner.ListServicesOutput, *apprunner.Options] {
+			return apprunner.NewListServicesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *apprunner.ListServicesOutput, _ *apprunner.ListServicesInput) ([]*apprunner.DescribeServiceInput, error) {
+			inputs := make([]*apprunner.DescribeServiceInput, 0, len(output.ServiceSummaryList))
+
+			for _, summary := range output.ServiceSummaryList {
+				inputs = append(inputs, &apprunner.DescribeServiceInput{
+					ServiceArn: summary.ServiceArn,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var apprunnerServiceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apprunner-service",
+	DescriptiveName: "App Runner Service",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an App Runner service by full name ({serviceName}/{serviceId})",
+		ListDescription:   "List all App Runner services",
+		SearchDescription: "Search for App Runner services by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apprunner_service.arn",
+		},
+	},
+	PotentialLinks: []string{"dns", "ecr-repository", "iam-role", "apprunner-vpc-connector", "apprunner-auto-scaling-configuration", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,158 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAppRunnerServiceGetFunc(t *testing.T) {
+	client := AppRunnerTestClient{
+		DescribeServiceOutput: &apprunner.DescribeServiceOutput{
+			Service: &types.Service{
+				ServiceArn:  adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:service/billing-api/8fe1e10304f84fd2b0df550fe98a71fa"),
+				ServiceId:   adapterhelpers.PtrString("8fe1e10304f84fd2b0df550fe98a71fa"),
+				ServiceName: adapterhelpers.PtrString("billing-api"),
+				ServiceUrl:  adapterhelpers.PtrString("mfjnz3ddpa.eu-west-1.awsapprunner.com"),
+				Status:      types.ServiceStatusRunning,
+				CreatedAt:   adapterhelpers.PtrTime(time.Now()),
+				UpdatedAt:   adapterhelpers.PtrTime(time.Now()),
+				SourceConfiguration: &types.SourceConfiguration{
+					AutoDeploymentsEnabled: adapterhelpers.PtrBool(true),
+					ImageRepository: &types.ImageRepository{
+						ImageIdentifier:     adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-1.amazonaws.com/billing-api:latest"),
+						ImageRepositoryType: types.ImageRepositoryTypeEcr,
+						ImageConfiguration: &types.ImageConfiguration{
+							Port: adapterhelpers.PtrString("8080"),
+						},
+					},
+					AuthenticationConfiguration: &types.AuthenticationConfiguration{
+						AccessRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/apprunner-ecr-access"),
+					},
+				},
+				InstanceConfiguration: &types.InstanceConfiguration{
+					Cpu:             adapterhelpers.PtrString("1024"),
+					Memory:          adapterhelpers.PtrString("2048"),
+					InstanceRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/billing-api"),
+				},
+				NetworkConfiguration: &types.NetworkConfiguration{
+					EgressConfiguration: &types.EgressConfiguration{
+						EgressType:      types.EgressTypeVpc,
+						VpcConnectorArn: adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:vpcconnector/private/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8"),
+					},
+					IngressConfiguration: &types.IngressConfiguration{
+						IsPubliclyAccessible: true,
+					},
+				},
+				AutoScalingConfigurationSummary: &types.AutoScalingConfigurationSummary{
+					AutoScalingConfigurationArn:      adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:autoscalingconfiguration/high-traffic/2/6e2d1e3c1a4b4c7f9d2b0a8e5f3c1d7b"),
+					AutoScalingConfigurationName:     adapterhelpers.PtrString("high-traffic"),
+					AutoScalingConfigurationRevision: 2,
+				},
+				ObservabilityConfiguration: &types.ServiceObservabilityConfiguration{
+					ObservabilityEnabled:          true,
+					ObservabilityConfigurationArn: adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:observabilityconfiguration/tracing/1/0a1b2c3d4e5f40718293a4b5c6d7e8f9"),
+				},
+				EncryptionConfiguration: &types.EncryptionConfiguration{
+					KmsKey: adapterhelpers.PtrString("arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+				},
+			},
+		},
+		ListTagsForResourceOutput: &apprunner.ListTagsForResourceOutput{
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("billing"),
+				},
+			},
+		},
+	}
+
+	item, err := apprunnerServiceGetFunc(context.Background(), client, "123456789012.eu-west-1", &apprunner.DescribeServiceInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "billing-api/8fe1e10304f84fd2b0df550fe98a71fa" {
+		t.Errorf("expected unique attribute value billing-api/8fe1e10304f84fd2b0df550fe98a71fa, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "billing" {
+		t.Errorf("expected tag team=billing, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "mfjnz3ddpa.eu-west-1.awsapprunner.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/apprunner-ecr-access",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/billing-api",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "apprunner-vpc-connector",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:apprunner:eu-west-1:123456789012:vpcconnector/private/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "apprunner-auto-scaling-configuration",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:apprunner:eu-west-1:123456789012:autoscalingconfiguration/high-traffic/2/6e2d1e3c1a4b4c7f9d2b0a8e5f3c1d7b",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAppRunnerServiceAdapter(t *testing.T) {
+	client, account, region := apprunnerGetAutoConfig(t)
+
+	adapter := NewAppRunnerServiceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
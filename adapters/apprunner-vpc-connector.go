@@ -0,0 +1,162 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func apprunnerVpcConnectorGetFunc(ctx context.Context, client AppRunnerClient, scope string, input *apprunner.DescribeVpcConnectorInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "no input provided",
+		}
+	}
+
+	out, err := client.DescribeVpcConnector(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	connector := out.VpcConnector
+
+	if connector == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "vpc connector was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(connector)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if connector.VpcConnectorArn != nil {
+		attributes.Set("VpcConnectorFullName", apprunnerFullName(*connector.VpcConnectorArn))
+	}
+
+	item := sdp.Item{
+		Type:            "apprunner-vpc-connector",
+		UniqueAttribute: "VpcConnectorFullName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if connector.VpcConnectorArn != nil {
+		tags, err := apprunnerListTags(ctx, client, *connector.VpcConnectorArn)
+
+		if err != nil {
+			tags = adapterhelpers.HandleTagsError(ctx, err)
+		}
+
+		item.Tags = tags
+	}
+
+	if connector.Status == types.VpcConnectorStatusActive {
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	}
+
+	for _, subnet := range connector.Subnets {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnet,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the subnet will affect the connector
+				In: true,
+				// The connector shouldn't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, sg := range connector.SecurityGroups {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  sg,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the security group will affect the connector
+				In: true,
+				// The connector shouldn't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewAppRunnerVpcConnectorAdapter(client AppRunnerClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*apprunner.ListVpcConnectorsInput, *apprunner.ListVpcConnectorsOutput, *apprunner.DescribeVpcConnectorInput, *apprunner.DescribeVpcConnectorOutput, AppRunnerClient, *apprunner.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*apprunner.ListVpcConnectorsInput, *apprunner.ListVpcConnectorsOutput, *apprunner.DescribeVpcConnectorInput, *apprunner.DescribeVpcConnectorOutput, AppRunnerClient, *apprunner.Options]{
+		ItemType:        "apprunner-vpc-connector",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: apprunnerVpcConnectorAdapterMetadata,
+		GetFunc:         apprunnerVpcConnectorGetFunc,
+		GetInputMapper: func(scope, query string) *apprunner.DescribeVpcConnectorInput {
+			// We are using a custom id of {name}/{revision}/{id} e.g.
+			// my-connector/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8
+			arn, err := apprunnerARN(scope, "vpcconnector", query)
+
+			if err != nil {
+				return nil
+			}
+
+			return &apprunner.DescribeVpcConnectorInput{
+				VpcConnectorArn: &arn,
+			}
+		},
+		ListInput: &apprunner.ListVpcConnectorsInput{},
+		ListFuncPaginatorBuilder: func(client AppRunnerClient, input *apprunner.ListVpcConnectorsInput) adapterhelpers.Paginator[*apprunner.ListVpcConnectorsOutput, *apprunner.Options] {
+			return apprunner.NewListVpcConnectorsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *apprunner.ListVpcConnectorsOutput, _ *apprunner.ListVpcConnectorsInput) ([]*apprunner.DescribeVpcConnectorInput, error) {
+			inputs := make([]*apprunner.DescribeVpcConnectorInput, 0, len(output.VpcConnectors))
+
+			for _, connector := range output.VpcConnectors {
+				inputs = append(inputs, &apprunner.DescribeVpcConnectorInput{
+					VpcConnectorArn: connector.VpcConnectorArn,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var apprunnerVpcConnectorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "apprunner-vpc-connector",
+	DescriptiveName: "App Runner VPC Connector",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a VPC connector by full name ({name}/{revision}/{id})",
+		ListDescription:   "List all VPC connectors",
+		SearchDescription: "Search for VPC connectors by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_apprunner_vpc_connector.arn",
+		},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/aws/aws-sdk-go-v2/service/apprunner/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAppRunnerVpcConnectorGetFunc(t *testing.T) {
+	client := AppRunnerTestClient{
+		DescribeVpcConnectorOutput: &apprunner.DescribeVpcConnectorOutput{
+			VpcConnector: &types.VpcConnector{
+				VpcConnectorArn:      adapterhelpers.PtrString("arn:aws:apprunner:eu-west-1:123456789012:vpcconnector/private/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8"),
+				VpcConnectorName:     adapterhelpers.PtrString("private"),
+				VpcConnectorRevision: 1,
+				Status:               types.VpcConnectorStatusActive,
+				Subnets:              []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"},
+				SecurityGroups:       []string{"sg-0123456789abcdef0"},
+				CreatedAt:            adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+		ListTagsForResourceOutput: &apprunner.ListTagsForResourceOutput{},
+	}
+
+	item, err := apprunnerVpcConnectorGetFunc(context.Background(), client, "123456789012.eu-west-1", &apprunner.DescribeVpcConnectorInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "private/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8" {
+		t.Errorf("expected unique attribute value private/1/3f2eb10e2bca4798a6d1a1b2c1f5e7d8, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAppRunnerVpcConnectorAdapter(t *testing.T) {
+	client, account, region := apprunnerGetAutoConfig(t)
+
+	adapter := NewAppRunnerVpcConnectorAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
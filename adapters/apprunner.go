@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type AppRunnerClient interface {
+	DescribeService(ctx context.Context, params *apprunner.DescribeServiceInput, optFns ...func(*apprunner.Options)) (*apprunner.DescribeServiceOutput, error)
+	DescribeVpcConnector(ctx context.Context, params *apprunner.DescribeVpcConnectorInput, optFns ...func(*apprunner.Options)) (*apprunner.DescribeVpcConnectorOutput, error)
+	DescribeAutoScalingConfiguration(ctx context.Context, params *apprunner.DescribeAutoScalingConfigurationInput, optFns ...func(*apprunner.Options)) (*apprunner.DescribeAutoScalingConfigurationOutput, error)
+	ListTagsForResource(ctx context.Context, params *apprunner.ListTagsForResourceInput, optFns ...func(*apprunner.Options)) (*apprunner.ListTagsForResourceOutput, error)
+
+	apprunner.ListServicesAPIClient
+	apprunner.ListVpcConnectorsAPIClient
+	apprunner.ListAutoScalingConfigurationsAPIClient
+}
+
+// apprunnerListTags Gets the tags for an App Runner resource
+func apprunnerListTags(ctx context.Context, client AppRunnerClient, arn string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &apprunner.ListTagsForResourceInput{
+		ResourceArn: &arn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make(map[string]string)
+
+	for _, tag := range out.Tags {
+		if tag.Key != nil && tag.Value != nil {
+			tags[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tags, nil
+}
+
+// apprunnerARN Builds the ARN of an App Runner resource from its full name.
+// App Runner resources can only be described by ARN, and the ARN includes a
+// generated ID so we use everything after the resource type as the name e.g.
+// {serviceName}/{serviceId}
+func apprunnerARN(scope, resourceType, fullName string) (string, error) {
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("arn:%v:apprunner:%v:%v:%v/%v", adapterhelpers.PartitionFromRegion(region), region, accountID, resourceType, fullName), nil
+}
+
+// apprunnerFullName Returns the full name of an App Runner resource, which is
+// everything in the ARN after the resource type
+func apprunnerFullName(arn string) string {
+	a, err := adapterhelpers.ParseARN(arn)
+
+	if err != nil {
+		return ""
+	}
+
+	return a.ResourceID()
+}
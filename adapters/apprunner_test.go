@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/apprunner"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type AppRunnerTestClient struct {
+	DescribeServiceOutput                  *apprunner.DescribeServiceOutput
+	DescribeVpcConnectorOutput             *apprunner.DescribeVpcConnectorOutput
+	DescribeAutoScalingConfigurationOutput *apprunner.DescribeAutoScalingConfigurationOutput
+	ListTagsForResourceOutput              *apprunner.ListTagsForResourceOutput
+	ListServicesOutput                     *apprunner.ListServicesOutput
+	ListVpcConnectorsOutput                *apprunner.ListVpcConnectorsOutput
+	ListAutoScalingConfigurationsOutput    *apprunner.ListAutoScalingConfigurationsOutput
+}
+
+func (t AppRunnerTestClient) DescribeService(context.Context, *apprunner.DescribeServiceInput, ...func(*apprunner.Options)) (*apprunner.DescribeServiceOutput, error) {
+	return t.DescribeServiceOutput, nil
+}
+
+func (t AppRunnerTestClient) DescribeVpcConnector(context.Context, *apprunner.DescribeVpcConnectorInput, ...func(*apprunner.Options)) (*apprunner.DescribeVpcConnectorOutput, error) {
+	return t.DescribeVpcConnectorOutput, nil
+}
+
+func (t AppRunnerTestClient) DescribeAutoScalingConfiguration(context.Context, *apprunner.DescribeAutoScalingConfigurationInput, ...func(*apprunner.Options)) (*apprunner.DescribeAutoScalingConfigurationOutput, error) {
+	return t.DescribeAutoScalingConfigurationOutput, nil
+}
+
+func (t AppRunnerTestClient) ListTagsForResource(context.Context, *apprunner.ListTagsForResourceInput, ...func(*apprunner.Options)) (*apprunner.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func (t AppRunnerTestClient) ListServices(context.Context, *apprunner.ListServicesInput, ...func(*apprunner.Options)) (*apprunner.ListServicesOutput, error) {
+	return t.ListServicesOutput, nil
+}
+
+func (t AppRunnerTestClient) ListVpcConnectors(context.Context, *apprunner.ListVpcConnectorsInput, ...func(*apprunner.Options)) (*apprunner.ListVpcConnectorsOutput, error) {
+	return t.ListVpcConnectorsOutput, nil
+}
+
+func (t AppRunnerTestClient) ListAutoScalingConfigurations(context.Context, *apprunner.ListAutoScalingConfigurationsInput, ...func(*apprunner.Options)) (*apprunner.ListAutoScalingConfigurationsOutput, error) {
+	return t.ListAutoScalingConfigurationsOutput, nil
+}
+
+func apprunnerGetAutoConfig(t *testing.T) (*apprunner.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := apprunner.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestAppRunnerARN(t *testing.T) {
+	arn, err := apprunnerARN("123456789012.eu-west-1", "service", "billing-api/8fe1e10304f84fd2b0df550fe98a71fa")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "arn:aws:apprunner:eu-west-1:123456789012:service/billing-api/8fe1e10304f84fd2b0df550fe98a71fa"
+
+	if arn != expected {
+		t.Errorf("expected %v, got %v", expected, arn)
+	}
+
+	if name := apprunnerFullName(arn); name != "billing-api/8fe1e10304f84fd2b0df550fe98a71fa" {
+		t.Errorf("expected full name billing-api/8fe1e10304f84fd2b0df550fe98a71fa, got %v", name)
+	}
+
+	if _, err := apprunnerARN("123456789012", "service", "billing-api/8fe1e10304f84fd2b0df550fe98a71fa"); err == nil {
+		t.Error("expected an error for a scope without a region")
+	}
+}
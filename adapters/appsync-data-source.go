@@ -0,0 +1,294 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// AppSyncDataSourceDetails A data source along with the ID of the API that it
+// belongs to, since the data source itself doesn't include it
+type AppSyncDataSourceDetails struct {
+	ApiId      string
+	DataSource types.DataSource
+}
+
+// appsyncDataSourceGetFunc Gets a data source by its unique name:
+// {apiId}/{name}
+func appsyncDataSourceGetFunc(ctx context.Context, client AppSyncClient, scope, query string) (*AppSyncDataSourceDetails, error) {
+	apiID, name, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {apiId}/{name}",
+		}
+	}
+
+	out, err := client.GetDataSource(ctx, &appsync.GetDataSourceInput{
+		ApiId: &apiID,
+		Name:  &name,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DataSource == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "data source was nil",
+		}
+	}
+
+	return &AppSyncDataSourceDetails{
+		ApiId:      apiID,
+		DataSource: *out.DataSource,
+	}, nil
+}
+
+// appsyncDataSourceSearchFunc Lists all data sources for an API by API ID or
+// ARN, or gets a data source by ARN
+func appsyncDataSourceSearchFunc(ctx context.Context, client AppSyncClient, scope, query string) ([]*AppSyncDataSourceDetails, error) {
+	apiID, path := appsyncParseQuery(query)
+
+	if len(path) == 2 && path[0] == "datasources" {
+		dataSource, err := appsyncDataSourceGetFunc(ctx, client, scope, apiID+"/"+path[1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*AppSyncDataSourceDetails{dataSource}, nil
+	}
+
+	paginator := appsync.NewListDataSourcesPaginator(client, &appsync.ListDataSourcesInput{
+		ApiId: &apiID,
+	})
+
+	dataSources := make([]*AppSyncDataSourceDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, dataSource := range out.DataSources {
+			dataSources = append(dataSources, &AppSyncDataSourceDetails{
+				ApiId:      apiID,
+				DataSource: dataSource,
+			})
+		}
+	}
+
+	return dataSources, nil
+}
+
+func appsyncDataSourceItemMapper(_, scope string, awsItem *AppSyncDataSourceDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.DataSource
+		ApiId string
+	}{
+		DataSource: awsItem.DataSource,
+		ApiId:      awsItem.ApiId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.DataSource.Name == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "data source is missing its name",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {apiId}/{name}
+	if err = attributes.Set("UniqueName", awsItem.ApiId+"/"+*awsItem.DataSource.Name); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "appsync-data-source",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			appsyncAPILink(awsItem.ApiId, scope),
+		},
+	}
+
+	dataSource := awsItem.DataSource
+
+	if dataSource.ServiceRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*dataSource.ServiceRoleArn, scope))
+	}
+
+	if dataSource.LambdaConfig != nil && dataSource.LambdaConfig.LambdaFunctionArn != nil {
+		if link := appsyncLambdaLink(*dataSource.LambdaConfig.LambdaFunctionArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if config := dataSource.DynamodbConfig; config != nil && config.TableName != nil {
+		tableScope := scope
+
+		if config.AwsRegion != nil {
+			accountID, _, _ := adapterhelpers.ParseScope(scope)
+			tableScope = adapterhelpers.FormatScope(accountID, *config.AwsRegion)
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  *config.TableName,
+				Scope:  tableScope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Requests fail if the table changes
+				In: true,
+				// Mutations write to the table
+				Out: true,
+			},
+		})
+	}
+
+	// There isn't an OpenSearch adapter so we link to the endpoint instead
+	if dataSource.OpenSearchServiceConfig != nil && dataSource.OpenSearchServiceConfig.Endpoint != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncEndpointLinks(*dataSource.OpenSearchServiceConfig.Endpoint)...)
+	}
+
+	if dataSource.ElasticsearchConfig != nil && dataSource.ElasticsearchConfig.Endpoint != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncEndpointLinks(*dataSource.ElasticsearchConfig.Endpoint)...)
+	}
+
+	if dataSource.HttpConfig != nil && dataSource.HttpConfig.Endpoint != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncEndpointLinks(*dataSource.HttpConfig.Endpoint)...)
+	}
+
+	if dataSource.RelationalDatabaseConfig != nil && dataSource.RelationalDatabaseConfig.RdsHttpEndpointConfig != nil {
+		rds := dataSource.RelationalDatabaseConfig.RdsHttpEndpointConfig
+
+		if rds.DbClusterIdentifier != nil {
+			query := &sdp.Query{
+				Type:   "rds-db-cluster",
+				Method: sdp.QueryMethod_GET,
+				Query:  *rds.DbClusterIdentifier,
+				Scope:  scope,
+			}
+
+			if a, err := adapterhelpers.ParseARN(*rds.DbClusterIdentifier); err == nil {
+				query.Method = sdp.QueryMethod_SEARCH
+				query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+			} else if rds.AwsRegion != nil {
+				accountID, _, _ := adapterhelpers.ParseScope(scope)
+				query.Scope = adapterhelpers.FormatScope(accountID, *rds.AwsRegion)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: query,
+				BlastPropagation: &sdp.BlastPropagation{
+					// Requests fail if the cluster is down
+					In: true,
+					// Mutations write to the database
+					Out: true,
+				},
+			})
+		}
+
+		if rds.AwsSecretStoreArn != nil {
+			if a, err := adapterhelpers.ParseARN(*rds.AwsSecretStoreArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "secretsmanager-secret",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *rds.AwsSecretStoreArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the credentials will stop the data source
+						// from connecting to the database
+						In: true,
+						// The data source can't affect the secret
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	if dataSource.EventBridgeConfig != nil && dataSource.EventBridgeConfig.EventBusArn != nil {
+		if a, err := adapterhelpers.ParseARN(*dataSource.EventBridgeConfig.EventBusArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "events-event-bus",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *dataSource.EventBridgeConfig.EventBusArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Requests fail if the bus is deleted
+					In: true,
+					// Events are sent to the bus and on to its rules
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAppSyncDataSourceAdapter(client AppSyncClient, accountID string, region string) *adapterhelpers.GetListAdapter[*AppSyncDataSourceDetails, AppSyncClient, *appsync.Options] {
+	return &adapterhelpers.GetListAdapter[*AppSyncDataSourceDetails, AppSyncClient, *appsync.Options]{
+		ItemType:        "appsync-data-source",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: appsyncDataSourceAdapterMetadata,
+		GetFunc:         appsyncDataSourceGetFunc,
+		// Data sources can only be listed per API
+		DisableList: true,
+		SearchFunc:  appsyncDataSourceSearchFunc,
+		ItemMapper:  appsyncDataSourceItemMapper,
+	}
+}
+
+var appsyncDataSourceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "appsync-data-source",
+	DescriptiveName: "AppSync Data Source",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an AppSync data source by {apiId}/{name}",
+		SearchDescription: "Search for AppSync data sources by API ID or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_appsync_datasource.arn",
+		},
+	},
+	PotentialLinks: []string{
+		"appsync-graphql-api",
+		"iam-role",
+		"lambda-function",
+		"dynamodb-table",
+		"http",
+		"dns",
+		"rds-db-cluster",
+		"secretsmanager-secret",
+		"events-event-bus",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
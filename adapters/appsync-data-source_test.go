@@ -0,0 +1,253 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAppSyncDataSourceItemMapper(t *testing.T) {
+	tests := []struct {
+		Name       string
+		DataSource types.DataSource
+		Tests      adapterhelpers.QueryTests
+	}{
+		{
+			Name: "lambda",
+			DataSource: types.DataSource{
+				Name:           adapterhelpers.PtrString("orders"),
+				Type:           types.DataSourceTypeAwsLambda,
+				ServiceRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/appsync-orders"),
+				LambdaConfig: &types.LambdaDataSourceConfig{
+					LambdaFunctionArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-1:123456789012:function:orders"),
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "appsync-graphql-api",
+					ExpectedMethod: sdp.QueryMethod_GET,
+					ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz",
+					ExpectedScope:  "123456789012.eu-west-1",
+				},
+				{
+					ExpectedType:   "iam-role",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "arn:aws:iam::123456789012:role/appsync-orders",
+					ExpectedScope:  "123456789012",
+				},
+				{
+					ExpectedType:   "lambda-function",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "arn:aws:lambda:eu-west-1:123456789012:function:orders",
+					ExpectedScope:  "123456789012.eu-west-1",
+				},
+			},
+		},
+		{
+			Name: "dynamodb",
+			DataSource: types.DataSource{
+				Name: adapterhelpers.PtrString("orders"),
+				Type: types.DataSourceTypeAmazonDynamodb,
+				DynamodbConfig: &types.DynamodbDataSourceConfig{
+					TableName: adapterhelpers.PtrString("orders"),
+					AwsRegion: adapterhelpers.PtrString("eu-west-2"),
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "dynamodb-table",
+					ExpectedMethod: sdp.QueryMethod_GET,
+					ExpectedQuery:  "orders",
+					ExpectedScope:  "123456789012.eu-west-2",
+				},
+			},
+		},
+		{
+			Name: "opensearch",
+			DataSource: types.DataSource{
+				Name: adapterhelpers.PtrString("search"),
+				Type: types.DataSourceTypeAmazonOpensearchService,
+				OpenSearchServiceConfig: &types.OpenSearchServiceDataSourceConfig{
+					Endpoint:  adapterhelpers.PtrString("https://search-orders-abc123.eu-west-1.es.amazonaws.com"),
+					AwsRegion: adapterhelpers.PtrString("eu-west-1"),
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "http",
+					ExpectedMethod: sdp.QueryMethod_GET,
+					ExpectedQuery:  "https://search-orders-abc123.eu-west-1.es.amazonaws.com",
+					ExpectedScope:  "global",
+				},
+				{
+					ExpectedType:   "dns",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "search-orders-abc123.eu-west-1.es.amazonaws.com",
+					ExpectedScope:  "global",
+				},
+			},
+		},
+		{
+			Name: "rds",
+			DataSource: types.DataSource{
+				Name: adapterhelpers.PtrString("orders"),
+				Type: types.DataSourceTypeRelationalDatabase,
+				RelationalDatabaseConfig: &types.RelationalDatabaseDataSourceConfig{
+					RelationalDatabaseSourceType: types.RelationalDatabaseSourceTypeRdsHttpEndpoint,
+					RdsHttpEndpointConfig: &types.RdsHttpEndpointConfig{
+						DbClusterIdentifier: adapterhelpers.PtrString("arn:aws:rds:eu-west-1:123456789012:cluster:orders"),
+						AwsSecretStoreArn:   adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-1:123456789012:secret:orders-AbCdEf"),
+						AwsRegion:           adapterhelpers.PtrString("eu-west-1"),
+						DatabaseName:        adapterhelpers.PtrString("orders"),
+					},
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "rds-db-cluster",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "arn:aws:rds:eu-west-1:123456789012:cluster:orders",
+					ExpectedScope:  "123456789012.eu-west-1",
+				},
+				{
+					ExpectedType:   "secretsmanager-secret",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "arn:aws:secretsmanager:eu-west-1:123456789012:secret:orders-AbCdEf",
+					ExpectedScope:  "123456789012.eu-west-1",
+				},
+			},
+		},
+		{
+			Name: "eventbridge",
+			DataSource: types.DataSource{
+				Name: adapterhelpers.PtrString("events"),
+				Type: types.DataSourceTypeAmazonEventbridge,
+				EventBridgeConfig: &types.EventBridgeDataSourceConfig{
+					EventBusArn: adapterhelpers.PtrString("arn:aws:events:eu-west-1:123456789012:event-bus/orders"),
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "events-event-bus",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "arn:aws:events:eu-west-1:123456789012:event-bus/orders",
+					ExpectedScope:  "123456789012.eu-west-1",
+				},
+			},
+		},
+		{
+			Name: "http",
+			DataSource: types.DataSource{
+				Name: adapterhelpers.PtrString("payments"),
+				Type: types.DataSourceTypeHttp,
+				HttpConfig: &types.HttpDataSourceConfig{
+					Endpoint: adapterhelpers.PtrString("https://payments.example.com"),
+				},
+			},
+			Tests: adapterhelpers.QueryTests{
+				{
+					ExpectedType:   "http",
+					ExpectedMethod: sdp.QueryMethod_GET,
+					ExpectedQuery:  "https://payments.example.com",
+					ExpectedScope:  "global",
+				},
+				{
+					ExpectedType:   "dns",
+					ExpectedMethod: sdp.QueryMethod_SEARCH,
+					ExpectedQuery:  "payments.example.com",
+					ExpectedScope:  "global",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			item, err := appsyncDataSourceItemMapper("", "123456789012.eu-west-1", &AppSyncDataSourceDetails{
+				ApiId:      "abcdefghijklmnopqrstuvwxyz",
+				DataSource: test.DataSource,
+			})
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err = item.Validate(); err != nil {
+				t.Error(err)
+			}
+
+			expected := "abcdefghijklmnopqrstuvwxyz/" + *test.DataSource.Name
+
+			if item.UniqueAttributeValue() != expected {
+				t.Errorf("expected unique attribute value to be %v, got %v", expected, item.UniqueAttributeValue())
+			}
+
+			test.Tests.Execute(t, item)
+		})
+	}
+}
+
+func TestAppSyncDataSourceSearchFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetDataSourceOutput: &appsync.GetDataSourceOutput{
+			DataSource: &types.DataSource{
+				Name: adapterhelpers.PtrString("orders"),
+				Type: types.DataSourceTypeNone,
+			},
+		},
+		ListDataSourcesOutput: &appsync.ListDataSourcesOutput{
+			DataSources: []types.DataSource{
+				{Name: adapterhelpers.PtrString("orders")},
+				{Name: adapterhelpers.PtrString("payments")},
+			},
+		},
+	}
+
+	dataSources, err := appsyncDataSourceSearchFunc(context.Background(), client, "123456789012.eu-west-1", "abcdefghijklmnopqrstuvwxyz")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dataSources) != 2 {
+		t.Errorf("expected 2 data sources, got %v", len(dataSources))
+	}
+
+	dataSources, err = appsyncDataSourceSearchFunc(context.Background(), client, "123456789012.eu-west-1", "arn:aws:appsync:eu-west-1:123456789012:apis/abcdefghijklmnopqrstuvwxyz/datasources/orders")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(dataSources) != 1 {
+		t.Fatalf("expected 1 data source, got %v", len(dataSources))
+	}
+
+	if dataSources[0].ApiId != "abcdefghijklmnopqrstuvwxyz" {
+		t.Errorf("expected API ID abcdefghijklmnopqrstuvwxyz, got %v", dataSources[0].ApiId)
+	}
+
+	if _, err = appsyncDataSourceGetFunc(context.Background(), client, "123456789012.eu-west-1", "orders"); err == nil {
+		t.Error("expected an error for a query without an API ID")
+	}
+}
+
+func TestNewAppSyncDataSourceAdapter(t *testing.T) {
+	client, account, region := appsyncGetAutoConfig(t)
+
+	adapter := NewAppSyncDataSourceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
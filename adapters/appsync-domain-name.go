@@ -0,0 +1,214 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// AppSyncDomainNameDetails A custom domain name along with the API that it is
+// associated with, if any
+type AppSyncDomainNameDetails struct {
+	DomainName     types.DomainNameConfig
+	ApiAssociation *types.ApiAssociation
+}
+
+// appsyncDomainNameDetails Looks up the API association for a domain name.
+// Domains don't have to be associated with an API so errors are ignored
+func appsyncDomainNameDetails(ctx context.Context, client AppSyncClient, domainName types.DomainNameConfig) *AppSyncDomainNameDetails {
+	details := AppSyncDomainNameDetails{
+		DomainName: domainName,
+	}
+
+	if domainName.DomainName != nil {
+		out, err := client.GetApiAssociation(ctx, &appsync.GetApiAssociationInput{
+			DomainName: domainName.DomainName,
+		})
+
+		if err == nil {
+			details.ApiAssociation = out.ApiAssociation
+		}
+	}
+
+	return &details
+}
+
+func appsyncDomainNameGetFunc(ctx context.Context, client AppSyncClient, scope, query string) (*AppSyncDomainNameDetails, error) {
+	out, err := client.GetDomainName(ctx, &appsync.GetDomainNameInput{
+		DomainName: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DomainNameConfig == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "domain name config was nil",
+		}
+	}
+
+	return appsyncDomainNameDetails(ctx, client, *out.DomainNameConfig), nil
+}
+
+func appsyncDomainNameListFunc(ctx context.Context, client AppSyncClient, scope string) ([]*AppSyncDomainNameDetails, error) {
+	paginator := appsync.NewListDomainNamesPaginator(client, &appsync.ListDomainNamesInput{})
+
+	domainNames := make([]*AppSyncDomainNameDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, domainName := range out.DomainNameConfigs {
+			domainNames = append(domainNames, appsyncDomainNameDetails(ctx, client, domainName))
+		}
+	}
+
+	return domainNames, nil
+}
+
+func appsyncDomainNameItemMapper(_, scope string, awsItem *AppSyncDomainNameDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.DomainNameConfig
+		ApiAssociation *types.ApiAssociation
+	}{
+		DomainNameConfig: awsItem.DomainName,
+		ApiAssociation:   awsItem.ApiAssociation,
+	}, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "appsync-domain-name",
+		UniqueAttribute: "DomainName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.DomainName.Tags,
+	}
+
+	if awsItem.ApiAssociation != nil {
+		switch awsItem.ApiAssociation.AssociationStatus {
+		case types.AssociationStatusSuccess:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.AssociationStatusProcessing:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.AssociationStatusFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		if awsItem.ApiAssociation.ApiId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "appsync-graphql-api",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.ApiAssociation.ApiId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Requests to the domain are served by the API
+					In: true,
+					// The API can be reached without the domain
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.DomainName.DomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.DomainName.DomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	// The CloudFront distribution that the custom domain should be aliased
+	// to
+	if awsItem.DomainName.AppsyncDomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.DomainName.AppsyncDomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.DomainName.CertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.DomainName.CertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.DomainName.CertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// If the certificate expires or is deleted the domain
+					// will stop working
+					In: true,
+					// The domain can't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAppSyncDomainNameAdapter(client AppSyncClient, accountID string, region string) *adapterhelpers.GetListAdapter[*AppSyncDomainNameDetails, AppSyncClient, *appsync.Options] {
+	return &adapterhelpers.GetListAdapter[*AppSyncDomainNameDetails, AppSyncClient, *appsync.Options]{
+		ItemType:        "appsync-domain-name",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: appsyncDomainNameAdapterMetadata,
+		GetFunc:         appsyncDomainNameGetFunc,
+		ListFunc:        appsyncDomainNameListFunc,
+		ItemMapper:      appsyncDomainNameItemMapper,
+	}
+}
+
+var appsyncDomainNameAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "appsync-domain-name",
+	DescriptiveName: "AppSync Domain Name",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an AppSync custom domain name by domain name",
+		ListDescription:   "List all AppSync custom domain names",
+		SearchDescription: "Search for AppSync custom domain names by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_appsync_domain_name.domain_name"},
+	},
+	PotentialLinks: []string{"appsync-graphql-api", "dns", "acm-certificate"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
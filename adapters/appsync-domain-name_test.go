@@ -0,0 +1,103 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAppSyncDomainNameGetFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetDomainNameOutput: &appsync.GetDomainNameOutput{
+			DomainNameConfig: &types.DomainNameConfig{
+				DomainName:        adapterhelpers.PtrString("api.example.com"), // link
+				DomainNameArn:     adapterhelpers.PtrString("arn:aws:appsync:eu-west-1:123456789012:domainnames/api.example.com"),
+				AppsyncDomainName: adapterhelpers.PtrString("d1234567890abc.cloudfront.net"),                                                       // link
+				CertificateArn:    adapterhelpers.PtrString("arn:aws:acm:us-east-1:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"), // link
+				HostedZoneId:      adapterhelpers.PtrString("Z2FDTNDATAQYW2"),
+				Tags: map[string]string{
+					"foo": "bar",
+				},
+			},
+		},
+		GetApiAssociationOutput: &appsync.GetApiAssociationOutput{
+			ApiAssociation: &types.ApiAssociation{
+				ApiId:             adapterhelpers.PtrString("abcdefghijklmnopqrstuvwxyz"), // link
+				DomainName:        adapterhelpers.PtrString("api.example.com"),
+				AssociationStatus: types.AssociationStatusSuccess,
+			},
+		},
+	}
+
+	domainName, err := appsyncDomainNameGetFunc(context.Background(), client, "123456789012.eu-west-1", "api.example.com")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := appsyncDomainNameItemMapper("", "123456789012.eu-west-1", domainName)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["foo"] != "bar" {
+		t.Errorf("expected tag foo to be bar, got %v", item.GetTags()["foo"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "appsync-graphql-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "api.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "d1234567890abc.cloudfront.net",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:us-east-1:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAppSyncDomainNameAdapter(t *testing.T) {
+	client, account, region := appsyncGetAutoConfig(t)
+
+	adapter := NewAppSyncDomainNameAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
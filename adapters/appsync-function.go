@@ -0,0 +1,184 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// AppSyncFunctionDetails A pipeline function along with the ID of the API
+// that it belongs to
+type AppSyncFunctionDetails struct {
+	ApiId    string
+	Function types.FunctionConfiguration
+}
+
+// appsyncFunctionGetFunc Gets a function by its unique name:
+// {apiId}/{functionId}
+func appsyncFunctionGetFunc(ctx context.Context, client AppSyncClient, scope, query string) (*AppSyncFunctionDetails, error) {
+	apiID, functionID, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {apiId}/{functionId}",
+		}
+	}
+
+	out, err := client.GetFunction(ctx, &appsync.GetFunctionInput{
+		ApiId:      &apiID,
+		FunctionId: &functionID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.FunctionConfiguration == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "function configuration was nil",
+		}
+	}
+
+	return &AppSyncFunctionDetails{
+		ApiId:    apiID,
+		Function: *out.FunctionConfiguration,
+	}, nil
+}
+
+// appsyncFunctionSearchFunc Lists all functions for an API by API ID or ARN,
+// or gets a function by ARN
+func appsyncFunctionSearchFunc(ctx context.Context, client AppSyncClient, scope, query string) ([]*AppSyncFunctionDetails, error) {
+	apiID, path := appsyncParseQuery(query)
+
+	if len(path) == 2 && path[0] == "functions" {
+		function, err := appsyncFunctionGetFunc(ctx, client, scope, apiID+"/"+path[1])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*AppSyncFunctionDetails{function}, nil
+	}
+
+	paginator := appsync.NewListFunctionsPaginator(client, &appsync.ListFunctionsInput{
+		ApiId: &apiID,
+	})
+
+	functions := make([]*AppSyncFunctionDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, function := range out.Functions {
+			functions = append(functions, &AppSyncFunctionDetails{
+				ApiId:    apiID,
+				Function: function,
+			})
+		}
+	}
+
+	return functions, nil
+}
+
+func appsyncFunctionItemMapper(_, scope string, awsItem *AppSyncFunctionDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.FunctionConfiguration
+		ApiId string
+	}{
+		FunctionConfiguration: awsItem.Function,
+		ApiId:                 awsItem.ApiId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Function.FunctionId == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "function is missing its ID",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {apiId}/{functionId}
+	if err = attributes.Set("UniqueName", awsItem.ApiId+"/"+*awsItem.Function.FunctionId); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "appsync-function",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			appsyncAPILink(awsItem.ApiId, scope),
+		},
+	}
+
+	if awsItem.Function.DataSourceName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "appsync-data-source",
+				Method: sdp.QueryMethod_GET,
+				Query:  awsItem.ApiId + "/" + *awsItem.Function.DataSourceName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The function fails if the data source breaks
+				In: true,
+				// The function reads and writes through the data source
+				Out: true,
+			},
+		})
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncSyncConfigLinks(awsItem.Function.SyncConfig)...)
+
+	return &item, nil
+}
+
+func NewAppSyncFunctionAdapter(client AppSyncClient, accountID string, region string) *adapterhelpers.GetListAdapter[*AppSyncFunctionDetails, AppSyncClient, *appsync.Options] {
+	return &adapterhelpers.GetListAdapter[*AppSyncFunctionDetails, AppSyncClient, *appsync.Options]{
+		ItemType:        "appsync-function",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: appsyncFunctionAdapterMetadata,
+		GetFunc:         appsyncFunctionGetFunc,
+		// Functions can only be listed per API
+		DisableList: true,
+		SearchFunc:  appsyncFunctionSearchFunc,
+		ItemMapper:  appsyncFunctionItemMapper,
+	}
+}
+
+var appsyncFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "appsync-function",
+	DescriptiveName: "AppSync Function",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an AppSync function by {apiId}/{functionId}",
+		SearchDescription: "Search for AppSync functions by API ID or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_appsync_function.arn",
+		},
+	},
+	PotentialLinks: []string{"appsync-graphql-api", "appsync-data-source", "lambda-function"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var appsyncGetFunctionOutput = appsync.GetFunctionOutput{
+	FunctionConfiguration: &types.FunctionConfiguration{
+		FunctionId:     adapterhelpers.PtrString("abcdefghijklmnopqrstuvwxyz"),
+		FunctionArn:    adapterhelpers.PtrString("arn:aws:appsync:eu-west-1:123456789012:apis/zyxwvutsrqponmlkjihgfedcba/functions/abcdefghijklmnopqrstuvwxyz"),
+		Name:           adapterhelpers.PtrString("getOrder"),
+		DataSourceName: adapterhelpers.PtrString("orders"), // link
+		Runtime: &types.AppSyncRuntime{
+			Name:           types.RuntimeNameAppsyncJs,
+			RuntimeVersion: adapterhelpers.PtrString("1.0.0"),
+		},
+		SyncConfig: &types.SyncConfig{
+			ConflictHandler:   types.ConflictHandlerTypeLambda,
+			ConflictDetection: types.ConflictDetectionTypeVersion,
+			LambdaConflictHandlerConfig: &types.LambdaConflictHandlerConfig{
+				LambdaConflictHandlerArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-1:123456789012:function:conflicts"), // link
+			},
+		},
+	},
+}
+
+func TestAppSyncFunctionGetFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetFunctionOutput: &appsyncGetFunctionOutput,
+	}
+
+	function, err := appsyncFunctionGetFunc(context.Background(), client, "123456789012.eu-west-1", "zyxwvutsrqponmlkjihgfedcba/abcdefghijklmnopqrstuvwxyz")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := appsyncFunctionItemMapper("", "123456789012.eu-west-1", function)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "zyxwvutsrqponmlkjihgfedcba/abcdefghijklmnopqrstuvwxyz" {
+		t.Errorf("expected unique attribute value to be zyxwvutsrqponmlkjihgfedcba/abcdefghijklmnopqrstuvwxyz, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "appsync-graphql-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "zyxwvutsrqponmlkjihgfedcba",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "appsync-data-source",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "zyxwvutsrqponmlkjihgfedcba/orders",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-1:123456789012:function:conflicts",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAppSyncFunctionSearchFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetFunctionOutput: &appsyncGetFunctionOutput,
+		ListFunctionsOutput: &appsync.ListFunctionsOutput{
+			Functions: []types.FunctionConfiguration{
+				*appsyncGetFunctionOutput.FunctionConfiguration,
+			},
+		},
+	}
+
+	queries := []string{
+		"zyxwvutsrqponmlkjihgfedcba",
+		"arn:aws:appsync:eu-west-1:123456789012:apis/zyxwvutsrqponmlkjihgfedcba",
+		"arn:aws:appsync:eu-west-1:123456789012:apis/zyxwvutsrqponmlkjihgfedcba/functions/abcdefghijklmnopqrstuvwxyz",
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			functions, err := appsyncFunctionSearchFunc(context.Background(), client, "123456789012.eu-west-1", query)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(functions) != 1 {
+				t.Fatalf("expected 1 function, got %v", len(functions))
+			}
+
+			if functions[0].ApiId != "zyxwvutsrqponmlkjihgfedcba" {
+				t.Errorf("expected API ID zyxwvutsrqponmlkjihgfedcba, got %v", functions[0].ApiId)
+			}
+		})
+	}
+}
+
+func TestNewAppSyncFunctionAdapter(t *testing.T) {
+	client, account, region := appsyncGetAutoConfig(t)
+
+	adapter := NewAppSyncFunctionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,209 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func appsyncGraphqlAPIGetFunc(ctx context.Context, client AppSyncClient, scope, query string) (*types.GraphqlApi, error) {
+	out, err := client.GetGraphqlApi(ctx, &appsync.GetGraphqlApiInput{
+		ApiId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.GraphqlApi == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "graphql api was nil",
+		}
+	}
+
+	return out.GraphqlApi, nil
+}
+
+func appsyncGraphqlAPIListFunc(ctx context.Context, client AppSyncClient, scope string) ([]*types.GraphqlApi, error) {
+	paginator := appsync.NewListGraphqlApisPaginator(client, &appsync.ListGraphqlApisInput{})
+
+	apis := make([]*types.GraphqlApi, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.GraphqlApis {
+			apis = append(apis, &out.GraphqlApis[i])
+		}
+	}
+
+	return apis, nil
+}
+
+func appsyncGraphqlAPIItemMapper(_, scope string, awsItem *types.GraphqlApi) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "appsync-graphql-api",
+		UniqueAttribute: "ApiId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	if awsItem.ApiId != nil {
+		for _, childType := range []string{"appsync-data-source", "appsync-function", "appsync-resolver"} {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   childType,
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ApiId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// These are all part of the API and define how it
+					// responds to queries
+					In: true,
+					// Deleting the API deletes them
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// The GraphQL and realtime endpoints
+	for _, uri := range awsItem.Uris {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  uri,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The endpoint is the API
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, dns := range awsItem.Dns {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  dns,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	var userPoolID, userPoolRegion *string
+
+	if awsItem.UserPoolConfig != nil {
+		userPoolID = awsItem.UserPoolConfig.UserPoolId
+		userPoolRegion = awsItem.UserPoolConfig.AwsRegion
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncAuthLinks(userPoolID, userPoolRegion, awsItem.OpenIDConnectConfig, awsItem.LambdaAuthorizerConfig, scope)...)
+
+	for _, provider := range awsItem.AdditionalAuthenticationProviders {
+		userPoolID, userPoolRegion = nil, nil
+
+		if provider.UserPoolConfig != nil {
+			userPoolID = provider.UserPoolConfig.UserPoolId
+			userPoolRegion = provider.UserPoolConfig.AwsRegion
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncAuthLinks(userPoolID, userPoolRegion, provider.OpenIDConnectConfig, provider.LambdaAuthorizerConfig, scope)...)
+	}
+
+	if awsItem.LogConfig != nil && awsItem.LogConfig.CloudWatchLogsRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.LogConfig.CloudWatchLogsRoleArn, scope))
+	}
+
+	if awsItem.MergedApiExecutionRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.MergedApiExecutionRoleArn, scope))
+	}
+
+	if awsItem.WafWebAclArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.WafWebAclArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "wafv2-web-acl",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.WafWebAclArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The web ACL decides which requests reach the API
+					In: true,
+					// The API can't affect the web ACL
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewAppSyncGraphqlAPIAdapter(client AppSyncClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.GraphqlApi, AppSyncClient, *appsync.Options] {
+	return &adapterhelpers.GetListAdapter[*types.GraphqlApi, AppSyncClient, *appsync.Options]{
+		ItemType:        "appsync-graphql-api",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: appsyncGraphqlAPIAdapterMetadata,
+		GetFunc:         appsyncGraphqlAPIGetFunc,
+		ListFunc:        appsyncGraphqlAPIListFunc,
+		ItemMapper:      appsyncGraphqlAPIItemMapper,
+	}
+}
+
+var appsyncGraphqlAPIAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "appsync-graphql-api",
+	DescriptiveName: "AppSync GraphQL API",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an AppSync GraphQL API by ID",
+		ListDescription:   "List all AppSync GraphQL APIs",
+		SearchDescription: "Search for AppSync GraphQL APIs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_appsync_graphql_api.id"},
+	},
+	PotentialLinks: []string{
+		"appsync-data-source",
+		"appsync-function",
+		"appsync-resolver",
+		"http",
+		"dns",
+		"cognito-idp-user-pool",
+		"lambda-function",
+		"iam-role",
+		"wafv2-web-acl",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,172 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var appsyncGetGraphqlAPIOutput = appsync.GetGraphqlApiOutput{
+	GraphqlApi: &types.GraphqlApi{
+		ApiId:              adapterhelpers.PtrString("abcdefghijklmnopqrstuvwxyz"),
+		Arn:                adapterhelpers.PtrString("arn:aws:appsync:eu-west-1:123456789012:apis/abcdefghijklmnopqrstuvwxyz"),
+		Name:               adapterhelpers.PtrString("orders"),
+		ApiType:            types.GraphQLApiTypeGraphql,
+		AuthenticationType: types.AuthenticationTypeAmazonCognitoUserPools,
+		Visibility:         types.GraphQLApiVisibilityGlobal,
+		XrayEnabled:        true,
+		UserPoolConfig: &types.UserPoolConfig{
+			UserPoolId:    adapterhelpers.PtrString("eu-west-2_abcdefghi"), // link
+			AwsRegion:     adapterhelpers.PtrString("eu-west-2"),
+			DefaultAction: types.DefaultActionAllow,
+		},
+		AdditionalAuthenticationProviders: []types.AdditionalAuthenticationProvider{
+			{
+				AuthenticationType: types.AuthenticationTypeOpenidConnect,
+				OpenIDConnectConfig: &types.OpenIDConnectConfig{
+					Issuer: adapterhelpers.PtrString("https://auth.example.com"), // link
+				},
+			},
+			{
+				AuthenticationType: types.AuthenticationTypeAwsLambda,
+				LambdaAuthorizerConfig: &types.LambdaAuthorizerConfig{
+					AuthorizerUri: adapterhelpers.PtrString("arn:aws:lambda:eu-west-1:123456789012:function:authorizer"), // link
+				},
+			},
+		},
+		LogConfig: &types.LogConfig{
+			CloudWatchLogsRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/appsync-logs"), // link
+			FieldLogLevel:         types.FieldLogLevelError,
+		},
+		WafWebAclArn: adapterhelpers.PtrString("arn:aws:wafv2:eu-west-1:123456789012:regional/webacl/orders/a1b2c3d4"), // link
+		Uris: map[string]string{
+			"GRAPHQL": "https://abcdefghijklmnopqrstuvwxyz.appsync-api.eu-west-1.amazonaws.com/graphql", // link
+		},
+		Dns: map[string]string{
+			"GRAPHQL": "abcdefghijklmnopqrstuvwxyz.appsync-api.eu-west-1.amazonaws.com", // link
+		},
+		Tags: map[string]string{
+			"foo": "bar",
+		},
+	},
+}
+
+func TestAppSyncGraphqlAPIItemMapper(t *testing.T) {
+	item, err := appsyncGraphqlAPIItemMapper("", "123456789012.eu-west-1", appsyncGetGraphqlAPIOutput.GraphqlApi)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["foo"] != "bar" {
+		t.Errorf("expected tag foo to be bar, got %v", item.GetTags()["foo"])
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "appsync-data-source",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "appsync-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "appsync-resolver",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://abcdefghijklmnopqrstuvwxyz.appsync-api.eu-west-1.amazonaws.com/graphql",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "abcdefghijklmnopqrstuvwxyz.appsync-api.eu-west-1.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "cognito-idp-user-pool",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eu-west-2_abcdefghi",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://auth.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-1:123456789012:function:authorizer",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/appsync-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "wafv2-web-acl",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:wafv2:eu-west-1:123456789012:regional/webacl/orders/a1b2c3d4",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAppSyncGraphqlAPIListFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		ListGraphqlApisOutput: &appsync.ListGraphqlApisOutput{
+			GraphqlApis: []types.GraphqlApi{
+				*appsyncGetGraphqlAPIOutput.GraphqlApi,
+			},
+		},
+	}
+
+	apis, err := appsyncGraphqlAPIListFunc(context.Background(), client, "123456789012.eu-west-1")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(apis) != 1 {
+		t.Errorf("expected 1 API, got %v", len(apis))
+	}
+}
+
+func TestNewAppSyncGraphqlAPIAdapter(t *testing.T) {
+	client, account, region := appsyncGetAutoConfig(t)
+
+	adapter := NewAppSyncGraphqlAPIAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
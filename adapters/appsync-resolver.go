@@ -0,0 +1,243 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// AppSyncResolverDetails A resolver along with the ID of the API that it
+// belongs to
+type AppSyncResolverDetails struct {
+	ApiId    string
+	Resolver types.Resolver
+}
+
+// appsyncResolverGetFunc Gets a resolver by its unique name:
+// {apiId}/{typeName}/{fieldName}
+func appsyncResolverGetFunc(ctx context.Context, client AppSyncClient, scope, query string) (*AppSyncResolverDetails, error) {
+	sections := strings.Split(query, "/")
+
+	if len(sections) != 3 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {apiId}/{typeName}/{fieldName}",
+		}
+	}
+
+	out, err := client.GetResolver(ctx, &appsync.GetResolverInput{
+		ApiId:     &sections[0],
+		TypeName:  &sections[1],
+		FieldName: &sections[2],
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Resolver == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "resolver was nil",
+		}
+	}
+
+	return &AppSyncResolverDetails{
+		ApiId:    sections[0],
+		Resolver: *out.Resolver,
+	}, nil
+}
+
+// appsyncListResolvers Lists the resolvers for a single type in an API
+func appsyncListResolvers(ctx context.Context, client AppSyncClient, apiID string, typeName string) ([]*AppSyncResolverDetails, error) {
+	paginator := appsync.NewListResolversPaginator(client, &appsync.ListResolversInput{
+		ApiId:    &apiID,
+		TypeName: &typeName,
+	})
+
+	resolvers := make([]*AppSyncResolverDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resolver := range out.Resolvers {
+			resolvers = append(resolvers, &AppSyncResolverDetails{
+				ApiId:    apiID,
+				Resolver: resolver,
+			})
+		}
+	}
+
+	return resolvers, nil
+}
+
+// appsyncResolverSearchFunc Lists all resolvers for an API by API ID or ARN,
+// or gets a resolver by ARN. Resolvers can only be listed per type, so we
+// have to list the types in the schema first. Resolver ARNs are in the format
+// arn:aws:appsync:{region}:{account}:apis/{apiId}/types/{typeName}/resolvers/{fieldName}
+func appsyncResolverSearchFunc(ctx context.Context, client AppSyncClient, scope, query string) ([]*AppSyncResolverDetails, error) {
+	apiID, path := appsyncParseQuery(query)
+
+	if len(path) == 4 && path[0] == "types" && path[2] == "resolvers" {
+		resolver, err := appsyncResolverGetFunc(ctx, client, scope, apiID+"/"+path[1]+"/"+path[3])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*AppSyncResolverDetails{resolver}, nil
+	}
+
+	paginator := appsync.NewListTypesPaginator(client, &appsync.ListTypesInput{
+		ApiId:  &apiID,
+		Format: types.TypeDefinitionFormatSdl,
+	})
+
+	resolvers := make([]*AppSyncResolverDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, t := range out.Types {
+			if t.Name == nil {
+				continue
+			}
+
+			typeResolvers, err := appsyncListResolvers(ctx, client, apiID, *t.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			resolvers = append(resolvers, typeResolvers...)
+		}
+	}
+
+	return resolvers, nil
+}
+
+func appsyncResolverItemMapper(_, scope string, awsItem *AppSyncResolverDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.Resolver
+		ApiId string
+	}{
+		Resolver: awsItem.Resolver,
+		ApiId:    awsItem.ApiId,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Resolver.TypeName == nil || awsItem.Resolver.FieldName == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "resolver is missing its type or field name",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {apiId}/{typeName}/{fieldName}
+	if err = attributes.Set("UniqueName", awsItem.ApiId+"/"+*awsItem.Resolver.TypeName+"/"+*awsItem.Resolver.FieldName); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "appsync-resolver",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			appsyncAPILink(awsItem.ApiId, scope),
+		},
+	}
+
+	// Unit resolvers call a data source directly
+	if awsItem.Resolver.DataSourceName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "appsync-data-source",
+				Method: sdp.QueryMethod_GET,
+				Query:  awsItem.ApiId + "/" + *awsItem.Resolver.DataSourceName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The resolver fails if the data source breaks
+				In: true,
+				// The resolver reads and writes through the data source
+				Out: true,
+			},
+		})
+	}
+
+	// Pipeline resolvers call a series of functions
+	if awsItem.Resolver.PipelineConfig != nil {
+		for _, functionID := range awsItem.Resolver.PipelineConfig.Functions {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "appsync-function",
+					Method: sdp.QueryMethod_GET,
+					Query:  awsItem.ApiId + "/" + functionID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The resolver fails if any of its functions do
+					In: true,
+					// The resolver can't change the function
+					Out: false,
+				},
+			})
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, appsyncSyncConfigLinks(awsItem.Resolver.SyncConfig)...)
+
+	return &item, nil
+}
+
+func NewAppSyncResolverAdapter(client AppSyncClient, accountID string, region string) *adapterhelpers.GetListAdapter[*AppSyncResolverDetails, AppSyncClient, *appsync.Options] {
+	return &adapterhelpers.GetListAdapter[*AppSyncResolverDetails, AppSyncClient, *appsync.Options]{
+		ItemType:        "appsync-resolver",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: appsyncResolverAdapterMetadata,
+		GetFunc:         appsyncResolverGetFunc,
+		// Resolvers can only be listed per type within an API
+		DisableList: true,
+		SearchFunc:  appsyncResolverSearchFunc,
+		ItemMapper:  appsyncResolverItemMapper,
+	}
+}
+
+var appsyncResolverAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "appsync-resolver",
+	DescriptiveName: "AppSync Resolver",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an AppSync resolver by {apiId}/{typeName}/{fieldName}",
+		SearchDescription: "Search for AppSync resolvers by API ID or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_appsync_resolver.arn",
+		},
+	},
+	PotentialLinks: []string{"appsync-graphql-api", "appsync-data-source", "appsync-function", "lambda-function"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
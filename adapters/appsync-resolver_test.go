@@ -0,0 +1,153 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var appsyncGetResolverOutput = appsync.GetResolverOutput{
+	Resolver: &types.Resolver{
+		TypeName:    adapterhelpers.PtrString("Query"),
+		FieldName:   adapterhelpers.PtrString("getOrder"),
+		ResolverArn: adapterhelpers.PtrString("arn:aws:appsync:eu-west-1:123456789012:apis/zyxwvutsrqponmlkjihgfedcba/types/Query/resolvers/getOrder"),
+		Kind:        types.ResolverKindPipeline,
+		PipelineConfig: &types.PipelineConfig{
+			Functions: []string{
+				"abcdefghijklmnopqrstuvwxyz", // link
+			},
+		},
+	},
+}
+
+func TestAppSyncResolverGetFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetResolverOutput: &appsyncGetResolverOutput,
+	}
+
+	resolver, err := appsyncResolverGetFunc(context.Background(), client, "123456789012.eu-west-1", "zyxwvutsrqponmlkjihgfedcba/Query/getOrder")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := appsyncResolverItemMapper("", "123456789012.eu-west-1", resolver)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "zyxwvutsrqponmlkjihgfedcba/Query/getOrder" {
+		t.Errorf("expected unique attribute value to be zyxwvutsrqponmlkjihgfedcba/Query/getOrder, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "appsync-graphql-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "zyxwvutsrqponmlkjihgfedcba",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "appsync-function",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "zyxwvutsrqponmlkjihgfedcba/abcdefghijklmnopqrstuvwxyz",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if _, err = appsyncResolverGetFunc(context.Background(), client, "123456789012.eu-west-1", "zyxwvutsrqponmlkjihgfedcba/Query"); err == nil {
+		t.Error("expected an error for a query without a field name")
+	}
+}
+
+func TestAppSyncResolverUnitItemMapper(t *testing.T) {
+	item, err := appsyncResolverItemMapper("", "123456789012.eu-west-1", &AppSyncResolverDetails{
+		ApiId: "zyxwvutsrqponmlkjihgfedcba",
+		Resolver: types.Resolver{
+			TypeName:       adapterhelpers.PtrString("Mutation"),
+			FieldName:      adapterhelpers.PtrString("createOrder"),
+			Kind:           types.ResolverKindUnit,
+			DataSourceName: adapterhelpers.PtrString("orders"),
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "appsync-data-source",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "zyxwvutsrqponmlkjihgfedcba/orders",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAppSyncResolverSearchFunc(t *testing.T) {
+	client := AppSyncTestClient{
+		GetResolverOutput: &appsyncGetResolverOutput,
+		ListTypesOutput: &appsync.ListTypesOutput{
+			Types: []types.Type{
+				{Name: adapterhelpers.PtrString("Query")},
+				{Name: adapterhelpers.PtrString("Mutation")},
+			},
+		},
+		ListResolversOutput: &appsync.ListResolversOutput{
+			Resolvers: []types.Resolver{
+				*appsyncGetResolverOutput.Resolver,
+			},
+		},
+	}
+
+	// The test client returns the same resolver for every type
+	resolvers, err := appsyncResolverSearchFunc(context.Background(), client, "123456789012.eu-west-1", "zyxwvutsrqponmlkjihgfedcba")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resolvers) != 2 {
+		t.Errorf("expected 2 resolvers, got %v", len(resolvers))
+	}
+
+	resolvers, err = appsyncResolverSearchFunc(context.Background(), client, "123456789012.eu-west-1", "arn:aws:appsync:eu-west-1:123456789012:apis/zyxwvutsrqponmlkjihgfedcba/types/Query/resolvers/getOrder")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(resolvers) != 1 {
+		t.Errorf("expected 1 resolver, got %v", len(resolvers))
+	}
+}
+
+func TestNewAppSyncResolverAdapter(t *testing.T) {
+	client, account, region := appsyncGetAutoConfig(t)
+
+	adapter := NewAppSyncResolverAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,203 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/aws/aws-sdk-go-v2/service/appsync/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type AppSyncClient interface {
+	GetGraphqlApi(ctx context.Context, params *appsync.GetGraphqlApiInput, optFns ...func(*appsync.Options)) (*appsync.GetGraphqlApiOutput, error)
+	GetDataSource(ctx context.Context, params *appsync.GetDataSourceInput, optFns ...func(*appsync.Options)) (*appsync.GetDataSourceOutput, error)
+	GetFunction(ctx context.Context, params *appsync.GetFunctionInput, optFns ...func(*appsync.Options)) (*appsync.GetFunctionOutput, error)
+	GetResolver(ctx context.Context, params *appsync.GetResolverInput, optFns ...func(*appsync.Options)) (*appsync.GetResolverOutput, error)
+	GetDomainName(ctx context.Context, params *appsync.GetDomainNameInput, optFns ...func(*appsync.Options)) (*appsync.GetDomainNameOutput, error)
+	GetApiAssociation(ctx context.Context, params *appsync.GetApiAssociationInput, optFns ...func(*appsync.Options)) (*appsync.GetApiAssociationOutput, error)
+
+	appsync.ListGraphqlApisAPIClient
+	appsync.ListDataSourcesAPIClient
+	appsync.ListFunctionsAPIClient
+	appsync.ListTypesAPIClient
+	appsync.ListResolversAPIClient
+	appsync.ListDomainNamesAPIClient
+}
+
+// appsyncParseQuery Splits a search query into the API ID and the path to the
+// resource within the API. The query can be either an API ID or the ARN of the
+// API or anything within it e.g.
+// arn:aws:appsync:{region}:{account}:apis/{apiId}/datasources/{name}
+func appsyncParseQuery(query string) (string, []string) {
+	a, err := adapterhelpers.ParseARN(query)
+
+	if err != nil {
+		return query, nil
+	}
+
+	sections := strings.Split(a.ResourceID(), "/")
+
+	return sections[0], sections[1:]
+}
+
+// appsyncAPILink Links to the API that a data source, function, resolver or
+// domain belongs to
+func appsyncAPILink(apiID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "appsync-graphql-api",
+			Method: sdp.QueryMethod_GET,
+			Query:  apiID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the API deletes everything in it
+			In: true,
+			// Everything in the API affects how it responds to queries
+			Out: true,
+		},
+	}
+}
+
+// appsyncLambdaLink Links to a Lambda function by ARN. AppSync invokes the
+// function but can't change it
+func appsyncLambdaLink(functionArn string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(functionArn)
+
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "lambda-function",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  functionArn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Requests fail if the function breaks
+			In: true,
+			// AppSync invokes the function but can't change it
+			Out: false,
+		},
+	}
+}
+
+// appsyncEndpointLinks Links to an HTTP endpoint and its hostname, used for
+// HTTP and OpenSearch data sources
+func appsyncEndpointLinks(endpoint string) []*sdp.LinkedItemQuery {
+	links := []*sdp.LinkedItemQuery{
+		{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  endpoint,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Requests fail if the endpoint is down
+				In: true,
+				// Mutations can change the data behind the endpoint
+				Out: true,
+			},
+		},
+	}
+
+	if u, err := url.Parse(endpoint); err == nil && u.Hostname() != "" {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  u.Hostname(),
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// appsyncUserPoolLink Links to a Cognito user pool used for authorization.
+// The pool can be in a different region to the API
+func appsyncUserPoolLink(userPoolID string, awsRegion *string, scope string) *sdp.LinkedItemQuery {
+	if awsRegion != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+		scope = adapterhelpers.FormatScope(accountID, *awsRegion)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "cognito-idp-user-pool",
+			Method: sdp.QueryMethod_GET,
+			Query:  userPoolID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Users can't authenticate if the pool changes
+			In: true,
+			// The API can't affect the pool
+			Out: false,
+		},
+	}
+}
+
+// appsyncAuthLinks Links to whatever is used to authorize requests to an API.
+// This is used for both the default and additional authentication providers,
+// which use different types for the user pool config so only the ID and
+// region are passed
+func appsyncAuthLinks(userPoolID *string, userPoolRegion *string, oidc *types.OpenIDConnectConfig, lambda *types.LambdaAuthorizerConfig, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if userPoolID != nil {
+		links = append(links, appsyncUserPoolLink(*userPoolID, userPoolRegion, scope))
+	}
+
+	if oidc != nil && oidc.Issuer != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  *oidc.Issuer,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Users can't authenticate if the issuer is down
+				In: true,
+				// The API can't affect the issuer
+				Out: false,
+			},
+		})
+	}
+
+	if lambda != nil && lambda.AuthorizerUri != nil {
+		if link := appsyncLambdaLink(*lambda.AuthorizerUri); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+// appsyncSyncConfigLinks Links to the Lambda function that handles conflicts
+// for versioned data sources
+func appsyncSyncConfigLinks(syncConfig *types.SyncConfig) []*sdp.LinkedItemQuery {
+	if syncConfig == nil || syncConfig.LambdaConflictHandlerConfig == nil || syncConfig.LambdaConflictHandlerConfig.LambdaConflictHandlerArn == nil {
+		return nil
+	}
+
+	if link := appsyncLambdaLink(*syncConfig.LambdaConflictHandlerConfig.LambdaConflictHandlerArn); link != nil {
+		return []*sdp.LinkedItemQuery{link}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/appsync"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type AppSyncTestClient struct {
+	GetGraphqlApiOutput     *appsync.GetGraphqlApiOutput
+	GetDataSourceOutput     *appsync.GetDataSourceOutput
+	GetFunctionOutput       *appsync.GetFunctionOutput
+	GetResolverOutput       *appsync.GetResolverOutput
+	GetDomainNameOutput     *appsync.GetDomainNameOutput
+	GetApiAssociationOutput *appsync.GetApiAssociationOutput
+	ListGraphqlApisOutput   *appsync.ListGraphqlApisOutput
+	ListDataSourcesOutput   *appsync.ListDataSourcesOutput
+	ListFunctionsOutput     *appsync.ListFunctionsOutput
+	ListTypesOutput         *appsync.ListTypesOutput
+	ListResolversOutput     *appsync.ListResolversOutput
+	ListDomainNamesOutput   *appsync.ListDomainNamesOutput
+}
+
+func (t AppSyncTestClient) GetGraphqlApi(context.Context, *appsync.GetGraphqlApiInput, ...func(*appsync.Options)) (*appsync.GetGraphqlApiOutput, error) {
+	return t.GetGraphqlApiOutput, nil
+}
+
+func (t AppSyncTestClient) GetDataSource(context.Context, *appsync.GetDataSourceInput, ...func(*appsync.Options)) (*appsync.GetDataSourceOutput, error) {
+	return t.GetDataSourceOutput, nil
+}
+
+func (t AppSyncTestClient) GetFunction(context.Context, *appsync.GetFunctionInput, ...func(*appsync.Options)) (*appsync.GetFunctionOutput, error) {
+	return t.GetFunctionOutput, nil
+}
+
+func (t AppSyncTestClient) GetResolver(context.Context, *appsync.GetResolverInput, ...func(*appsync.Options)) (*appsync.GetResolverOutput, error) {
+	return t.GetResolverOutput, nil
+}
+
+func (t AppSyncTestClient) GetDomainName(context.Context, *appsync.GetDomainNameInput, ...func(*appsync.Options)) (*appsync.GetDomainNameOutput, error) {
+	return t.GetDomainNameOutput, nil
+}
+
+func (t AppSyncTestClient) GetApiAssociation(context.Context, *appsync.GetApiAssociationInput, ...func(*appsync.Options)) (*appsync.GetApiAssociationOutput, error) {
+	return t.GetApiAssociationOutput, nil
+}
+
+func (t AppSyncTestClient) ListGraphqlApis(context.Context, *appsync.ListGraphqlApisInput, ...func(*appsync.Options)) (*appsync.ListGraphqlApisOutput, error) {
+	return t.ListGraphqlApisOutput, nil
+}
+
+func (t AppSyncTestClient) ListDataSources(context.Context, *appsync.ListDataSourcesInput, ...func(*appsync.Options)) (*appsync.ListDataSourcesOutput, error) {
+	return t.ListDataSourcesOutput, nil
+}
+
+func (t AppSyncTestClient) ListFunctions(context.Context, *appsync.ListFunctionsInput, ...func(*appsync.Options)) (*appsync.ListFunctionsOutput, error) {
+	return t.ListFunctionsOutput, nil
+}
+
+func (t AppSyncTestClient) ListTypes(context.Context, *appsync.ListTypesInput, ...func(*appsync.Options)) (*appsync.ListTypesOutput, error) {
+	return t.ListTypesOutput, nil
+}
+
+func (t AppSyncTestClient) ListResolvers(context.Context, *appsync.ListResolversInput, ...func(*appsync.Options)) (*appsync.ListResolversOutput, error) {
+	return t.ListResolversOutput, nil
+}
+
+func (t AppSyncTestClient) ListDomainNames(context.Context, *appsync.ListDomainNamesInput, ...func(*appsync.Options)) (*appsync.ListDomainNamesOutput, error) {
+	return t.ListDomainNamesOutput, nil
+}
+
+func appsyncGetAutoConfig(t *testing.T) (*appsync.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := appsync.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestAppSyncParseQuery(t *testing.T) {
+	tests := []struct {
+		Query         string
+		ExpectedAPIID string
+		ExpectedPath  []string
+	}{
+		{
+			Query:         "abcdefghijklmnopqrstuvwxyz",
+			ExpectedAPIID: "abcdefghijklmnopqrstuvwxyz",
+		},
+		{
+			Query:         "arn:aws:appsync:eu-west-1:123456789012:apis/abcdefghijklmnopqrstuvwxyz",
+			ExpectedAPIID: "abcdefghijklmnopqrstuvwxyz",
+		},
+		{
+			Query:         "arn:aws:appsync:eu-west-1:123456789012:apis/abcdefghijklmnopqrstuvwxyz/types/Query/resolvers/getOrder",
+			ExpectedAPIID: "abcdefghijklmnopqrstuvwxyz",
+			ExpectedPath:  []string{"types", "Query", "resolvers", "getOrder"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			apiID, path := appsyncParseQuery(test.Query)
+
+			if apiID != test.ExpectedAPIID {
+				t.Errorf("expected API ID %v, got %v", test.ExpectedAPIID, apiID)
+			}
+
+			if len(path) != len(test.ExpectedPath) {
+				t.Fatalf("expected path %v, got %v", test.ExpectedPath, path)
+			}
+
+			for i := range path {
+				if path[i] != test.ExpectedPath[i] {
+					t.Errorf("expected path %v, got %v", test.ExpectedPath, path)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func athenaWorkgroupGetFunc(ctx context.Context, client AthenaClient, scope, query string) (*types.WorkGroup, error) {
+	out, err := client.GetWorkGroup(ctx, &athena.GetWorkGroupInput{
+		WorkGroup: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.WorkGroup == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "workgroup was nil",
+		}
+	}
+
+	return out.WorkGroup, nil
+}
+
+func athenaWorkgroupItemMapper(_ *string, scope string, awsItem *types.WorkGroup) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "athena-workgroup",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	config := awsItem.Configuration
+
+	if config == nil {
+		return &item, nil
+	}
+
+	if rc := config.ResultConfiguration; rc != nil {
+		if rc.OutputLocation != nil {
+			if bucket, ok := s3BucketFromURI(*rc.OutputLocation); ok {
+				// Results can be written to a bucket owned by another account
+				accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+				if rc.ExpectedBucketOwner != nil {
+					accountID = *rc.ExpectedBucketOwner
+				}
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Queries fail if results can't be written
+						In: true,
+						// Query results are written to the bucket
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if rc.EncryptionConfiguration != nil && rc.EncryptionConfiguration.KmsKey != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*rc.EncryptionConfiguration.KmsKey, scope))
+		}
+	}
+
+	if config.CustomerContentEncryptionConfiguration != nil && config.CustomerContentEncryptionConfiguration.KmsKey != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*config.CustomerContentEncryptionConfiguration.KmsKey, scope))
+	}
+
+	if config.ExecutionRole != nil {
+		// Changing the role affects what Spark sessions can access
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*config.ExecutionRole, scope))
+	}
+
+	return &item, nil
+}
+
+func NewAthenaWorkgroupAdapter(client AthenaClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*athena.ListWorkGroupsInput, *athena.ListWorkGroupsOutput, *types.WorkGroup, AthenaClient, *athena.Options] {
+	return &adapterhelpers.GetListAdapterV2[*athena.ListWorkGroupsInput, *athena.ListWorkGroupsOutput, *types.WorkGroup, AthenaClient, *athena.Options]{
+		ItemType:        "athena-workgroup",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: athenaWorkgroupAdapterMetadata,
+		GetFunc:         athenaWorkgroupGetFunc,
+		InputMapperList: func(scope string) (*athena.ListWorkGroupsInput, error) {
+			return &athena.ListWorkGroupsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client AthenaClient, input *athena.ListWorkGroupsInput) adapterhelpers.Paginator[*athena.ListWorkGroupsOutput, *athena.Options] {
+			return athena.NewListWorkGroupsPaginator(client, input)
+		},
+		// The summary doesn't include the configuration, so get each
+		// workgroup
+		ListExtractor: func(ctx context.Context, output *athena.ListWorkGroupsOutput, client AthenaClient) ([]*types.WorkGroup, error) {
+			workgroups := make([]*types.WorkGroup, 0, len(output.WorkGroups))
+
+			for _, summary := range output.WorkGroups {
+				out, err := client.GetWorkGroup(ctx, &athena.GetWorkGroupInput{
+					WorkGroup: summary.Name,
+				})
+
+				if err != nil {
+					return nil, err
+				}
+
+				if out.WorkGroup != nil {
+					workgroups = append(workgroups, out.WorkGroup)
+				}
+			}
+
+			return workgroups, nil
+		},
+		ItemMapper: athenaWorkgroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, workgroup *types.WorkGroup, client AthenaClient) (map[string]string, error) {
+			if workgroup.Name == nil {
+				return nil, nil
+			}
+
+			a := arn.ARN{
+				Partition: adapterhelpers.PartitionFromRegion(region),
+				Service:   "athena",
+				Region:    region,
+				AccountID: accountID,
+				Resource:  "workgroup/" + *workgroup.Name,
+			}
+
+			return athenaListTags(ctx, client, a.String())
+		},
+	}
+}
+
+var athenaWorkgroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "athena-workgroup",
+	DescriptiveName: "Athena Workgroup",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an Athena workgroup by name",
+		ListDescription:   "List all Athena workgroups",
+		SearchDescription: "Search for Athena workgroups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_athena_workgroup.name"},
+	},
+	PotentialLinks: []string{"s3-bucket", "kms-key", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestAthenaWorkgroupItemMapper(t *testing.T) {
+	workgroup := &types.WorkGroup{
+		Name:         adapterhelpers.PtrString("analysts"),
+		State:        types.WorkGroupStateEnabled,
+		CreationTime: adapterhelpers.PtrTime(time.Now()),
+		Configuration: &types.WorkGroupConfiguration{
+			EnforceWorkGroupConfiguration: adapterhelpers.PtrBool(true),
+			ExecutionRole:                 adapterhelpers.PtrString("arn:aws:iam::123456789012:role/athena-spark"),
+			ResultConfiguration: &types.ResultConfiguration{
+				OutputLocation:      adapterhelpers.PtrString("s3://athena-results-analysts/output/"),
+				ExpectedBucketOwner: adapterhelpers.PtrString("210987654321"),
+				EncryptionConfiguration: &types.EncryptionConfiguration{
+					EncryptionOption: types.EncryptionOptionSseKms,
+					KmsKey:           adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+				},
+			},
+		},
+	}
+
+	item, err := athenaWorkgroupItemMapper(nil, "123456789012.eu-west-2", workgroup)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "athena-results-analysts",
+			ExpectedScope:  "210987654321",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/athena-spark",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewAthenaWorkgroupAdapter(t *testing.T) {
+	client, account, region := athenaGetAutoConfig(t)
+
+	adapter := NewAthenaWorkgroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,39 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+)
+
+type AthenaClient interface {
+	GetWorkGroup(ctx context.Context, params *athena.GetWorkGroupInput, optFns ...func(*athena.Options)) (*athena.GetWorkGroupOutput, error)
+
+	athena.ListTagsForResourceAPIClient
+	athena.ListWorkGroupsAPIClient
+}
+
+// athenaListTags Gets the tags for an Athena resource
+func athenaListTags(ctx context.Context, client AthenaClient, arn string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	paginator := athena.NewListTagsForResourcePaginator(client, &athena.ListTagsForResourceInput{
+		ResourceARN: &arn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/athena"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func athenaGetAutoConfig(t *testing.T) (*athena.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := athena.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -0,0 +1,273 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func batchComputeEnvironmentOutputMapper(_ context.Context, _ BatchClient, scope string, _ *batch.DescribeComputeEnvironmentsInput, output *batch.DescribeComputeEnvironmentsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, ce := range output.ComputeEnvironments {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(ce, "Tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "batch-compute-environment",
+			UniqueAttribute: "ComputeEnvironmentName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            ce.Tags,
+			Health:          batchStatusToHealth(string(ce.Status), string(ce.State)),
+		}
+
+		if ce.EcsClusterArn != nil {
+			if a, err := adapterhelpers.ParseARN(*ce.EcsClusterArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ecs-cluster",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *ce.EcsClusterArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Jobs run as tasks in the cluster
+						In: true,
+						// Batch manages the capacity of the cluster
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if ce.EksConfiguration != nil && ce.EksConfiguration.EksClusterArn != nil {
+			if a, err := adapterhelpers.ParseARN(*ce.EksConfiguration.EksClusterArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "eks-cluster",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *ce.EksConfiguration.EksClusterArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Jobs run as pods in the cluster
+						In: true,
+						// Batch adds and removes nodes from the cluster
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if ce.ServiceRole != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*ce.ServiceRole, scope))
+		}
+
+		if cr := ce.ComputeResources; cr != nil {
+			for _, subnet := range cr.Subnets {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-subnet",
+						Method: sdp.QueryMethod_GET,
+						Query:  subnet,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Instances are launched in the subnet
+						In: true,
+						// The compute environment can't affect the subnet
+						Out: false,
+					},
+				})
+			}
+
+			for _, sg := range cr.SecurityGroupIds {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-security-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  sg,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the security group affect the instances
+						In: true,
+						// The compute environment can't affect the security
+						// group
+						Out: false,
+					},
+				})
+			}
+
+			if cr.LaunchTemplate != nil {
+				// Launch templates can be specified by name or ID, but we can
+				// only link by ID
+				templateIDs := make([]string, 0)
+
+				if cr.LaunchTemplate.LaunchTemplateId != nil {
+					templateIDs = append(templateIDs, *cr.LaunchTemplate.LaunchTemplateId)
+				}
+
+				for _, override := range cr.LaunchTemplate.Overrides {
+					if override.LaunchTemplateId != nil {
+						templateIDs = append(templateIDs, *override.LaunchTemplateId)
+					}
+				}
+
+				for _, id := range templateIDs {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ec2-launch-template",
+							Method: sdp.QueryMethod_GET,
+							Query:  id,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The template configures the instances
+							In: true,
+							// The compute environment can't affect the
+							// template
+							Out: false,
+						},
+					})
+				}
+			}
+
+			if cr.InstanceRole != nil {
+				// This is actually an instance profile and can be a name or
+				// ARN
+				query := &sdp.Query{
+					Type:   "iam-instance-profile",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cr.InstanceRole,
+				}
+
+				if a, err := adapterhelpers.ParseARN(*cr.InstanceRole); err == nil {
+					query.Method = sdp.QueryMethod_SEARCH
+					query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+				} else {
+					accountID, _, _ := adapterhelpers.ParseScope(scope)
+					query.Scope = adapterhelpers.FormatScope(accountID, "")
+				}
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: query,
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the profile affect what the instances can
+						// access
+						In: true,
+						// The compute environment can't affect the profile
+						Out: false,
+					},
+				})
+			}
+
+			if cr.SpotIamFleetRole != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*cr.SpotIamFleetRole, scope))
+			}
+
+			if cr.Ec2KeyPair != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-key-pair",
+						Method: sdp.QueryMethod_GET,
+						Query:  *cr.Ec2KeyPair,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The key pair is installed on the instances
+						In: true,
+						// The compute environment can't affect the key pair
+						Out: false,
+					},
+				})
+			}
+
+			imageIDs := make([]string, 0)
+
+			if cr.ImageId != nil {
+				imageIDs = append(imageIDs, *cr.ImageId)
+			}
+
+			for _, config := range cr.Ec2Configuration {
+				if config.ImageIdOverride != nil {
+					imageIDs = append(imageIDs, *config.ImageIdOverride)
+				}
+			}
+
+			for _, id := range imageIDs {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-image",
+						Method: sdp.QueryMethod_GET,
+						Query:  id,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The image is used to launch the instances
+						In: true,
+						// The compute environment can't affect the image
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewBatchComputeEnvironmentAdapter(client BatchClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*batch.DescribeComputeEnvironmentsInput, *batch.DescribeComputeEnvironmentsOutput, BatchClient, *batch.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*batch.DescribeComputeEnvironmentsInput, *batch.DescribeComputeEnvironmentsOutput, BatchClient, *batch.Options]{
+		ItemType:        "batch-compute-environment",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: batchComputeEnvironmentAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client BatchClient, input *batch.DescribeComputeEnvironmentsInput) (*batch.DescribeComputeEnvironmentsOutput, error) {
+			return client.DescribeComputeEnvironments(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*batch.DescribeComputeEnvironmentsInput, error) {
+			return &batch.DescribeComputeEnvironmentsInput{
+				ComputeEnvironments: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*batch.DescribeComputeEnvironmentsInput, error) {
+			return &batch.DescribeComputeEnvironmentsInput{}, nil
+		},
+		PaginatorBuilder: func(client BatchClient, params *batch.DescribeComputeEnvironmentsInput) adapterhelpers.Paginator[*batch.DescribeComputeEnvironmentsOutput, *batch.Options] {
+			return batch.NewDescribeComputeEnvironmentsPaginator(client, params)
+		},
+		OutputMapper: batchComputeEnvironmentOutputMapper,
+	}
+}
+
+var batchComputeEnvironmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "batch-compute-environment",
+	DescriptiveName: "Batch Compute Environment",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Batch compute environment by name",
+		ListDescription:   "List all Batch compute environments",
+		SearchDescription: "Search for Batch compute environments by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_batch_compute_environment.arn",
+		},
+	},
+	PotentialLinks: []string{"ecs-cluster", "eks-cluster", "iam-role", "ec2-subnet", "ec2-security-group", "ec2-launch-template", "iam-instance-profile", "ec2-key-pair", "ec2-image"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,142 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestBatchComputeEnvironmentOutputMapper(t *testing.T) {
+	output := &batch.DescribeComputeEnvironmentsOutput{
+		ComputeEnvironments: []types.ComputeEnvironmentDetail{
+			{
+				ComputeEnvironmentName: adapterhelpers.PtrString("nightly-spot"),
+				ComputeEnvironmentArn:  adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:compute-environment/nightly-spot"),
+				EcsClusterArn:          adapterhelpers.PtrString("arn:aws:ecs:eu-west-2:123456789012:cluster/AWSBatch-nightly-spot-1a2b3c"),
+				ServiceRole:            adapterhelpers.PtrString("arn:aws:iam::123456789012:role/aws-service-role/batch.amazonaws.com/AWSServiceRoleForBatch"),
+				State:                  types.CEStateEnabled,
+				Status:                 types.CEStatusValid,
+				Type:                   types.CETypeManaged,
+				Tags: map[string]string{
+					"team": "data",
+				},
+				ComputeResources: &types.ComputeResource{
+					Type:             types.CRTypeSpot,
+					MaxvCpus:         adapterhelpers.PtrInt32(256),
+					Subnets:          []string{"subnet-0a1b2c3d"},
+					SecurityGroupIds: []string{"sg-0a1b2c3d"},
+					InstanceRole:     adapterhelpers.PtrString("arn:aws:iam::123456789012:instance-profile/ecsInstanceRole"),
+					SpotIamFleetRole: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/AmazonEC2SpotFleetTaggingRole"),
+					Ec2KeyPair:       adapterhelpers.PtrString("batch-debug"),
+					LaunchTemplate: &types.LaunchTemplateSpecification{
+						LaunchTemplateId: adapterhelpers.PtrString("lt-0a1b2c3d"),
+					},
+					Ec2Configuration: []types.Ec2Configuration{
+						{
+							ImageType:       adapterhelpers.PtrString("ECS_AL2"),
+							ImageIdOverride: adapterhelpers.PtrString("ami-0a1b2c3d"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := batchComputeEnvironmentOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecs-cluster",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ecs:eu-west-2:123456789012:cluster/AWSBatch-nightly-spot-1a2b3c",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-service-role/batch.amazonaws.com/AWSServiceRoleForBatch",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:instance-profile/ecsInstanceRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/AmazonEC2SpotFleetTaggingRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-key-pair",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "batch-debug",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewBatchComputeEnvironmentAdapter(t *testing.T) {
+	client, account, region := batchGetAutoConfig(t)
+
+	adapter := NewBatchComputeEnvironmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,227 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func batchJobDefinitionOutputMapper(_ context.Context, _ BatchClient, scope string, _ *batch.DescribeJobDefinitionsInput, output *batch.DescribeJobDefinitionsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, jd := range output.JobDefinitions {
+		if jd.JobDefinitionName == nil || jd.Revision == nil {
+			continue
+		}
+
+		attributes, err := adapterhelpers.ToAttributesWithExclude(jd, "Tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The uniqueAttributeValue for this is a custom field:
+		// {name}:{revision}
+		if err = attributes.Set("UniqueName", fmt.Sprintf("%v:%v", *jd.JobDefinitionName, *jd.Revision)); err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "batch-job-definition",
+			UniqueAttribute: "UniqueName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            jd.Tags,
+		}
+
+		if jd.Status != nil {
+			switch *jd.Status {
+			case "ACTIVE":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "INACTIVE":
+				item.Health = nil
+			}
+		}
+
+		// Containers can be defined in a number of places depending on
+		// whether the job runs on ECS, EKS or is a multi-node parallel job.
+		// Many containers will share the same role and image so dedupe them
+		seen := make(map[string]bool)
+
+		addLink := func(link *sdp.LinkedItemQuery) {
+			if link == nil {
+				return
+			}
+
+			key := link.GetQuery().GetType() + link.GetQuery().GetQuery()
+
+			if !seen[key] {
+				seen[key] = true
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		addRole := func(role *string) {
+			if role != nil && *role != "" {
+				addLink(iamRoleLink(*role, scope))
+			}
+		}
+
+		addImage := func(image *string) {
+			if image != nil {
+				addLink(batchImageLink(*image))
+			}
+		}
+
+		addSecrets := func(secrets []types.Secret, logConfig *types.LogConfiguration) {
+			for _, secret := range secrets {
+				addLink(secretValueFromLinkedItem(secret.ValueFrom))
+			}
+
+			if logConfig != nil {
+				for _, secret := range logConfig.SecretOptions {
+					addLink(secretValueFromLinkedItem(secret.ValueFrom))
+				}
+			}
+		}
+
+		addContainer := func(container *types.ContainerProperties) {
+			if container == nil {
+				return
+			}
+
+			addImage(container.Image)
+			addRole(container.JobRoleArn)
+			addRole(container.ExecutionRoleArn)
+			addSecrets(container.Secrets, container.LogConfiguration)
+
+			if newQueries, err := sdp.ExtractLinksFrom(container.Environment); err == nil {
+				for _, link := range newQueries {
+					addLink(link)
+				}
+			}
+		}
+
+		addECS := func(properties *types.EcsProperties) {
+			if properties == nil {
+				return
+			}
+
+			for _, task := range properties.TaskProperties {
+				addRole(task.TaskRoleArn)
+				addRole(task.ExecutionRoleArn)
+
+				for _, container := range task.Containers {
+					addImage(container.Image)
+					addSecrets(container.Secrets, container.LogConfiguration)
+
+					if newQueries, err := sdp.ExtractLinksFrom(container.Environment); err == nil {
+						for _, link := range newQueries {
+							addLink(link)
+						}
+					}
+				}
+			}
+		}
+
+		addEKS := func(properties *types.EksProperties) {
+			if properties == nil || properties.PodProperties == nil {
+				return
+			}
+
+			for _, container := range properties.PodProperties.InitContainers {
+				addImage(container.Image)
+			}
+
+			for _, container := range properties.PodProperties.Containers {
+				addImage(container.Image)
+			}
+		}
+
+		addContainer(jd.ContainerProperties)
+		addECS(jd.EcsProperties)
+		addEKS(jd.EksProperties)
+
+		if jd.NodeProperties != nil {
+			for _, nodeRange := range jd.NodeProperties.NodeRangeProperties {
+				addContainer(nodeRange.Container)
+				addECS(nodeRange.EcsProperties)
+				addEKS(nodeRange.EksProperties)
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewBatchJobDefinitionAdapter(client BatchClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobDefinitionsInput, *batch.DescribeJobDefinitionsOutput, BatchClient, *batch.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobDefinitionsInput, *batch.DescribeJobDefinitionsOutput, BatchClient, *batch.Options]{
+		ItemType:        "batch-job-definition",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: batchJobDefinitionAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client BatchClient, input *batch.DescribeJobDefinitionsInput) (*batch.DescribeJobDefinitionsOutput, error) {
+			return client.DescribeJobDefinitions(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*batch.DescribeJobDefinitionsInput, error) {
+			// AWS supports "name:revision" format as an input here so we can
+			// just push it in directly
+			return &batch.DescribeJobDefinitionsInput{
+				JobDefinitions: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*batch.DescribeJobDefinitionsInput, error) {
+			// Every update creates a new revision and the old ones are
+			// deregistered, so only list the active ones
+			return &batch.DescribeJobDefinitionsInput{
+				Status: adapterhelpers.PtrString("ACTIVE"),
+			}, nil
+		},
+		InputMapperSearch: func(ctx context.Context, client BatchClient, scope, query string) (*batch.DescribeJobDefinitionsInput, error) {
+			if _, err := adapterhelpers.ParseARN(query); err == nil {
+				return &batch.DescribeJobDefinitionsInput{
+					JobDefinitions: []string{query},
+				}, nil
+			}
+
+			// Otherwise return all active revisions with this name
+			return &batch.DescribeJobDefinitionsInput{
+				JobDefinitionName: &query,
+				Status:            adapterhelpers.PtrString("ACTIVE"),
+			}, nil
+		},
+		PaginatorBuilder: func(client BatchClient, params *batch.DescribeJobDefinitionsInput) adapterhelpers.Paginator[*batch.DescribeJobDefinitionsOutput, *batch.Options] {
+			return batch.NewDescribeJobDefinitionsPaginator(client, params)
+		},
+		OutputMapper: batchJobDefinitionOutputMapper,
+	}
+}
+
+var batchJobDefinitionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "batch-job-definition",
+	DescriptiveName: "Batch Job Definition",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Batch job definition by revision name ({name}:{revision})",
+		ListDescription:   "List all active Batch job definitions",
+		SearchDescription: "Search for Batch job definitions by ARN, or by name to return all active revisions",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_batch_job_definition.arn",
+		},
+	},
+	PotentialLinks: []string{"iam-role", "ecr-repository", "secretsmanager-secret", "ssm-parameter"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestBatchJobDefinitionOutputMapper(t *testing.T) {
+	output := &batch.DescribeJobDefinitionsOutput{
+		JobDefinitions: []types.JobDefinition{
+			{
+				JobDefinitionName: adapterhelpers.PtrString("orders-export"),
+				JobDefinitionArn:  adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:job-definition/orders-export:3"),
+				Revision:          adapterhelpers.PtrInt32(3),
+				Status:            adapterhelpers.PtrString("ACTIVE"),
+				Type:              adapterhelpers.PtrString("container"),
+				ContainerProperties: &types.ContainerProperties{
+					Image:            adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/orders-export:latest"),
+					JobRoleArn:       adapterhelpers.PtrString("arn:aws:iam::123456789012:role/orders-export"),
+					ExecutionRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/batch-execution"),
+					Secrets: []types.Secret{
+						{
+							Name:      adapterhelpers.PtrString("DB_PASSWORD"),
+							ValueFrom: adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:123456789012:secret:orders-db-1a2b3c"),
+						},
+					},
+					LogConfiguration: &types.LogConfiguration{
+						LogDriver: types.LogDriverAwslogs,
+						SecretOptions: []types.Secret{
+							{
+								Name:      adapterhelpers.PtrString("token"),
+								ValueFrom: adapterhelpers.PtrString("arn:aws:ssm:eu-west-2:123456789012:parameter/log-token"),
+							},
+						},
+					},
+				},
+				NodeProperties: &types.NodeProperties{
+					NodeRangeProperties: []types.NodeRangeProperty{
+						{
+							// The same image and role again, this shouldn't
+							// be linked twice
+							Container: &types.ContainerProperties{
+								Image:      adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/orders-export:latest"),
+								JobRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/orders-export"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := batchJobDefinitionOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "orders-export:3" {
+		t.Errorf("expected unique attribute value to be orders-export:3, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders-export",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/orders-export",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/batch-execution",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:123456789012:secret:orders-db-1a2b3c",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-parameter",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ssm:eu-west-2:123456789012:parameter/log-token",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewBatchJobDefinitionAdapter(t *testing.T) {
+	client, account, region := batchGetAutoConfig(t)
+
+	adapter := NewBatchJobDefinitionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func batchJobQueueOutputMapper(_ context.Context, _ BatchClient, scope string, _ *batch.DescribeJobQueuesInput, output *batch.DescribeJobQueuesOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, queue := range output.JobQueues {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(queue, "Tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "batch-job-queue",
+			UniqueAttribute: "JobQueueName",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            queue.Tags,
+			Health:          batchStatusToHealth(string(queue.Status), string(queue.State)),
+		}
+
+		for _, order := range queue.ComputeEnvironmentOrder {
+			if order.ComputeEnvironment == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*order.ComputeEnvironment); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "batch-compute-environment",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *order.ComputeEnvironment,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Jobs in the queue can't run without compute
+						In: true,
+						// Jobs from the queue scale the compute environment
+						Out: true,
+					},
+				})
+			}
+		}
+
+		if queue.SchedulingPolicyArn != nil {
+			if a, err := adapterhelpers.ParseARN(*queue.SchedulingPolicyArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "batch-scheduling-policy",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *queue.SchedulingPolicyArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The policy controls the order that jobs run in
+						In: true,
+						// The queue can't affect the policy
+						Out: false,
+					},
+				})
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewBatchJobQueueAdapter(client BatchClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobQueuesInput, *batch.DescribeJobQueuesOutput, BatchClient, *batch.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*batch.DescribeJobQueuesInput, *batch.DescribeJobQueuesOutput, BatchClient, *batch.Options]{
+		ItemType:        "batch-job-queue",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: batchJobQueueAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client BatchClient, input *batch.DescribeJobQueuesInput) (*batch.DescribeJobQueuesOutput, error) {
+			return client.DescribeJobQueues(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*batch.DescribeJobQueuesInput, error) {
+			return &batch.DescribeJobQueuesInput{
+				JobQueues: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*batch.DescribeJobQueuesInput, error) {
+			return &batch.DescribeJobQueuesInput{}, nil
+		},
+		PaginatorBuilder: func(client BatchClient, params *batch.DescribeJobQueuesInput) adapterhelpers.Paginator[*batch.DescribeJobQueuesOutput, *batch.Options] {
+			return batch.NewDescribeJobQueuesPaginator(client, params)
+		},
+		OutputMapper: batchJobQueueOutputMapper,
+	}
+}
+
+var batchJobQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "batch-job-queue",
+	DescriptiveName: "Batch Job Queue",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Batch job queue by name",
+		ListDescription:   "List all Batch job queues",
+		SearchDescription: "Search for Batch job queues by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_batch_job_queue.arn",
+		},
+	},
+	PotentialLinks: []string{"batch-compute-environment", "batch-scheduling-policy"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/aws/aws-sdk-go-v2/service/batch/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestBatchJobQueueOutputMapper(t *testing.T) {
+	output := &batch.DescribeJobQueuesOutput{
+		JobQueues: []types.JobQueueDetail{
+			{
+				JobQueueName:        adapterhelpers.PtrString("nightly"),
+				JobQueueArn:         adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:job-queue/nightly"),
+				Priority:            adapterhelpers.PtrInt32(10),
+				State:               types.JQStateDisabled,
+				Status:              types.JQStatusValid,
+				SchedulingPolicyArn: adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:scheduling-policy/fair-share"),
+				ComputeEnvironmentOrder: []types.ComputeEnvironmentOrder{
+					{
+						Order:              adapterhelpers.PtrInt32(1),
+						ComputeEnvironment: adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:compute-environment/nightly-spot"),
+					},
+					{
+						Order:              adapterhelpers.PtrInt32(2),
+						ComputeEnvironment: adapterhelpers.PtrString("arn:aws:batch:eu-west-2:123456789012:compute-environment/nightly-on-demand"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := batchJobQueueOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "batch-compute-environment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:batch:eu-west-2:123456789012:compute-environment/nightly-spot",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "batch-compute-environment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:batch:eu-west-2:123456789012:compute-environment/nightly-on-demand",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "batch-scheduling-policy",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:batch:eu-west-2:123456789012:scheduling-policy/fair-share",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewBatchJobQueueAdapter(t *testing.T) {
+	client, account, region := batchGetAutoConfig(t)
+
+	adapter := NewBatchJobQueueAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
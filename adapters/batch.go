@@ -0,0 +1,82 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type BatchClient interface {
+	DescribeComputeEnvironments(ctx context.Context, params *batch.DescribeComputeEnvironmentsInput, optFns ...func(*batch.Options)) (*batch.DescribeComputeEnvironmentsOutput, error)
+	DescribeJobQueues(ctx context.Context, params *batch.DescribeJobQueuesInput, optFns ...func(*batch.Options)) (*batch.DescribeJobQueuesOutput, error)
+	DescribeJobDefinitions(ctx context.Context, params *batch.DescribeJobDefinitionsInput, optFns ...func(*batch.Options)) (*batch.DescribeJobDefinitionsOutput, error)
+}
+
+// batchStatusToHealth Converts the status and state of a compute environment
+// or job queue to a health state. Both share the same set of values
+func batchStatusToHealth(status string, state string) *sdp.Health {
+	switch status {
+	case "CREATING", "UPDATING", "DELETING":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case "INVALID":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case "VALID":
+		if state == "DISABLED" {
+			// Nothing will be scheduled until it is enabled again
+			return sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		return sdp.Health_HEALTH_OK.Enum()
+	}
+
+	return nil
+}
+
+// batchImageLink Links to the ECR repository that a container image is pulled
+// from. Images from other registries are not linked. ECR images are in the
+// format {account}.dkr.ecr.{region}.amazonaws.com/{repository}[:tag|@digest]
+func batchImageLink(image string) *sdp.LinkedItemQuery {
+	host, path, found := strings.Cut(image, "/")
+
+	if !found {
+		return nil
+	}
+
+	hostParts := strings.Split(host, ".")
+
+	if len(hostParts) < 6 || hostParts[1] != "dkr" || hostParts[2] != "ecr" {
+		return nil
+	}
+
+	// Strip the digest or tag to leave the repository name
+	if i := strings.Index(path, "@"); i >= 0 {
+		path = path[:i]
+	}
+
+	if i := strings.LastIndex(path, ":"); i >= 0 {
+		path = path[:i]
+	}
+
+	if path == "" {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ecr-repository",
+			Method: sdp.QueryMethod_GET,
+			Query:  path,
+			Scope:  adapterhelpers.FormatScope(hostParts[0], hostParts[3]),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Pushing a new image changes what the jobs run
+			In: true,
+			// Jobs can't affect the repository
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/batch"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func batchGetAutoConfig(t *testing.T) (*batch.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := batch.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestBatchStatusToHealth(t *testing.T) {
+	tests := []struct {
+		Status   string
+		State    string
+		Expected *sdp.Health
+	}{
+		{Status: "VALID", State: "ENABLED", Expected: sdp.Health_HEALTH_OK.Enum()},
+		{Status: "VALID", State: "DISABLED", Expected: sdp.Health_HEALTH_WARNING.Enum()},
+		{Status: "UPDATING", State: "ENABLED", Expected: sdp.Health_HEALTH_PENDING.Enum()},
+		{Status: "INVALID", State: "ENABLED", Expected: sdp.Health_HEALTH_ERROR.Enum()},
+		{Status: "DELETED", State: "DISABLED", Expected: nil},
+	}
+
+	for _, test := range tests {
+		health := batchStatusToHealth(test.Status, test.State)
+
+		if (health == nil) != (test.Expected == nil) || (health != nil && *health != *test.Expected) {
+			t.Errorf("expected %v for %v/%v, got %v", test.Expected, test.Status, test.State, health)
+		}
+	}
+}
+
+func TestBatchImageLink(t *testing.T) {
+	tests := []struct {
+		Image         string
+		ExpectedQuery string
+		ExpectedScope string
+	}{
+		{
+			Image:         "123456789012.dkr.ecr.eu-west-2.amazonaws.com/nightly/etl:latest",
+			ExpectedQuery: "nightly/etl",
+			ExpectedScope: "123456789012.eu-west-2",
+		},
+		{
+			Image:         "123456789012.dkr.ecr.us-east-1.amazonaws.com/etl@sha256:0123456789abcdef",
+			ExpectedQuery: "etl",
+			ExpectedScope: "123456789012.us-east-1",
+		},
+		{
+			Image:         "123456789012.dkr.ecr.us-east-1.amazonaws.com/etl",
+			ExpectedQuery: "etl",
+			ExpectedScope: "123456789012.us-east-1",
+		},
+	}
+
+	for _, test := range tests {
+		link := batchImageLink(test.Image)
+
+		if link == nil {
+			t.Errorf("expected a link for %v", test.Image)
+			continue
+		}
+
+		if link.GetQuery().GetQuery() != test.ExpectedQuery {
+			t.Errorf("expected query %v for %v, got %v", test.ExpectedQuery, test.Image, link.GetQuery().GetQuery())
+		}
+
+		if link.GetQuery().GetScope() != test.ExpectedScope {
+			t.Errorf("expected scope %v for %v, got %v", test.ExpectedScope, test.Image, link.GetQuery().GetScope())
+		}
+	}
+
+	for _, image := range []string{"busybox", "public.ecr.aws/amazonlinux/amazonlinux:2023", "ghcr.io/example/etl:1.0"} {
+		if link := batchImageLink(image); link != nil {
+			t.Errorf("expected no link for %v, got %v", image, link)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// cloudhsmv2ClusterHealth Converts the state of a cluster and its HSMs to a
+// health state
+func cloudhsmv2ClusterHealth(cluster types.Cluster) *sdp.Health {
+	switch cluster.State {
+	case types.ClusterStateActive:
+		for _, hsm := range cluster.Hsms {
+			if hsm.State == types.HsmStateDegraded {
+				// The cluster still works, but has less capacity and
+				// redundancy than expected
+				return sdp.Health_HEALTH_WARNING.Enum()
+			}
+		}
+
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.ClusterStateCreateInProgress,
+		types.ClusterStateUninitialized,
+		types.ClusterStateInitializeInProgress,
+		types.ClusterStateInitialized,
+		types.ClusterStateUpdateInProgress,
+		types.ClusterStateModifyInProgress,
+		types.ClusterStateRollbackInProgress,
+		types.ClusterStateDeleteInProgress:
+		// Clusters can't be used until they have been initialized and
+		// activated
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.ClusterStateDegraded:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	return nil
+}
+
+func cloudhsmv2ClusterOutputMapper(_ context.Context, _ CloudHSMV2Client, scope string, _ *cloudhsmv2.DescribeClustersInput, output *cloudhsmv2.DescribeClustersOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, cluster := range output.Clusters {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(cluster, "TagList")
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "cloudhsmv2-cluster",
+			UniqueAttribute: "ClusterId",
+			Attributes:      attributes,
+			Scope:           scope,
+			Tags:            cloudhsmv2TagsToMap(cluster.TagList),
+			Health:          cloudhsmv2ClusterHealth(cluster),
+		}
+
+		if cluster.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The HSMs are only reachable from within the VPC
+					In: true,
+					// The cluster can't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		if cluster.SecurityGroup != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cluster.SecurityGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group controls which clients can reach
+					// the HSMs
+					In: true,
+					// The security group is created and managed by the
+					// cluster
+					Out: true,
+				},
+			})
+		}
+
+		// The subnet mapping contains every subnet the cluster can use, while
+		// the HSMs may be in a subset of these. Deduplicate so that each
+		// subnet is only linked once
+		subnets := make(map[string]bool)
+
+		for _, subnetID := range cluster.SubnetMapping {
+			subnets[subnetID] = true
+		}
+
+		for _, hsm := range cluster.Hsms {
+			if hsm.SubnetId != nil {
+				subnets[*hsm.SubnetId] = true
+			}
+
+			if hsm.EniId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-network-interface",
+						Method: sdp.QueryMethod_GET,
+						Query:  *hsm.EniId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Clients reach the HSM through its interface
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+
+			if hsm.EniIp != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ip",
+						Method: sdp.QueryMethod_GET,
+						Query:  *hsm.EniIp,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// IPs are always linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		subnetIDs := make([]string, 0, len(subnets))
+
+		for subnetID := range subnets {
+			subnetIDs = append(subnetIDs, subnetID)
+		}
+
+		sort.Strings(subnetIDs)
+
+		for _, subnetID := range subnetIDs {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnetID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The HSMs can't be reached if the subnet breaks
+					In: true,
+					// The cluster can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewCloudHSMV2ClusterAdapter(client CloudHSMV2Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*cloudhsmv2.DescribeClustersInput, *cloudhsmv2.DescribeClustersOutput, CloudHSMV2Client, *cloudhsmv2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*cloudhsmv2.DescribeClustersInput, *cloudhsmv2.DescribeClustersOutput, CloudHSMV2Client, *cloudhsmv2.Options]{
+		ItemType:        "cloudhsmv2-cluster",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cloudhsmv2ClusterAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client CloudHSMV2Client, input *cloudhsmv2.DescribeClustersInput) (*cloudhsmv2.DescribeClustersOutput, error) {
+			return client.DescribeClusters(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*cloudhsmv2.DescribeClustersInput, error) {
+			return &cloudhsmv2.DescribeClustersInput{
+				Filters: map[string][]string{
+					"clusterIds": {query},
+				},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*cloudhsmv2.DescribeClustersInput, error) {
+			return &cloudhsmv2.DescribeClustersInput{}, nil
+		},
+		PaginatorBuilder: func(client CloudHSMV2Client, params *cloudhsmv2.DescribeClustersInput) adapterhelpers.Paginator[*cloudhsmv2.DescribeClustersOutput, *cloudhsmv2.Options] {
+			return cloudhsmv2.NewDescribeClustersPaginator(client, params)
+		},
+		OutputMapper: cloudhsmv2ClusterOutputMapper,
+	}
+}
+
+var cloudhsmv2ClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cloudhsmv2-cluster",
+	DescriptiveName: "CloudHSM Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a CloudHSM cluster by ID",
+		ListDescription:   "List all CloudHSM clusters",
+		SearchDescription: "Search for CloudHSM clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudhsm_v2_cluster.cluster_id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-security-group", "ec2-subnet", "ec2-network-interface", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
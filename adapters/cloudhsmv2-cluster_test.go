@@ -0,0 +1,187 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCloudHSMV2ClusterOutputMapper(t *testing.T) {
+	output := &cloudhsmv2.DescribeClustersOutput{
+		Clusters: []types.Cluster{
+			{
+				BackupPolicy:  types.BackupPolicyDefault,
+				ClusterId:     adapterhelpers.PtrString("cluster-igklspoyj5v"),
+				HsmType:       adapterhelpers.PtrString("hsm2m.medium"),
+				Mode:          types.ClusterModeFips,
+				NetworkType:   types.NetworkTypeIpv4,
+				SecurityGroup: adapterhelpers.PtrString("sg-0b7f3c2a1d4e5f6a7"),
+				State:         types.ClusterStateActive,
+				SubnetMapping: map[string]string{
+					"eu-west-2a": "subnet-0a1b2c3d4e5f6a7b8",
+					"eu-west-2b": "subnet-0c9d8e7f6a5b4c3d2",
+				},
+				Hsms: []types.Hsm{
+					{
+						AvailabilityZone: adapterhelpers.PtrString("eu-west-2a"),
+						ClusterId:        adapterhelpers.PtrString("cluster-igklspoyj5v"),
+						EniId:            adapterhelpers.PtrString("eni-0f1e2d3c4b5a69788"),
+						EniIp:            adapterhelpers.PtrString("10.0.1.45"),
+						HsmId:            adapterhelpers.PtrString("hsm-ka2ewsxgh6a"),
+						State:            types.HsmStateActive,
+						SubnetId:         adapterhelpers.PtrString("subnet-0a1b2c3d4e5f6a7b8"),
+					},
+				},
+				TagList: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("team"),
+						Value: adapterhelpers.PtrString("security"),
+					},
+				},
+				VpcId: adapterhelpers.PtrString("vpc-0d4c3b2a1f0e9d8c7"),
+			},
+		},
+	}
+
+	items, err := cloudhsmv2ClusterOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "security" {
+		t.Errorf("expected tag team=security, got %v", item.GetTags())
+	}
+
+	subnetLinks := 0
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "ec2-subnet" {
+			subnetLinks++
+		}
+	}
+
+	if subnetLinks != 2 {
+		t.Errorf("expected 2 subnet links, got %v", subnetLinks)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d4c3b2a1f0e9d8c7",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b7f3c2a1d4e5f6a7",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eni-0f1e2d3c4b5a69788",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.45",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0c9d8e7f6a5b4c3d2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCloudHSMV2ClusterHealth(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Cluster  types.Cluster
+		Expected *sdp.Health
+	}{
+		{
+			Name:     "active",
+			Cluster:  types.Cluster{State: types.ClusterStateActive},
+			Expected: sdp.Health_HEALTH_OK.Enum(),
+		},
+		{
+			Name: "degraded hsm",
+			Cluster: types.Cluster{
+				State: types.ClusterStateActive,
+				Hsms:  []types.Hsm{{State: types.HsmStateDegraded}},
+			},
+			Expected: sdp.Health_HEALTH_WARNING.Enum(),
+		},
+		{
+			Name:     "uninitialized",
+			Cluster:  types.Cluster{State: types.ClusterStateUninitialized},
+			Expected: sdp.Health_HEALTH_PENDING.Enum(),
+		},
+		{
+			Name:     "degraded",
+			Cluster:  types.Cluster{State: types.ClusterStateDegraded},
+			Expected: sdp.Health_HEALTH_WARNING.Enum(),
+		},
+		{
+			Name:     "deleted",
+			Cluster:  types.Cluster{State: types.ClusterStateDeleted},
+			Expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			health := cloudhsmv2ClusterHealth(test.Cluster)
+
+			if (health == nil) != (test.Expected == nil) || (health != nil && *health != *test.Expected) {
+				t.Errorf("expected %v, got %v", test.Expected, health)
+			}
+		})
+	}
+}
+
+func TestNewCloudHSMV2ClusterAdapter(t *testing.T) {
+	client, account, region := cloudhsmv2GetAutoConfig(t)
+
+	adapter := NewCloudHSMV2ClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
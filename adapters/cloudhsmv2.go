@@ -0,0 +1,23 @@
+package adapters
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2/types"
+)
+
+type CloudHSMV2Client interface {
+	cloudhsmv2.DescribeClustersAPIClient
+}
+
+// Converts a slice of CloudHSM tags to a map
+func cloudhsmv2TagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func cloudhsmv2GetAutoConfig(t *testing.T) (*cloudhsmv2.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := cloudhsmv2.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -0,0 +1,129 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func eventDataStoreGetFunc(ctx context.Context, client CloudTrailClient, _, query string) (*cloudtrail.GetEventDataStoreOutput, error) {
+	// This accepts either the ARN or the ID of the event data store
+	return client.GetEventDataStore(ctx, &cloudtrail.GetEventDataStoreInput{
+		EventDataStore: &query,
+	})
+}
+
+// eventDataStoreStatusToHealth Converts the status of an event data store to a
+// health state
+func eventDataStoreStatusToHealth(status types.EventDataStoreStatus) *sdp.Health {
+	switch status {
+	case types.EventDataStoreStatusEnabled:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.EventDataStoreStatusCreated,
+		types.EventDataStoreStatusStartingIngestion,
+		types.EventDataStoreStatusStoppingIngestion,
+		types.EventDataStoreStatusPendingDeletion:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.EventDataStoreStatusStoppedIngestion:
+		// The store still exists but isn't receiving any events
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	return nil
+}
+
+func eventDataStoreItemMapper(_ *string, scope string, awsItem *cloudtrail.GetEventDataStoreOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "ResultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cloudtrail-event-data-store",
+		UniqueAttribute: "EventDataStoreArn",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          eventDataStoreStatusToHealth(awsItem.Status),
+	}
+
+	if awsItem.KmsKeyId != nil {
+		// This can be a key ID, alias or ARN
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.KmsKeyId, scope))
+	}
+
+	if awsItem.FederationRoleArn != nil {
+		// The role is used to query the store via Lake Formation
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.FederationRoleArn, scope))
+	}
+
+	return &item, nil
+}
+
+func NewCloudTrailEventDataStoreAdapter(client CloudTrailClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*cloudtrail.ListEventDataStoresInput, *cloudtrail.ListEventDataStoresOutput, *cloudtrail.GetEventDataStoreOutput, CloudTrailClient, *cloudtrail.Options] {
+	return &adapterhelpers.GetListAdapterV2[*cloudtrail.ListEventDataStoresInput, *cloudtrail.ListEventDataStoresOutput, *cloudtrail.GetEventDataStoreOutput, CloudTrailClient, *cloudtrail.Options]{
+		ItemType:        "cloudtrail-event-data-store",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cloudtrailEventDataStoreAdapterMetadata,
+		GetFunc:         eventDataStoreGetFunc,
+		InputMapperList: func(scope string) (*cloudtrail.ListEventDataStoresInput, error) {
+			return &cloudtrail.ListEventDataStoresInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client CloudTrailClient, input *cloudtrail.ListEventDataStoresInput) adapterhelpers.Paginator[*cloudtrail.ListEventDataStoresOutput, *cloudtrail.Options] {
+			return cloudtrail.NewListEventDataStoresPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *cloudtrail.ListEventDataStoresOutput, client CloudTrailClient) ([]*cloudtrail.GetEventDataStoreOutput, error) {
+			// The list API doesn't return the KMS key or federation details
+			// so we need to get each one individually
+			stores := make([]*cloudtrail.GetEventDataStoreOutput, 0, len(output.EventDataStores))
+
+			for _, eds := range output.EventDataStores {
+				if eds.EventDataStoreArn == nil {
+					continue
+				}
+
+				store, err := eventDataStoreGetFunc(ctx, client, "", *eds.EventDataStoreArn)
+
+				if err != nil {
+					return nil, err
+				}
+
+				stores = append(stores, store)
+			}
+
+			return stores, nil
+		},
+		ItemMapper: eventDataStoreItemMapper,
+		ListTagsFunc: func(ctx context.Context, eds *cloudtrail.GetEventDataStoreOutput, client CloudTrailClient) (map[string]string, error) {
+			if eds.EventDataStoreArn == nil {
+				return nil, nil
+			}
+
+			return cloudtrailListTags(ctx, client, *eds.EventDataStoreArn)
+		},
+	}
+}
+
+var cloudtrailEventDataStoreAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cloudtrail-event-data-store",
+	DescriptiveName: "CloudTrail Event Data Store",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a CloudTrail Lake event data store by ARN or ID",
+		ListDescription:   "List all CloudTrail Lake event data stores",
+		SearchDescription: "Search for CloudTrail Lake event data stores by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_cloudtrail_event_data_store.arn"},
+	},
+	PotentialLinks: []string{"kms-key", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
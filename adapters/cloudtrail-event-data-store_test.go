@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEventDataStoreItemMapper(t *testing.T) {
+	output := &cloudtrail.GetEventDataStoreOutput{
+		EventDataStoreArn:            adapterhelpers.PtrString("arn:aws:cloudtrail:eu-west-2:123456789012:eventdatastore/EXAMPLE-f852-4e8f-8bd1-bcf6cEXAMPLE"),
+		Name:                         adapterhelpers.PtrString("audit-store"),
+		Status:                       types.EventDataStoreStatusStoppedIngestion,
+		MultiRegionEnabled:           adapterhelpers.PtrBool(true),
+		OrganizationEnabled:          adapterhelpers.PtrBool(true),
+		RetentionPeriod:              adapterhelpers.PtrInt32(2557),
+		TerminationProtectionEnabled: adapterhelpers.PtrBool(true),
+		KmsKeyId:                     adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+		FederationRoleArn:            adapterhelpers.PtrString("arn:aws:iam::123456789012:role/CloudTrailLakeFederation"),
+		FederationStatus:             types.FederationStatusEnabled,
+		CreatedTimestamp:             adapterhelpers.PtrTime(time.Now()),
+		UpdatedTimestamp:             adapterhelpers.PtrTime(time.Now()),
+	}
+
+	item, err := eventDataStoreItemMapper(nil, "123456789012.eu-west-2", output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/CloudTrailLakeFederation",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewCloudTrailEventDataStoreAdapter(t *testing.T) {
+	client, account, region := cloudtrailGetAutoConfig(t)
+
+	adapter := NewCloudTrailEventDataStoreAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
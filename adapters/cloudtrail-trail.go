@@ -0,0 +1,311 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// TrailDetails A trail along with its current logging status, since the
+// status is returned by a separate API call
+type TrailDetails struct {
+	Trail  *types.Trail
+	Status *cloudtrail.GetTrailStatusOutput
+}
+
+func trailGetFunc(ctx context.Context, client CloudTrailClient, _, query string) (*TrailDetails, error) {
+	out, err := client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		TrailNameList: []string{query},
+		// Multi-region trails show up in every region as shadow trails, we
+		// only want to return them from their home region
+		IncludeShadowTrails: aws.Bool(false),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.TrailList) == 0 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "trail not found",
+		}
+	}
+
+	return enrichTrail(ctx, client, &out.TrailList[0]), nil
+}
+
+func trailListFunc(ctx context.Context, client CloudTrailClient, _ string) ([]*TrailDetails, error) {
+	out, err := client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		// Multi-region trails show up in every region as shadow trails, we
+		// only want to return them from their home region
+		IncludeShadowTrails: aws.Bool(false),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	trails := make([]*TrailDetails, 0, len(out.TrailList))
+
+	for i := range out.TrailList {
+		trails = append(trails, enrichTrail(ctx, client, &out.TrailList[i]))
+	}
+
+	return trails, nil
+}
+
+// trailSearchFunc Searches for trails by ARN, or by the name of the S3 bucket
+// that they deliver logs to. Searching by bucket is what allows a bucket to
+// link back to the trails that depend on it
+func trailSearchFunc(ctx context.Context, client CloudTrailClient, scope, query string) ([]*TrailDetails, error) {
+	if _, err := adapterhelpers.ParseARN(query); err == nil {
+		trail, err := trailGetFunc(ctx, client, scope, query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*TrailDetails{trail}, nil
+	}
+
+	out, err := client.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+		// Otherwise a multi-region trail would be found in every region
+		IncludeShadowTrails: aws.Bool(false),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	trails := make([]*TrailDetails, 0)
+
+	for i := range out.TrailList {
+		if aws.ToString(out.TrailList[i].S3BucketName) == query {
+			trails = append(trails, enrichTrail(ctx, client, &out.TrailList[i]))
+		}
+	}
+
+	return trails, nil
+}
+
+// enrichTrail Fetches the logging status of a trail. Failing to get the status
+// isn't fatal since the trail itself is still valid, it just won't have a
+// health
+func enrichTrail(ctx context.Context, client CloudTrailClient, trail *types.Trail) *TrailDetails {
+	details := TrailDetails{
+		Trail: trail,
+	}
+
+	if trail.TrailARN != nil {
+		status, err := client.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{
+			Name: trail.TrailARN,
+		})
+
+		if err == nil {
+			details.Status = status
+		}
+	}
+
+	return &details
+}
+
+// trailHealth Works out the health of a trail from its status. A trail that
+// has stopped logging or can't deliver logs is no longer doing its job
+func trailHealth(status *cloudtrail.GetTrailStatusOutput) *sdp.Health {
+	if status == nil {
+		return nil
+	}
+
+	if status.IsLogging != nil && !*status.IsLogging {
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	// CloudTrail doesn't report when CloudWatch Logs delivery was last
+	// attempted, so there is no way to tell whether this error is stale
+	if s3DeliveryFailing(status) || aws.ToString(status.LatestCloudWatchLogsDeliveryError) != "" {
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return sdp.Health_HEALTH_OK.Enum()
+}
+
+// s3DeliveryFailing Works out whether the most recent attempt to deliver logs
+// to S3 failed. LatestDeliveryError isn't cleared once delivery recovers, so
+// where the attempt timestamps are available the error only counts if the
+// last successful attempt is older than the last attempt
+func s3DeliveryFailing(status *cloudtrail.GetTrailStatusOutput) bool {
+	if aws.ToString(status.LatestDeliveryError) == "" {
+		return false
+	}
+
+	lastAttempt := aws.ToString(status.LatestDeliveryAttemptTime)
+
+	if lastAttempt == "" {
+		return true
+	}
+
+	return aws.ToString(status.LatestDeliveryAttemptSucceeded) != lastAttempt
+}
+
+func trailItemMapper(_, scope string, awsItem *TrailDetails) (*sdp.Item, error) {
+	trail := awsItem.Trail
+
+	enrichedTrail := struct {
+		*types.Trail
+		IsLogging          *bool
+		StartLoggingTime   *string
+		StopLoggingTime    *string
+		LatestDeliveryTime *string
+	}{
+		Trail: trail,
+	}
+
+	if awsItem.Status != nil {
+		enrichedTrail.IsLogging = awsItem.Status.IsLogging
+		enrichedTrail.StartLoggingTime = awsItem.Status.TimeLoggingStarted
+		enrichedTrail.StopLoggingTime = awsItem.Status.TimeLoggingStopped
+		enrichedTrail.LatestDeliveryTime = awsItem.Status.LatestDeliveryAttemptTime
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(enrichedTrail)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "cloudtrail-trail",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          trailHealth(awsItem.Status),
+	}
+
+	// S3 buckets don't have ARNs with accounts in them, so we assume that the
+	// bucket is in the same account as the trail
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	if trail.TrailARN != nil {
+		if a, err := adapterhelpers.ParseARN(*trail.TrailARN); err == nil {
+			accountID = a.AccountID
+		}
+	}
+
+	if trail.S3BucketName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *trail.S3BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the bucket (e.g. its policy) can stop the trail
+				// from delivering logs. The bucket links back to the trail so
+				// this is visible from either side
+				In: true,
+				// The trail writes to the bucket but doesn't change it
+				Out: false,
+			},
+		})
+	}
+
+	if trail.SnsTopicARN != nil {
+		if a, err := adapterhelpers.ParseARN(*trail.SnsTopicARN); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sns-topic",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *trail.SnsTopicARN,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the topic can stop notifications being sent
+					In: true,
+					// Changes to the trail will change the notifications that
+					// are sent to the topic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if trail.CloudWatchLogsLogGroupArn != nil {
+		if a, err := adapterhelpers.ParseARN(*trail.CloudWatchLogsLogGroupArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *trail.CloudWatchLogsLogGroupArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the log group can stop delivery
+					In: true,
+					// Changing the trail changes what ends up in the logs
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if trail.CloudWatchLogsRoleArn != nil {
+		// The role is used to write to CloudWatch Logs
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*trail.CloudWatchLogsRoleArn, scope))
+	}
+
+	if trail.KmsKeyId != nil {
+		// The KMS key ID is a full ARN
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*trail.KmsKeyId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewCloudTrailTrailAdapter(client CloudTrailClient, accountID string, region string) *adapterhelpers.GetListAdapter[*TrailDetails, CloudTrailClient, *cloudtrail.Options] {
+	return &adapterhelpers.GetListAdapter[*TrailDetails, CloudTrailClient, *cloudtrail.Options]{
+		ItemType:        "cloudtrail-trail",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: cloudtrailTrailAdapterMetadata,
+		GetFunc:         trailGetFunc,
+		ListFunc:        trailListFunc,
+		SearchFunc:      trailSearchFunc,
+		ItemMapper:      trailItemMapper,
+		ListTagsFunc: func(ctx context.Context, t *TrailDetails, client CloudTrailClient) (map[string]string, error) {
+			if t.Trail.TrailARN == nil {
+				return nil, nil
+			}
+
+			return cloudtrailListTags(ctx, client, *t.Trail.TrailARN)
+		},
+	}
+}
+
+var cloudtrailTrailAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "cloudtrail-trail",
+	DescriptiveName: "CloudTrail Trail",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a CloudTrail trail by name",
+		ListDescription:   "List all CloudTrail trails",
+		SearchDescription: "Search for CloudTrail trails by ARN or by the name of the S3 bucket they deliver logs to",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_cloudtrail.arn",
+		},
+	},
+	PotentialLinks: []string{"s3-bucket", "sns-topic", "logs-log-group", "iam-role", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
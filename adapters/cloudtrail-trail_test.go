@@ -0,0 +1,261 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTrailItemMapper(t *testing.T) {
+	details := &TrailDetails{
+		Trail: &types.Trail{
+			Name:                       adapterhelpers.PtrString("org-trail"),
+			TrailARN:                   adapterhelpers.PtrString("arn:aws:cloudtrail:eu-west-2:123456789012:trail/org-trail"),
+			HomeRegion:                 adapterhelpers.PtrString("eu-west-2"),
+			IsOrganizationTrail:        adapterhelpers.PtrBool(true),
+			IsMultiRegionTrail:         adapterhelpers.PtrBool(true),
+			IncludeGlobalServiceEvents: adapterhelpers.PtrBool(true),
+			LogFileValidationEnabled:   adapterhelpers.PtrBool(true),
+			S3BucketName:               adapterhelpers.PtrString("org-trail-logs"),
+			SnsTopicARN:                adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:trail-notifications"),
+			CloudWatchLogsLogGroupArn:  adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:org-trail:*"),
+			CloudWatchLogsRoleArn:      adapterhelpers.PtrString("arn:aws:iam::123456789012:role/CloudTrailToCloudWatch"),
+			KmsKeyId:                   adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+		},
+		Status: &cloudtrail.GetTrailStatusOutput{
+			IsLogging:          adapterhelpers.PtrBool(true),
+			StartLoggingTime:   adapterhelpers.PtrTime(time.Now()),
+			TimeLoggingStarted: adapterhelpers.PtrString("2024-01-01T00:00:00Z"),
+		},
+	}
+
+	item, err := trailItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "org-trail-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:trail-notifications",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:org-trail:*",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/CloudTrailToCloudWatch",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestTrailHealth(t *testing.T) {
+	tests := []struct {
+		Name     string
+		Status   *cloudtrail.GetTrailStatusOutput
+		Expected sdp.Health
+	}{
+		{
+			Name: "logging",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging: adapterhelpers.PtrBool(true),
+			},
+			Expected: sdp.Health_HEALTH_OK,
+		},
+		{
+			Name: "stopped",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging: adapterhelpers.PtrBool(false),
+			},
+			Expected: sdp.Health_HEALTH_WARNING,
+		},
+		{
+			Name: "delivery error",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging:           adapterhelpers.PtrBool(true),
+				LatestDeliveryError: adapterhelpers.PtrString("AccessDenied"),
+			},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+		{
+			Name: "delivery recovered",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging:                      adapterhelpers.PtrBool(true),
+				LatestDeliveryError:            adapterhelpers.PtrString("AccessDenied"),
+				LatestDeliveryAttemptTime:      adapterhelpers.PtrString("2024-03-01T12:00:00Z"),
+				LatestDeliveryAttemptSucceeded: adapterhelpers.PtrString("2024-03-01T12:00:00Z"),
+			},
+			Expected: sdp.Health_HEALTH_OK,
+		},
+		{
+			Name: "delivery still failing",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging:                      adapterhelpers.PtrBool(true),
+				LatestDeliveryError:            adapterhelpers.PtrString("AccessDenied"),
+				LatestDeliveryAttemptTime:      adapterhelpers.PtrString("2024-03-01T12:00:00Z"),
+				LatestDeliveryAttemptSucceeded: adapterhelpers.PtrString("2024-02-28T09:30:00Z"),
+			},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+		{
+			Name: "delivery never succeeded",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging:                 adapterhelpers.PtrBool(true),
+				LatestDeliveryError:       adapterhelpers.PtrString("NoSuchBucket"),
+				LatestDeliveryAttemptTime: adapterhelpers.PtrString("2024-03-01T12:00:00Z"),
+			},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+		{
+			Name: "cloudwatch logs error",
+			Status: &cloudtrail.GetTrailStatusOutput{
+				IsLogging:                         adapterhelpers.PtrBool(true),
+				LatestCloudWatchLogsDeliveryError: adapterhelpers.PtrString("AccessDeniedException"),
+			},
+			Expected: sdp.Health_HEALTH_ERROR,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			health := trailHealth(test.Status)
+
+			if health == nil {
+				t.Fatal("expected health, got nil")
+			}
+
+			if *health != test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, *health)
+			}
+		})
+	}
+
+	if trailHealth(nil) != nil {
+		t.Error("expected nil health when status is unknown")
+	}
+}
+
+func testCloudTrailClient() CloudTrailTestClient {
+	return CloudTrailTestClient{
+		Region: "eu-west-2",
+		Trails: []types.Trail{
+			{
+				Name:         adapterhelpers.PtrString("org-trail"),
+				TrailARN:     adapterhelpers.PtrString("arn:aws:cloudtrail:eu-west-2:123456789012:trail/org-trail"),
+				HomeRegion:   adapterhelpers.PtrString("eu-west-2"),
+				S3BucketName: adapterhelpers.PtrString("org-trail-logs"),
+			},
+			{
+				Name:         adapterhelpers.PtrString("app-trail"),
+				TrailARN:     adapterhelpers.PtrString("arn:aws:cloudtrail:eu-west-2:123456789012:trail/app-trail"),
+				HomeRegion:   adapterhelpers.PtrString("eu-west-2"),
+				S3BucketName: adapterhelpers.PtrString("app-trail-logs"),
+			},
+			{
+				// A shadow of a multi-region trail from another region
+				Name:         adapterhelpers.PtrString("global-trail"),
+				TrailARN:     adapterhelpers.PtrString("arn:aws:cloudtrail:us-east-1:123456789012:trail/global-trail"),
+				HomeRegion:   adapterhelpers.PtrString("us-east-1"),
+				S3BucketName: adapterhelpers.PtrString("org-trail-logs"),
+			},
+		},
+	}
+}
+
+func TestTrailGetFunc(t *testing.T) {
+	client := testCloudTrailClient()
+
+	trail, err := trailGetFunc(context.Background(), client, "123456789012.eu-west-2", "org-trail")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if aws.ToString(trail.Trail.Name) != "org-trail" {
+		t.Errorf("expected org-trail, got %v", aws.ToString(trail.Trail.Name))
+	}
+
+	if _, err = trailGetFunc(context.Background(), client, "123456789012.eu-west-2", "global-trail"); err == nil {
+		t.Error("expected shadow trails not to be found outside their home region")
+	}
+}
+
+func TestTrailSearchFunc(t *testing.T) {
+	client := testCloudTrailClient()
+
+	t.Run("by bucket", func(t *testing.T) {
+		trails, err := trailSearchFunc(context.Background(), client, "123456789012.eu-west-2", "org-trail-logs")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// The shadow trail delivers to the same bucket but belongs to
+		// another region
+		if len(trails) != 1 || aws.ToString(trails[0].Trail.Name) != "org-trail" {
+			t.Errorf("expected only org-trail, got %v trails", len(trails))
+		}
+	})
+
+	t.Run("by ARN", func(t *testing.T) {
+		trails, err := trailSearchFunc(context.Background(), client, "123456789012.eu-west-2", "arn:aws:cloudtrail:eu-west-2:123456789012:trail/app-trail")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(trails) != 1 || aws.ToString(trails[0].Trail.Name) != "app-trail" {
+			t.Errorf("expected app-trail, got %v trails", len(trails))
+		}
+	})
+}
+
+func TestNewCloudTrailTrailAdapter(t *testing.T) {
+	client, account, region := cloudtrailGetAutoConfig(t)
+
+	adapter := NewCloudTrailTrailAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+)
+
+type CloudTrailClient interface {
+	DescribeTrails(ctx context.Context, params *cloudtrail.DescribeTrailsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error)
+	GetTrailStatus(ctx context.Context, params *cloudtrail.GetTrailStatusInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error)
+	GetEventDataStore(ctx context.Context, params *cloudtrail.GetEventDataStoreInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetEventDataStoreOutput, error)
+
+	cloudtrail.ListEventDataStoresAPIClient
+	cloudtrail.ListTagsAPIClient
+}
+
+// cloudtrailListTags Gets the tags for a given CloudTrail resource ARN
+func cloudtrailListTags(ctx context.Context, client CloudTrailClient, arn string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	paginator := cloudtrail.NewListTagsPaginator(client, &cloudtrail.ListTagsInput{
+		ResourceIdList: []string{arn},
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, resourceTag := range out.ResourceTagList {
+			for k, v := range cloudtrailTagsToMap(resourceTag.TagsList) {
+				tags[k] = v
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// Converts a slice of CloudTrail tags to a map
+func cloudtrailTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
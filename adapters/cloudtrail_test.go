@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+	"github.com/aws/aws-sdk-go-v2/service/cloudtrail/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+// CloudTrailTestClient Returns the given trails. Like the real API, trails
+// whose home region isn't Region are shadow trails and are only returned if
+// IncludeShadowTrails isn't false
+type CloudTrailTestClient struct {
+	Region string
+	Trails []types.Trail
+}
+
+func (t CloudTrailTestClient) DescribeTrails(ctx context.Context, params *cloudtrail.DescribeTrailsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.DescribeTrailsOutput, error) {
+	includeShadow := params.IncludeShadowTrails == nil || *params.IncludeShadowTrails
+	trails := make([]types.Trail, 0)
+
+	for _, trail := range t.Trails {
+		if !includeShadow && aws.ToString(trail.HomeRegion) != t.Region {
+			continue
+		}
+
+		if len(params.TrailNameList) > 0 && !slices.Contains(params.TrailNameList, aws.ToString(trail.Name)) && !slices.Contains(params.TrailNameList, aws.ToString(trail.TrailARN)) {
+			continue
+		}
+
+		trails = append(trails, trail)
+	}
+
+	return &cloudtrail.DescribeTrailsOutput{
+		TrailList: trails,
+	}, nil
+}
+
+func (t CloudTrailTestClient) GetTrailStatus(ctx context.Context, params *cloudtrail.GetTrailStatusInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetTrailStatusOutput, error) {
+	return &cloudtrail.GetTrailStatusOutput{
+		IsLogging: aws.Bool(true),
+	}, nil
+}
+
+func (t CloudTrailTestClient) GetEventDataStore(ctx context.Context, params *cloudtrail.GetEventDataStoreInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.GetEventDataStoreOutput, error) {
+	return &cloudtrail.GetEventDataStoreOutput{}, nil
+}
+
+func (t CloudTrailTestClient) ListEventDataStores(ctx context.Context, params *cloudtrail.ListEventDataStoresInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.ListEventDataStoresOutput, error) {
+	return &cloudtrail.ListEventDataStoresOutput{}, nil
+}
+
+func (t CloudTrailTestClient) ListTags(ctx context.Context, params *cloudtrail.ListTagsInput, optFns ...func(*cloudtrail.Options)) (*cloudtrail.ListTagsOutput, error) {
+	return &cloudtrail.ListTagsOutput{}, nil
+}
+
+func cloudtrailGetAutoConfig(t *testing.T) (*cloudtrail.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := cloudtrail.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -125,7 +125,7 @@ func alarmOutputMapper(ctx context.Context, client CloudwatchClient, scope strin
 		}
 
 		for _, action := range allActions {
-			if q, err := actionToLink(action); err == nil {
+			if q, err := actionToLink(action, scope); err == nil {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, q)
 			}
 		}
@@ -295,7 +295,10 @@ var cloudwatchAlarmAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 // * arn:aws:ssm:region:account-id:opsitem:severity#CATEGORY=category-name
 //
 // * arn:aws:ssm-incidents::account-id:responseplan/response-plan-name
-func actionToLink(action string) (*sdp.LinkedItemQuery, error) {
+//
+// The scope is that of the alarm, and is used for ARNs that don't include a
+// region
+func actionToLink(action string, scope string) (*sdp.LinkedItemQuery, error) {
 	arn, err := adapterhelpers.ParseARN(action)
 
 	if err != nil {
@@ -349,12 +352,19 @@ func actionToLink(action string) (*sdp.LinkedItemQuery, error) {
 			},
 		}, nil
 	case "ssm-incidents":
+		// Response plan ARNs don't include a region, but the plan is
+		// available in the alarm's region since it can be started from it
+		region := arn.Region
+		if region == "" {
+			_, region, _ = adapterhelpers.ParseScope(scope)
+		}
+
 		return &sdp.LinkedItemQuery{
 			Query: &sdp.Query{
 				Type:   "ssm-incidents-response-plan",
 				Method: sdp.QueryMethod_SEARCH,
 				Query:  action,
-				Scope:  adapterhelpers.FormatScope(arn.AccountID, arn.Region),
+				Scope:  adapterhelpers.FormatScope(arn.AccountID, region),
 			},
 			BlastPropagation: &sdp.BlastPropagation{
 				// Changes to a response plan won't affect the alarm
@@ -153,6 +153,40 @@ func TestAlarmOutputMapper(t *testing.T) {
 	tests.Execute(t, item)
 }
 
+func TestActionToLink(t *testing.T) {
+	scope := "123456789012.eu-west-2"
+
+	tests := map[string]adapterhelpers.QueryTest{
+		"arn:aws:ssm:eu-west-2:123456789012:opsitem:3#CATEGORY=Availability": {
+			ExpectedType:   "ssm-ops-item",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ssm:eu-west-2:123456789012:opsitem:3#CATEGORY=Availability",
+			ExpectedScope:  scope,
+		},
+		// Response plan ARNs don't have a region so use the alarm's
+		"arn:aws:ssm-incidents::123456789012:responseplan/web-outage": {
+			ExpectedType:   "ssm-incidents-response-plan",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ssm-incidents::123456789012:responseplan/web-outage",
+			ExpectedScope:  scope,
+		},
+	}
+
+	for action, test := range tests {
+		link, err := actionToLink(action, scope)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		item := sdp.Item{
+			LinkedItemQueries: []*sdp.LinkedItemQuery{link},
+		}
+
+		adapterhelpers.QueryTests{test}.Execute(t, &item)
+	}
+}
+
 func TestNewCloudwatchAlarmAdapter(t *testing.T) {
 	config, account, region := adapterhelpers.GetAutoConfig(t)
 	client := cloudwatch.NewFromConfig(config)
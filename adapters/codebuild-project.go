@@ -0,0 +1,354 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func codebuildProjectGetFunc(ctx context.Context, client CodeBuildClient, scope, query string) (*types.Project, error) {
+	out, err := client.BatchGetProjects(ctx, &codebuild.BatchGetProjectsInput{
+		Names: []string{query},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Projects) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "project not found",
+		}
+	}
+
+	return &out.Projects[0], nil
+}
+
+func codebuildProjectListFunc(ctx context.Context, client CodeBuildClient, scope string) ([]*types.Project, error) {
+	paginator := codebuild.NewListProjectsPaginator(client, &codebuild.ListProjectsInput{})
+
+	projects := make([]*types.Project, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(out.Projects) == 0 {
+			continue
+		}
+
+		// The list only returns names, but each page is at most 100 which is
+		// also the most that can be fetched in one batch
+		details, err := client.BatchGetProjects(ctx, &codebuild.BatchGetProjectsInput{
+			Names: out.Projects,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range details.Projects {
+			projects = append(projects, &details.Projects[i])
+		}
+	}
+
+	return projects, nil
+}
+
+// codebuildPushedImageLink Links to an ECR repository that a build pushes to.
+// Projects don't declare where images are pushed, but by convention the
+// repository URI is passed in as a plain text environment variable e.g.
+// REPOSITORY_URI=123456789012.dkr.ecr.eu-west-2.amazonaws.com/my-app
+func codebuildPushedImageLink(variable types.EnvironmentVariable) *sdp.LinkedItemQuery {
+	if variable.Type != types.EnvironmentVariableTypePlaintext || variable.Value == nil {
+		return nil
+	}
+
+	link := batchImageLink(*variable.Value)
+
+	if link != nil {
+		link.BlastPropagation = &sdp.BlastPropagation{
+			// Deleting the repository breaks the build
+			In: true,
+			// The build pushes new images to the repository
+			Out: true,
+		}
+	}
+
+	return link
+}
+
+func codebuildProjectItemMapper(_, scope string, awsItem *types.Project) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "codebuild-project",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            codebuildTagsToMap(awsItem.Tags),
+	}
+
+	for _, role := range []*string{awsItem.ServiceRole, awsItem.ResourceAccessRole} {
+		if role == nil {
+			continue
+		}
+
+		// The role controls what the build can access
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*role, scope))
+	}
+
+	if vpcConfig := awsItem.VpcConfig; vpcConfig != nil {
+		if vpcConfig.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *vpcConfig.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The VPC controls the build's network access
+					In: true,
+					// The project can't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnet := range vpcConfig.Subnets {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Builds run in the subnet
+					In: true,
+					// The project can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, securityGroup := range vpcConfig.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  securityGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group controls the build's network access
+					In: true,
+					// The project can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if environment := awsItem.Environment; environment != nil {
+		if environment.Image != nil {
+			if link := batchImageLink(*environment.Image); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if environment.RegistryCredential != nil {
+			if link := secretValueFromLinkedItem(environment.RegistryCredential.Credential); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		for _, variable := range environment.EnvironmentVariables {
+			if variable.Value == nil {
+				continue
+			}
+
+			switch variable.Type {
+			case types.EnvironmentVariableTypeParameterStore:
+				if link := secretValueFromLinkedItem(variable.Value); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				} else {
+					// Parameters are usually referenced by name
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "ssm-parameter",
+							Method: sdp.QueryMethod_GET,
+							Query:  *variable.Value,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The parameter's value is passed to the build
+							In: true,
+							// The build can't affect the parameter
+							Out: false,
+						},
+					})
+				}
+			case types.EnvironmentVariableTypeSecretsManager:
+				// These can have a JSON key, version stage and version ID
+				// appended e.g. {arn}:{json-key}:{version-stage}:{version-id}
+				// so we only keep the ARN itself which has 7 sections
+				value := *variable.Value
+				if sections := strings.Split(value, ":"); len(sections) > 7 {
+					value = strings.Join(sections[:7], ":")
+				}
+
+				if link := secretValueFromLinkedItem(&value); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			default:
+				if link := codebuildPushedImageLink(variable); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+	}
+
+	if awsItem.EncryptionKey != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.EncryptionKey, scope))
+	}
+
+	sources := awsItem.SecondarySources
+	if awsItem.Source != nil {
+		sources = append([]types.ProjectSource{*awsItem.Source}, sources...)
+	}
+
+	for _, source := range sources {
+		if source.Location == nil {
+			continue
+		}
+
+		switch source.Type {
+		case types.SourceTypeS3:
+			if link := codebuildS3Link(*source.Location, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		case types.SourceTypeCodecommit, types.SourceTypeGithub, types.SourceTypeGithubEnterprise, types.SourceTypeBitbucket, types.SourceTypeGitlab, types.SourceTypeGitlabSelfManaged:
+			if strings.HasPrefix(*source.Location, "https://") {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "http",
+						Method: sdp.QueryMethod_GET,
+						Query:  *source.Location,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the source repository change what is
+						// built
+						In: true,
+						// The project can't affect the repository
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	artifacts := awsItem.SecondaryArtifacts
+	if awsItem.Artifacts != nil {
+		artifacts = append([]types.ProjectArtifacts{*awsItem.Artifacts}, artifacts...)
+	}
+
+	for _, artifact := range artifacts {
+		if artifact.Type == types.ArtifactsTypeS3 && artifact.Location != nil {
+			if link := codebuildS3Link(*artifact.Location, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	if awsItem.Cache != nil && awsItem.Cache.Type == types.CacheTypeS3 && awsItem.Cache.Location != nil {
+		if link := codebuildS3Link(*awsItem.Cache.Location, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if logsConfig := awsItem.LogsConfig; logsConfig != nil {
+		if cw := logsConfig.CloudWatchLogs; cw != nil && cw.Status == types.LogsConfigStatusTypeEnabled && cw.GroupName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *cw.GroupName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the log group loses the build logs
+					In: true,
+					// The builds write logs to the group
+					Out: true,
+				},
+			})
+		}
+
+		if s3Logs := logsConfig.S3Logs; s3Logs != nil && s3Logs.Status == types.LogsConfigStatusTypeEnabled && s3Logs.Location != nil {
+			if link := codebuildS3Link(*s3Logs.Location, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewCodeBuildProjectAdapter(client CodeBuildClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Project, CodeBuildClient, *codebuild.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Project, CodeBuildClient, *codebuild.Options]{
+		ItemType:        "codebuild-project",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codebuildProjectAdapterMetadata,
+		GetFunc:         codebuildProjectGetFunc,
+		ListFunc:        codebuildProjectListFunc,
+		ItemMapper:      codebuildProjectItemMapper,
+	}
+}
+
+var codebuildProjectAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codebuild-project",
+	DescriptiveName: "CodeBuild Project",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a build project by name",
+		ListDescription:   "List all build projects",
+		SearchDescription: "Search for build projects by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_codebuild_project.name"},
+	},
+	PotentialLinks: []string{
+		"iam-role",
+		"ec2-vpc",
+		"ec2-subnet",
+		"ec2-security-group",
+		"ecr-repository",
+		"secretsmanager-secret",
+		"ssm-parameter",
+		"kms-key",
+		"s3-bucket",
+		"http",
+		"logs-log-group",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,253 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCodeBuildProjectItemMapper(t *testing.T) {
+	project := &types.Project{
+		Name:               adapterhelpers.PtrString("billing-api"),
+		Arn:                adapterhelpers.PtrString("arn:aws:codebuild:eu-west-2:123456789012:project/billing-api"),
+		Created:            adapterhelpers.PtrTime(time.Now()),
+		ServiceRole:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/codebuild-billing-api"),
+		EncryptionKey:      adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:alias/aws/s3"),
+		ResourceAccessRole: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/codebuild-logs"),
+		Source: &types.ProjectSource{
+			Type:     types.SourceTypeGithub,
+			Location: adapterhelpers.PtrString("https://github.com/example/billing-api.git"),
+		},
+		SecondarySources: []types.ProjectSource{
+			{
+				Type:     types.SourceTypeS3,
+				Location: adapterhelpers.PtrString("build-inputs/billing-api/fixtures.zip"),
+			},
+		},
+		Artifacts: &types.ProjectArtifacts{
+			Type:     types.ArtifactsTypeS3,
+			Location: adapterhelpers.PtrString("build-artifacts"),
+		},
+		Cache: &types.ProjectCache{
+			Type:     types.CacheTypeS3,
+			Location: adapterhelpers.PtrString("arn:aws:s3:::build-cache/billing-api"),
+		},
+		Environment: &types.ProjectEnvironment{
+			Type:        types.EnvironmentTypeLinuxContainer,
+			ComputeType: types.ComputeTypeBuildGeneral1Small,
+			Image:       adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/build-images:node20"),
+			RegistryCredential: &types.RegistryCredential{
+				Credential:         adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:123456789012:secret:registry-creds-AbCdEf"),
+				CredentialProvider: types.CredentialProviderTypeSecretsManager,
+			},
+			EnvironmentVariables: []types.EnvironmentVariable{
+				{
+					Name:  adapterhelpers.PtrString("REPOSITORY_URI"),
+					Type:  types.EnvironmentVariableTypePlaintext,
+					Value: adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/billing-api"),
+				},
+				{
+					Name:  adapterhelpers.PtrString("NODE_ENV"),
+					Type:  types.EnvironmentVariableTypePlaintext,
+					Value: adapterhelpers.PtrString("production"),
+				},
+				{
+					Name:  adapterhelpers.PtrString("NPM_TOKEN"),
+					Type:  types.EnvironmentVariableTypeParameterStore,
+					Value: adapterhelpers.PtrString("/build/npm-token"),
+				},
+				{
+					Name:  adapterhelpers.PtrString("DB_PASSWORD"),
+					Type:  types.EnvironmentVariableTypeSecretsManager,
+					Value: adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:123456789012:secret:billing-db-XyZ123:password::"),
+				},
+			},
+		},
+		VpcConfig: &types.VpcConfig{
+			VpcId:            adapterhelpers.PtrString("vpc-0a1b2c3d"),
+			Subnets:          []string{"subnet-0a1b2c3d"},
+			SecurityGroupIds: []string{"sg-0a1b2c3d"},
+		},
+		LogsConfig: &types.LogsConfig{
+			CloudWatchLogs: &types.CloudWatchLogsConfig{
+				Status:    types.LogsConfigStatusTypeEnabled,
+				GroupName: adapterhelpers.PtrString("/codebuild/billing-api"),
+			},
+			S3Logs: &types.S3LogsConfig{
+				Status:   types.LogsConfigStatusTypeEnabled,
+				Location: adapterhelpers.PtrString("build-logs/billing-api"),
+			},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("team"),
+				Value: adapterhelpers.PtrString("billing"),
+			},
+		},
+	}
+
+	item, err := codebuildProjectItemMapper("", "123456789012.eu-west-2", project)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["team"] != "billing" {
+		t.Errorf("expected tag team=billing, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codebuild-billing-api",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codebuild-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-images",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:123456789012:secret:registry-creds-AbCdEf",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-parameter",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/build/npm-token",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:123456789012:secret:billing-db-XyZ123",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:alias/aws/s3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://github.com/example/billing-api.git",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-inputs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-artifacts",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-cache",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/codebuild/billing-api",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "build-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCodeBuildProjectListFunc(t *testing.T) {
+	client := CodeBuildTestClient{
+		ListProjectsOutput: &codebuild.ListProjectsOutput{
+			Projects: []string{"billing-api"},
+		},
+		BatchGetProjectsOutput: &codebuild.BatchGetProjectsOutput{
+			Projects: []types.Project{
+				{
+					Name: adapterhelpers.PtrString("billing-api"),
+				},
+			},
+		},
+	}
+
+	projects, err := codebuildProjectListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(projects) != 1 {
+		t.Errorf("expected 1 project, got %v", len(projects))
+	}
+}
+
+func TestNewCodeBuildProjectAdapter(t *testing.T) {
+	client, account, region := codebuildGetAutoConfig(t)
+
+	adapter := NewCodeBuildProjectAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,46 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/aws/aws-sdk-go-v2/service/codebuild/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type CodeBuildClient interface {
+	BatchGetProjects(ctx context.Context, params *codebuild.BatchGetProjectsInput, optFns ...func(*codebuild.Options)) (*codebuild.BatchGetProjectsOutput, error)
+
+	codebuild.ListProjectsAPIClient
+}
+
+// Converts a slice of CodeBuild tags to a map
+func codebuildTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// codebuildS3Link Links to the bucket from a CodeBuild S3 location. These are
+// in the format {bucket}/{path} rather than an S3 URI, and can also be an ARN
+// e.g. arn:aws:s3:::{bucket}/{path}
+func codebuildS3Link(location string, scope string) *sdp.LinkedItemQuery {
+	if a, err := adapterhelpers.ParseARN(location); err == nil && a.Service == "s3" {
+		location = a.Resource
+	}
+
+	return s3BucketLink("s3://"+location, scope, &sdp.BlastPropagation{
+		// Builds read from and write to the bucket, so changes to the
+		// bucket affect the builds and vice versa
+		In:  true,
+		Out: true,
+	})
+}
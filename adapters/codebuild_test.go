@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codebuild"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type CodeBuildTestClient struct {
+	BatchGetProjectsOutput *codebuild.BatchGetProjectsOutput
+	ListProjectsOutput     *codebuild.ListProjectsOutput
+}
+
+func (t CodeBuildTestClient) BatchGetProjects(context.Context, *codebuild.BatchGetProjectsInput, ...func(*codebuild.Options)) (*codebuild.BatchGetProjectsOutput, error) {
+	return t.BatchGetProjectsOutput, nil
+}
+
+func (t CodeBuildTestClient) ListProjects(context.Context, *codebuild.ListProjectsInput, ...func(*codebuild.Options)) (*codebuild.ListProjectsOutput, error) {
+	return t.ListProjectsOutput, nil
+}
+
+func codebuildGetAutoConfig(t *testing.T) (*codebuild.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := codebuild.NewFromConfig(config)
+
+	return client, account, region
+}
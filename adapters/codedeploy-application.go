@@ -0,0 +1,134 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func codedeployApplicationGetFunc(ctx context.Context, client CodeDeployClient, scope, query string) (*types.ApplicationInfo, error) {
+	out, err := client.GetApplication(ctx, &codedeploy.GetApplicationInput{
+		ApplicationName: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Application == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "application was nil",
+		}
+	}
+
+	return out.Application, nil
+}
+
+func codedeployApplicationListFunc(ctx context.Context, client CodeDeployClient, scope string) ([]*types.ApplicationInfo, error) {
+	paginator := codedeploy.NewListApplicationsPaginator(client, &codedeploy.ListApplicationsInput{})
+
+	applications := make([]*types.ApplicationInfo, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(out.Applications) == 0 {
+			continue
+		}
+
+		// Each page is at most 100 names which is also the most that can be
+		// fetched in one batch
+		details, err := client.BatchGetApplications(ctx, &codedeploy.BatchGetApplicationsInput{
+			ApplicationNames: out.Applications,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range details.ApplicationsInfo {
+			applications = append(applications, &details.ApplicationsInfo[i])
+		}
+	}
+
+	return applications, nil
+}
+
+func codedeployApplicationItemMapper(_, scope string, awsItem *types.ApplicationInfo) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "codedeploy-application",
+		UniqueAttribute: "ApplicationName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "codedeploy-deployment-group",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ApplicationName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the application deletes its deployment groups
+					In:  true,
+					Out: true,
+				},
+			},
+		},
+	}
+
+	return &item, nil
+}
+
+func NewCodeDeployApplicationAdapter(client CodeDeployClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ApplicationInfo, CodeDeployClient, *codedeploy.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ApplicationInfo, CodeDeployClient, *codedeploy.Options]{
+		ItemType:        "codedeploy-application",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codedeployApplicationAdapterMetadata,
+		GetFunc:         codedeployApplicationGetFunc,
+		ListFunc:        codedeployApplicationListFunc,
+		ItemMapper:      codedeployApplicationItemMapper,
+		ListTagsFunc: func(ctx context.Context, application *types.ApplicationInfo, client CodeDeployClient) (map[string]string, error) {
+			if application.ApplicationName == nil {
+				return nil, nil
+			}
+
+			return codedeployListTags(ctx, client, codedeployARN(accountID, region, "application:"+*application.ApplicationName))
+		},
+	}
+}
+
+var codedeployApplicationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codedeploy-application",
+	DescriptiveName: "CodeDeploy Application",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an application by name",
+		ListDescription:   "List all applications",
+		SearchDescription: "Search for applications by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_codedeploy_app.name"},
+	},
+	PotentialLinks: []string{"codedeploy-deployment-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
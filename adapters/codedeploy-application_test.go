@@ -0,0 +1,80 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCodeDeployApplicationItemMapper(t *testing.T) {
+	application := &types.ApplicationInfo{
+		ApplicationId:   adapterhelpers.PtrString("5f3a1c2e-8b4d-4e6f-9a0b-1c2d3e4f5a6b"),
+		ApplicationName: adapterhelpers.PtrString("billing"),
+		ComputePlatform: types.ComputePlatformServer,
+		CreateTime:      adapterhelpers.PtrTime(time.Now()),
+	}
+
+	item, err := codedeployApplicationItemMapper("", "123456789012.eu-west-2", application)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "codedeploy-deployment-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCodeDeployApplicationListFunc(t *testing.T) {
+	client := CodeDeployTestClient{
+		ListApplicationsOutput: &codedeploy.ListApplicationsOutput{
+			Applications: []string{"billing", "payments"},
+		},
+		BatchGetApplicationsOutput: &codedeploy.BatchGetApplicationsOutput{
+			ApplicationsInfo: []types.ApplicationInfo{
+				{ApplicationName: adapterhelpers.PtrString("billing")},
+				{ApplicationName: adapterhelpers.PtrString("payments")},
+			},
+		},
+	}
+
+	applications, err := codedeployApplicationListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(applications) != 2 {
+		t.Errorf("expected 2 applications, got %v", len(applications))
+	}
+}
+
+func TestNewCodeDeployApplicationAdapter(t *testing.T) {
+	client, account, region := codedeployGetAutoConfig(t)
+
+	adapter := NewCodeDeployApplicationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,407 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// codedeployDeploymentGroupGetFunc Gets a deployment group by its unique
+// name: {applicationName}/{deploymentGroupName}
+func codedeployDeploymentGroupGetFunc(ctx context.Context, client CodeDeployClient, scope, query string) (*types.DeploymentGroupInfo, error) {
+	applicationName, deploymentGroupName, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {applicationName}/{deploymentGroupName}",
+		}
+	}
+
+	out, err := client.GetDeploymentGroup(ctx, &codedeploy.GetDeploymentGroupInput{
+		ApplicationName:     &applicationName,
+		DeploymentGroupName: &deploymentGroupName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.DeploymentGroupInfo == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "deployment group was nil",
+		}
+	}
+
+	return out.DeploymentGroupInfo, nil
+}
+
+// codedeployDeploymentGroupListFunc Lists all deployment groups. These are
+// listed per-application so we need to list the applications first
+func codedeployDeploymentGroupListFunc(ctx context.Context, client CodeDeployClient, scope string) ([]*types.DeploymentGroupInfo, error) {
+	paginator := codedeploy.NewListApplicationsPaginator(client, &codedeploy.ListApplicationsInput{})
+
+	deploymentGroups := make([]*types.DeploymentGroupInfo, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, application := range out.Applications {
+			groups, err := codedeployDeploymentGroupListByApplication(ctx, client, application)
+
+			if err != nil {
+				return nil, err
+			}
+
+			deploymentGroups = append(deploymentGroups, groups...)
+		}
+	}
+
+	return deploymentGroups, nil
+}
+
+func codedeployDeploymentGroupListByApplication(ctx context.Context, client CodeDeployClient, applicationName string) ([]*types.DeploymentGroupInfo, error) {
+	paginator := codedeploy.NewListDeploymentGroupsPaginator(client, &codedeploy.ListDeploymentGroupsInput{
+		ApplicationName: &applicationName,
+	})
+
+	deploymentGroups := make([]*types.DeploymentGroupInfo, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(out.DeploymentGroups) == 0 {
+			continue
+		}
+
+		// Each page is at most 100 names which is also the most that can be
+		// fetched in one batch
+		details, err := client.BatchGetDeploymentGroups(ctx, &codedeploy.BatchGetDeploymentGroupsInput{
+			ApplicationName:      &applicationName,
+			DeploymentGroupNames: out.DeploymentGroups,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range details.DeploymentGroupsInfo {
+			deploymentGroups = append(deploymentGroups, &details.DeploymentGroupsInfo[i])
+		}
+	}
+
+	return deploymentGroups, nil
+}
+
+// codedeployDeploymentGroupSearchFunc Searches for deployment groups either
+// by ARN, or by the name of the application they belong to
+func codedeployDeploymentGroupSearchFunc(ctx context.Context, client CodeDeployClient, scope, query string) ([]*types.DeploymentGroupInfo, error) {
+	// The ARN is in the format
+	// arn:aws:codedeploy:region:account:deploymentgroup:{applicationName}/{deploymentGroupName}
+	// so the resource ID is the same as the unique name
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		deploymentGroup, err := codedeployDeploymentGroupGetFunc(ctx, client, scope, a.ResourceID())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.DeploymentGroupInfo{deploymentGroup}, nil
+	}
+
+	return codedeployDeploymentGroupListByApplication(ctx, client, query)
+}
+
+func codedeployDeploymentGroupItemMapper(_, scope string, awsItem *types.DeploymentGroupInfo) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {applicationName}/{deploymentGroupName}
+	attributes.Set("UniqueName", (*awsItem.ApplicationName + "/" + *awsItem.DeploymentGroupName))
+
+	item := sdp.Item{
+		Type:            "codedeploy-deployment-group",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "codedeploy-application",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.ApplicationName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the application deletes the deployment group
+					In: true,
+					// The deployment group can't affect the application
+					Out: false,
+				},
+			},
+		},
+	}
+
+	if awsItem.LastAttemptedDeployment != nil {
+		switch awsItem.LastAttemptedDeployment.Status {
+		case types.DeploymentStatusCreated, types.DeploymentStatusQueued, types.DeploymentStatusInProgress, types.DeploymentStatusBaking, types.DeploymentStatusReady:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.DeploymentStatusSucceeded:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.DeploymentStatusStopped:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.DeploymentStatusFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+	}
+
+	if awsItem.ServiceRoleArn != nil {
+		// The role controls what CodeDeploy can do during a deployment
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.ServiceRoleArn, scope))
+	}
+
+	for _, asg := range awsItem.AutoScalingGroups {
+		if asg.Name != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "autoscaling-auto-scaling-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *asg.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// New instances in the group are deployed to
+					In: true,
+					// Deployments change what runs on the group's instances
+					Out: true,
+				},
+			})
+		}
+	}
+
+	for _, service := range awsItem.EcsServices {
+		if service.ClusterName != nil && service.ServiceName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ecs-service",
+					Method: sdp.QueryMethod_GET,
+					Query:  *service.ClusterName + "/" + *service.ServiceName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deployments fail if the service is changed or removed
+					In: true,
+					// Deployments replace the service's task sets
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if lb := awsItem.LoadBalancerInfo; lb != nil {
+		for _, elb := range lb.ElbInfoList {
+			if elb.Name != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "elb-load-balancer",
+						Method: sdp.QueryMethod_GET,
+						Query:  *elb.Name,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Instances are deregistered from the load balancer
+						// during deployments
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		targetGroups := lb.TargetGroupInfoList
+
+		for _, pair := range lb.TargetGroupPairInfoList {
+			targetGroups = append(targetGroups, pair.TargetGroups...)
+
+			for _, route := range []*types.TrafficRoute{pair.ProdTrafficRoute, pair.TestTrafficRoute} {
+				if route == nil {
+					continue
+				}
+
+				for _, listenerArn := range route.ListenerArns {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "elbv2-listener",
+							Method: sdp.QueryMethod_GET,
+							Query:  listenerArn,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Blue/green deployments fail if the listener is
+							// removed
+							In: true,
+							// Blue/green deployments shift the listener's
+							// traffic between target groups
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		for _, targetGroup := range targetGroups {
+			if targetGroup.Name != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "elbv2-target-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *targetGroup.Name,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Targets are deregistered from the target group
+						// during deployments
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	for _, trigger := range awsItem.TriggerConfigurations {
+		if trigger.TriggerTargetArn == nil {
+			continue
+		}
+
+		if a, err := adapterhelpers.ParseARN(*trigger.TriggerTargetArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sns-topic",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *trigger.TriggerTargetArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The topic can't affect the deployment group
+					In: false,
+					// Deployment events are sent to the topic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.AlarmConfiguration != nil && awsItem.AlarmConfiguration.Enabled {
+		for _, alarm := range awsItem.AlarmConfiguration.Alarms {
+			if alarm.Name != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "cloudwatch-alarm",
+						Method: sdp.QueryMethod_GET,
+						Query:  *alarm.Name,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The alarm stops and rolls back deployments
+						In: true,
+						// The deployment group can't affect the alarm
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	if revision := awsItem.TargetRevision; revision != nil && revision.S3Location != nil && revision.S3Location.Bucket != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *revision.S3Location.Bucket,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The revision is deployed from the bucket
+				In: true,
+				// The deployment group can't affect the bucket
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewCodeDeployDeploymentGroupAdapter(client CodeDeployClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DeploymentGroupInfo, CodeDeployClient, *codedeploy.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DeploymentGroupInfo, CodeDeployClient, *codedeploy.Options]{
+		ItemType:        "codedeploy-deployment-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codedeployDeploymentGroupAdapterMetadata,
+		GetFunc:         codedeployDeploymentGroupGetFunc,
+		ListFunc:        codedeployDeploymentGroupListFunc,
+		SearchFunc:      codedeployDeploymentGroupSearchFunc,
+		ItemMapper:      codedeployDeploymentGroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, deploymentGroup *types.DeploymentGroupInfo, client CodeDeployClient) (map[string]string, error) {
+			if deploymentGroup.ApplicationName == nil || deploymentGroup.DeploymentGroupName == nil {
+				return nil, nil
+			}
+
+			return codedeployListTags(ctx, client, codedeployARN(accountID, region, "deploymentgroup:"+*deploymentGroup.ApplicationName+"/"+*deploymentGroup.DeploymentGroupName))
+		},
+	}
+}
+
+var codedeployDeploymentGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codedeploy-deployment-group",
+	DescriptiveName: "CodeDeploy Deployment Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a deployment group by unique name ({applicationName}/{deploymentGroupName})",
+		ListDescription:   "List all deployment groups",
+		SearchDescription: "Search for deployment groups by ARN, or by application name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_codedeploy_deployment_group.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{
+		"codedeploy-application",
+		"iam-role",
+		"autoscaling-auto-scaling-group",
+		"ecs-service",
+		"elb-load-balancer",
+		"elbv2-target-group",
+		"elbv2-listener",
+		"sns-topic",
+		"cloudwatch-alarm",
+		"s3-bucket",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
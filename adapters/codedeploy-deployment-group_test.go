@@ -0,0 +1,225 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestCodeDeployDeploymentGroupItemMapper(t *testing.T) {
+	deploymentGroup := &types.DeploymentGroupInfo{
+		ApplicationName:      adapterhelpers.PtrString("billing"),
+		DeploymentGroupName:  adapterhelpers.PtrString("production"),
+		DeploymentGroupId:    adapterhelpers.PtrString("9a8b7c6d-5e4f-4a3b-2c1d-0e9f8a7b6c5d"),
+		DeploymentConfigName: adapterhelpers.PtrString("CodeDeployDefault.OneAtATime"),
+		ComputePlatform:      types.ComputePlatformServer,
+		ServiceRoleArn:       adapterhelpers.PtrString("arn:aws:iam::123456789012:role/codedeploy-service"),
+		AutoScalingGroups: []types.AutoScalingGroup{
+			{
+				Name: adapterhelpers.PtrString("billing-workers"),
+				Hook: adapterhelpers.PtrString("CodeDeploy-managed-automatic-launch-deployment-hook-production"),
+			},
+		},
+		EcsServices: []types.ECSService{
+			{
+				ClusterName: adapterhelpers.PtrString("production"),
+				ServiceName: adapterhelpers.PtrString("billing-api"),
+			},
+		},
+		LoadBalancerInfo: &types.LoadBalancerInfo{
+			ElbInfoList: []types.ELBInfo{
+				{Name: adapterhelpers.PtrString("billing-classic")},
+			},
+			TargetGroupInfoList: []types.TargetGroupInfo{
+				{Name: adapterhelpers.PtrString("billing-workers")},
+			},
+			TargetGroupPairInfoList: []types.TargetGroupPairInfo{
+				{
+					TargetGroups: []types.TargetGroupInfo{
+						{Name: adapterhelpers.PtrString("billing-api-blue")},
+						{Name: adapterhelpers.PtrString("billing-api-green")},
+					},
+					ProdTrafficRoute: &types.TrafficRoute{
+						ListenerArns: []string{"arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/billing/50dc6c495c0c9188/f2f7dc8efc522ab2"},
+					},
+					TestTrafficRoute: &types.TrafficRoute{
+						ListenerArns: []string{"arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/billing/50dc6c495c0c9188/0467ef3c8400ae65"},
+					},
+				},
+			},
+		},
+		TriggerConfigurations: []types.TriggerConfig{
+			{
+				TriggerName:      adapterhelpers.PtrString("failures"),
+				TriggerTargetArn: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:deploy-failures"),
+				TriggerEvents:    []types.TriggerEventType{types.TriggerEventTypeDeploymentFailure},
+			},
+		},
+		AlarmConfiguration: &types.AlarmConfiguration{
+			Enabled: true,
+			Alarms: []types.Alarm{
+				{Name: adapterhelpers.PtrString("billing-5xx")},
+			},
+		},
+		TargetRevision: &types.RevisionLocation{
+			RevisionType: types.RevisionLocationTypeS3,
+			S3Location: &types.S3Location{
+				Bucket:     adapterhelpers.PtrString("billing-releases"),
+				Key:        adapterhelpers.PtrString("billing-1.2.3.zip"),
+				BundleType: types.BundleTypeZip,
+			},
+		},
+		LastAttemptedDeployment: &types.LastDeploymentInfo{
+			DeploymentId: adapterhelpers.PtrString("d-ABCDEF123"),
+			Status:       types.DeploymentStatusFailed,
+			CreateTime:   adapterhelpers.PtrTime(time.Now()),
+		},
+	}
+
+	item, err := codedeployDeploymentGroupItemMapper("", "123456789012.eu-west-2", deploymentGroup)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "codedeploy-application",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codedeploy-service",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-workers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "production/billing-api",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elb-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-classic",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-listener",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/billing/50dc6c495c0c9188/f2f7dc8efc522ab2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-listener",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:listener/app/billing/50dc6c495c0c9188/0467ef3c8400ae65",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-target-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-workers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-target-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api-blue",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-target-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api-green",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:deploy-failures",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-5xx",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-releases",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestCodeDeployDeploymentGroupGetFunc(t *testing.T) {
+	client := CodeDeployTestClient{}
+
+	_, err := codedeployDeploymentGroupGetFunc(context.Background(), client, "123456789012.eu-west-2", "billing")
+
+	if err == nil {
+		t.Error("expected an error when the query isn't a unique name")
+	}
+}
+
+func TestCodeDeployDeploymentGroupSearchFunc(t *testing.T) {
+	client := CodeDeployTestClient{
+		GetDeploymentGroupOutput: &codedeploy.GetDeploymentGroupOutput{
+			DeploymentGroupInfo: &types.DeploymentGroupInfo{
+				ApplicationName:     adapterhelpers.PtrString("billing"),
+				DeploymentGroupName: adapterhelpers.PtrString("production"),
+			},
+		},
+	}
+
+	deploymentGroups, err := codedeployDeploymentGroupSearchFunc(context.Background(), client, "123456789012.eu-west-2", "arn:aws:codedeploy:eu-west-2:123456789012:deploymentgroup:billing/production")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(deploymentGroups) != 1 {
+		t.Errorf("expected 1 deployment group, got %v", len(deploymentGroups))
+	}
+}
+
+func TestNewCodeDeployDeploymentGroupAdapter(t *testing.T) {
+	client, account, region := codedeployGetAutoConfig(t)
+
+	adapter := NewCodeDeployDeploymentGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
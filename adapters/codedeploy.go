@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type CodeDeployClient interface {
+	GetApplication(ctx context.Context, params *codedeploy.GetApplicationInput, optFns ...func(*codedeploy.Options)) (*codedeploy.GetApplicationOutput, error)
+	BatchGetApplications(ctx context.Context, params *codedeploy.BatchGetApplicationsInput, optFns ...func(*codedeploy.Options)) (*codedeploy.BatchGetApplicationsOutput, error)
+	GetDeploymentGroup(ctx context.Context, params *codedeploy.GetDeploymentGroupInput, optFns ...func(*codedeploy.Options)) (*codedeploy.GetDeploymentGroupOutput, error)
+	BatchGetDeploymentGroups(ctx context.Context, params *codedeploy.BatchGetDeploymentGroupsInput, optFns ...func(*codedeploy.Options)) (*codedeploy.BatchGetDeploymentGroupsOutput, error)
+	ListTagsForResource(ctx context.Context, params *codedeploy.ListTagsForResourceInput, optFns ...func(*codedeploy.Options)) (*codedeploy.ListTagsForResourceOutput, error)
+
+	codedeploy.ListApplicationsAPIClient
+	codedeploy.ListDeploymentGroupsAPIClient
+}
+
+// codedeployARN Builds the ARN of a CodeDeploy resource. Applications and
+// deployment groups don't return their ARN, but it is needed to get their tags.
+// The resource is either `application:{name}` or
+// `deploymentgroup:{applicationName}/{deploymentGroupName}`
+func codedeployARN(accountID string, region string, resource string) string {
+	return fmt.Sprintf("arn:%v:codedeploy:%v:%v:%v", adapterhelpers.PartitionFromRegion(region), region, accountID, resource)
+}
+
+// codedeployListTags Gets the tags for a CodeDeploy resource. The SDK doesn't
+// provide a paginator for this so we follow the token ourselves
+func codedeployListTags(ctx context.Context, client CodeDeployClient, arn string) (map[string]string, error) {
+	input := &codedeploy.ListTagsForResourceInput{
+		ResourceArn: &arn,
+	}
+
+	tags := make(map[string]string)
+
+	for {
+		out, err := client.ListTagsForResource(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range codedeployTagsToMap(out.Tags) {
+			tags[k] = v
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return tags, nil
+}
+
+// Converts a slice of CodeDeploy tags to a map
+func codedeployTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
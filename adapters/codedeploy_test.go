@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type CodeDeployTestClient struct {
+	GetApplicationOutput           *codedeploy.GetApplicationOutput
+	BatchGetApplicationsOutput     *codedeploy.BatchGetApplicationsOutput
+	GetDeploymentGroupOutput       *codedeploy.GetDeploymentGroupOutput
+	BatchGetDeploymentGroupsOutput *codedeploy.BatchGetDeploymentGroupsOutput
+	ListTagsForResourceOutput      *codedeploy.ListTagsForResourceOutput
+	ListApplicationsOutput         *codedeploy.ListApplicationsOutput
+	ListDeploymentGroupsOutput     *codedeploy.ListDeploymentGroupsOutput
+}
+
+func (t CodeDeployTestClient) GetApplication(context.Context, *codedeploy.GetApplicationInput, ...func(*codedeploy.Options)) (*codedeploy.GetApplicationOutput, error) {
+	return t.GetApplicationOutput, nil
+}
+
+func (t CodeDeployTestClient) BatchGetApplications(context.Context, *codedeploy.BatchGetApplicationsInput, ...func(*codedeploy.Options)) (*codedeploy.BatchGetApplicationsOutput, error) {
+	return t.BatchGetApplicationsOutput, nil
+}
+
+func (t CodeDeployTestClient) GetDeploymentGroup(context.Context, *codedeploy.GetDeploymentGroupInput, ...func(*codedeploy.Options)) (*codedeploy.GetDeploymentGroupOutput, error) {
+	return t.GetDeploymentGroupOutput, nil
+}
+
+func (t CodeDeployTestClient) BatchGetDeploymentGroups(context.Context, *codedeploy.BatchGetDeploymentGroupsInput, ...func(*codedeploy.Options)) (*codedeploy.BatchGetDeploymentGroupsOutput, error) {
+	return t.BatchGetDeploymentGroupsOutput, nil
+}
+
+func (t CodeDeployTestClient) ListTagsForResource(context.Context, *codedeploy.ListTagsForResourceInput, ...func(*codedeploy.Options)) (*codedeploy.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func (t CodeDeployTestClient) ListApplications(context.Context, *codedeploy.ListApplicationsInput, ...func(*codedeploy.Options)) (*codedeploy.ListApplicationsOutput, error) {
+	return t.ListApplicationsOutput, nil
+}
+
+func (t CodeDeployTestClient) ListDeploymentGroups(context.Context, *codedeploy.ListDeploymentGroupsInput, ...func(*codedeploy.Options)) (*codedeploy.ListDeploymentGroupsOutput, error) {
+	return t.ListDeploymentGroupsOutput, nil
+}
+
+func codedeployGetAutoConfig(t *testing.T) (*codedeploy.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := codedeploy.NewFromConfig(config)
+
+	return client, account, region
+}
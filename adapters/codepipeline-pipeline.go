@@ -0,0 +1,366 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func pipelineGetFunc(ctx context.Context, client CodePipelineClient, scope string, input *codepipeline.GetPipelineInput) (*sdp.Item, error) {
+	out, err := client.GetPipeline(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := out.Pipeline
+
+	if pipeline == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "pipeline was nil",
+		}
+	}
+
+	// Flatten the metadata into the pipeline so that the name and ARN are
+	// both top-level attributes
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*types.PipelineDeclaration
+		*types.PipelineMetadata
+	}{
+		PipelineDeclaration: pipeline,
+		PipelineMetadata:    out.Metadata,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "codepipeline-pipeline",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if out.Metadata != nil && out.Metadata.PipelineArn != nil {
+		tags, err := codepipelineListTags(ctx, client, *out.Metadata.PipelineArn)
+
+		if err != nil {
+			tags = adapterhelpers.HandleTagsError(ctx, err)
+		}
+
+		item.Tags = tags
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	if pipeline.RoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*pipeline.RoleArn, scope))
+	}
+
+	// Pipelines either have a single artifact store, or one per region for
+	// cross-region actions
+	if pipeline.ArtifactStore != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineArtifactStoreLinks(*pipeline.ArtifactStore, accountID, scope)...)
+	}
+
+	for region, store := range pipeline.ArtifactStores {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineArtifactStoreLinks(store, accountID, adapterhelpers.FormatScope(accountID, region))...)
+	}
+
+	for _, stage := range pipeline.Stages {
+		for _, action := range stage.Actions {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, pipelineActionLinks(action, accountID, scope)...)
+		}
+	}
+
+	return &item, nil
+}
+
+// pipelineArtifactStoreLinks Links to the bucket that artifacts are passed
+// between stages through, and the key they are encrypted with
+func pipelineArtifactStoreLinks(store types.ArtifactStore, accountID string, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if store.Type == types.ArtifactStoreTypeS3 && store.Location != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *store.Location,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The pipeline can't run without its artifacts
+				In: true,
+				// The pipeline writes artifacts to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	if store.EncryptionKey != nil && store.EncryptionKey.Id != nil {
+		links = append(links, kmsKeyLink(*store.EncryptionKey.Id, scope))
+	}
+
+	return links
+}
+
+// pipelineActionLinks Links to the resources that a stage action reads from
+// or deploys to. These are determined by the action's provider and its
+// provider-specific configuration, see:
+// https://docs.aws.amazon.com/codepipeline/latest/userguide/action-reference.html
+func pipelineActionLinks(action types.ActionDeclaration, accountID string, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if action.RoleArn != nil {
+		links = append(links, iamRoleLink(*action.RoleArn, scope))
+	}
+
+	if action.ActionTypeId == nil || action.ActionTypeId.Provider == nil {
+		return links
+	}
+
+	// Cross-region actions run in the region that they specify
+	if action.Region != nil {
+		scope = adapterhelpers.FormatScope(accountID, *action.Region)
+	}
+
+	config := action.Configuration
+
+	switch *action.ActionTypeId.Provider {
+	case "S3":
+		if action.ActionTypeId.Category == types.ActionCategorySource {
+			if bucket := config["S3Bucket"]; bucket != "" {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Uploading a new object starts the pipeline
+						In: true,
+						// The pipeline can't affect its source
+						Out: false,
+					},
+				})
+			}
+		} else if bucket := config["BucketName"]; bucket != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  bucket,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The pipeline can't deploy if the bucket is gone
+					In: true,
+					// The pipeline deploys to the bucket
+					Out: true,
+				},
+			})
+		}
+	case "ECR":
+		if repository := config["RepositoryName"]; repository != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ecr-repository",
+					Method: sdp.QueryMethod_GET,
+					Query:  repository,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Pushing a new image starts the pipeline
+					In: true,
+					// The pipeline can't affect its source
+					Out: false,
+				},
+			})
+		}
+	case "CodeCommit":
+		// There isn't a CodeCommit adapter, so link to the repository's clone
+		// URL instead
+		if repository := config["RepositoryName"]; repository != "" {
+			_, region, _ := adapterhelpers.ParseScope(scope)
+
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "http",
+					Method: sdp.QueryMethod_GET,
+					Query:  fmt.Sprintf("https://git-codecommit.%v.amazonaws.com/v1/repos/%v", region, repository),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Pushing a new commit starts the pipeline
+					In: true,
+					// The pipeline can't affect its source
+					Out: false,
+				},
+			})
+		}
+	case "CodeBuild":
+		if project := config["ProjectName"]; project != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "codebuild-project",
+					Method: sdp.QueryMethod_GET,
+					Query:  project,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the project change how the pipeline builds
+					In: true,
+					// The pipeline starts builds
+					Out: true,
+				},
+			})
+		}
+	case "CodeDeploy", "CodeDeployToECS":
+		application := config["ApplicationName"]
+		deploymentGroup := config["DeploymentGroupName"]
+
+		if application != "" && deploymentGroup != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "codedeploy-deployment-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  application + "/" + deploymentGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The deploy stage fails if the group is changed or removed
+					In: true,
+					// The pipeline creates deployments in the group
+					Out: true,
+				},
+			})
+		}
+	case "ECS":
+		cluster := config["ClusterName"]
+		service := config["ServiceName"]
+
+		if cluster != "" && service != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ecs-service",
+					Method: sdp.QueryMethod_GET,
+					Query:  cluster + "/" + service,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The deploy stage fails if the service is changed or removed
+					In: true,
+					// The pipeline deploys new task definitions to the service
+					Out: true,
+				},
+			})
+		}
+	case "ElasticBeanstalk":
+		if environment := config["EnvironmentName"]; environment != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticbeanstalk-environment",
+					Method: sdp.QueryMethod_GET,
+					Query:  environment,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The deploy stage fails if the environment is changed or
+					// removed
+					In: true,
+					// The pipeline deploys new versions to the environment
+					Out: true,
+				},
+			})
+		}
+	case "Lambda":
+		if function := config["FunctionName"]; function != "" {
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_GET,
+					Query:  function,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The function is invoked by the pipeline, changes to it
+					// change what the action does
+					In: true,
+					// The pipeline invokes the function
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return links
+}
+
+func NewCodePipelinePipelineAdapter(client CodePipelineClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*codepipeline.ListPipelinesInput, *codepipeline.ListPipelinesOutput, *codepipeline.GetPipelineInput, *codepipeline.GetPipelineOutput, CodePipelineClient, *codepipeline.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*codepipeline.ListPipelinesInput, *codepipeline.ListPipelinesOutput, *codepipeline.GetPipelineInput, *codepipeline.GetPipelineOutput, CodePipelineClient, *codepipeline.Options]{
+		ItemType:        "codepipeline-pipeline",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: codepipelinePipelineAdapterMetadata,
+		ListInput:       &codepipeline.ListPipelinesInput{},
+		GetInputMapper: func(scope, query string) *codepipeline.GetPipelineInput {
+			return &codepipeline.GetPipelineInput{
+				Name: &query,
+			}
+		},
+		ListFuncPaginatorBuilder: func(client CodePipelineClient, input *codepipeline.ListPipelinesInput) adapterhelpers.Paginator[*codepipeline.ListPipelinesOutput, *codepipeline.Options] {
+			return codepipeline.NewListPipelinesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *codepipeline.ListPipelinesOutput, _ *codepipeline.ListPipelinesInput) ([]*codepipeline.GetPipelineInput, error) {
+			inputs := make([]*codepipeline.GetPipelineInput, 0, len(output.Pipelines))
+
+			for _, pipeline := range output.Pipelines {
+				inputs = append(inputs, &codepipeline.GetPipelineInput{
+					Name: pipeline.Name,
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: pipelineGetFunc,
+	}
+}
+
+var codepipelinePipelineAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "codepipeline-pipeline",
+	DescriptiveName: "CodePipeline Pipeline",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a pipeline by name",
+		ListDescription:   "List all pipelines",
+		SearchDescription: "Search for pipelines by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_codepipeline.name"},
+	},
+	PotentialLinks: []string{
+		"iam-role",
+		"s3-bucket",
+		"kms-key",
+		"ecr-repository",
+		"http",
+		"codebuild-project",
+		"codedeploy-deployment-group",
+		"ecs-service",
+		"elasticbeanstalk-environment",
+		"lambda-function",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
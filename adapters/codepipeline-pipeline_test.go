@@ -0,0 +1,253 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestPipelineGetFunc(t *testing.T) {
+	client := CodePipelineTestClient{
+		GetPipelineOutput: &codepipeline.GetPipelineOutput{
+			Metadata: &types.PipelineMetadata{
+				PipelineArn: adapterhelpers.PtrString("arn:aws:codepipeline:eu-west-2:123456789012:billing-api"),
+				Created:     adapterhelpers.PtrTime(time.Now()),
+				Updated:     adapterhelpers.PtrTime(time.Now()),
+			},
+			Pipeline: &types.PipelineDeclaration{
+				Name:         adapterhelpers.PtrString("billing-api"),
+				RoleArn:      adapterhelpers.PtrString("arn:aws:iam::123456789012:role/codepipeline-billing-api"),
+				PipelineType: types.PipelineTypeV2,
+				ArtifactStore: &types.ArtifactStore{
+					Type:     types.ArtifactStoreTypeS3,
+					Location: adapterhelpers.PtrString("codepipeline-artifacts-eu-west-2"),
+					EncryptionKey: &types.EncryptionKey{
+						Type: types.EncryptionKeyTypeKms,
+						Id:   adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+					},
+				},
+				Stages: []types.StageDeclaration{
+					{
+						Name: adapterhelpers.PtrString("Source"),
+						Actions: []types.ActionDeclaration{
+							{
+								Name: adapterhelpers.PtrString("Code"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategorySource,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("CodeCommit"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"RepositoryName": "billing-api",
+									"BranchName":     "main",
+								},
+							},
+							{
+								Name: adapterhelpers.PtrString("BaseImage"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategorySource,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("ECR"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"RepositoryName": "node-base",
+								},
+							},
+						},
+					},
+					{
+						Name: adapterhelpers.PtrString("Build"),
+						Actions: []types.ActionDeclaration{
+							{
+								Name: adapterhelpers.PtrString("Build"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategoryBuild,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("CodeBuild"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"ProjectName": "billing-api",
+								},
+							},
+						},
+					},
+					{
+						Name: adapterhelpers.PtrString("Deploy"),
+						Actions: []types.ActionDeclaration{
+							{
+								Name: adapterhelpers.PtrString("Service"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategoryDeploy,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("ECS"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"ClusterName": "production",
+									"ServiceName": "billing-api",
+								},
+							},
+							{
+								Name: adapterhelpers.PtrString("Workers"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategoryDeploy,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("CodeDeploy"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Region:  adapterhelpers.PtrString("us-east-1"),
+								RoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/codepipeline-cross-region"),
+								Configuration: map[string]string{
+									"ApplicationName":     "billing",
+									"DeploymentGroupName": "workers",
+								},
+							},
+							{
+								Name: adapterhelpers.PtrString("Docs"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategoryDeploy,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("S3"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"BucketName": "billing-api-docs",
+									"Extract":    "true",
+								},
+							},
+							{
+								Name: adapterhelpers.PtrString("Smoke"),
+								ActionTypeId: &types.ActionTypeId{
+									Category: types.ActionCategoryInvoke,
+									Owner:    types.ActionOwnerAws,
+									Provider: adapterhelpers.PtrString("Lambda"),
+									Version:  adapterhelpers.PtrString("1"),
+								},
+								Configuration: map[string]string{
+									"FunctionName": "billing-api-smoke-test",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		ListTagsForResourceOutput: &codepipeline.ListTagsForResourceOutput{
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("billing"),
+				},
+			},
+		},
+	}
+
+	item, err := pipelineGetFunc(context.Background(), client, "123456789012.eu-west-2", &codepipeline.GetPipelineInput{
+		Name: adapterhelpers.PtrString("billing-api"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["team"] != "billing" {
+		t.Errorf("expected tag team=billing, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codepipeline-billing-api",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "codepipeline-artifacts-eu-west-2",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://git-codecommit.eu-west-2.amazonaws.com/v1/repos/billing-api",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "node-base",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "codebuild-project",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecs-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "production/billing-api",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/codepipeline-cross-region",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "codedeploy-deployment-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing/workers",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api-docs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-api-smoke-test",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewCodePipelinePipelineAdapter(t *testing.T) {
+	client, account, region := codepipelineGetAutoConfig(t)
+
+	adapter := NewCodePipelinePipelineAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
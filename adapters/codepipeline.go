@@ -0,0 +1,39 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+)
+
+type CodePipelineClient interface {
+	GetPipeline(ctx context.Context, params *codepipeline.GetPipelineInput, optFns ...func(*codepipeline.Options)) (*codepipeline.GetPipelineOutput, error)
+
+	codepipeline.ListPipelinesAPIClient
+	codepipeline.ListTagsForResourceAPIClient
+}
+
+// codepipelineListTags Gets the tags for a CodePipeline resource
+func codepipelineListTags(ctx context.Context, client CodePipelineClient, arn string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	paginator := codepipeline.NewListTagsForResourcePaginator(client, &codepipeline.ListTagsForResourceInput{
+		ResourceArn: &arn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
@@ -0,0 +1,34 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/codepipeline"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type CodePipelineTestClient struct {
+	GetPipelineOutput         *codepipeline.GetPipelineOutput
+	ListPipelinesOutput       *codepipeline.ListPipelinesOutput
+	ListTagsForResourceOutput *codepipeline.ListTagsForResourceOutput
+}
+
+func (t CodePipelineTestClient) GetPipeline(context.Context, *codepipeline.GetPipelineInput, ...func(*codepipeline.Options)) (*codepipeline.GetPipelineOutput, error) {
+	return t.GetPipelineOutput, nil
+}
+
+func (t CodePipelineTestClient) ListPipelines(context.Context, *codepipeline.ListPipelinesInput, ...func(*codepipeline.Options)) (*codepipeline.ListPipelinesOutput, error) {
+	return t.ListPipelinesOutput, nil
+}
+
+func (t CodePipelineTestClient) ListTagsForResource(context.Context, *codepipeline.ListTagsForResourceInput, ...func(*codepipeline.Options)) (*codepipeline.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func codepipelineGetAutoConfig(t *testing.T) (*codepipeline.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := codepipeline.NewFromConfig(config)
+
+	return client, account, region
+}
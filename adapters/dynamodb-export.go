@@ -0,0 +1,203 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func dynamodbExportGetFunc(ctx context.Context, client Client, scope, query string) (*types.ExportDescription, error) {
+	out, err := client.DescribeExport(ctx, &dynamodb.DescribeExportInput{
+		ExportArn: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ExportDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "export description was nil",
+		}
+	}
+
+	return out.ExportDescription, nil
+}
+
+// dynamodbListExports Lists exports, optionally only those for a given table
+func dynamodbListExports(ctx context.Context, client Client, scope string, tableArn *string) ([]*types.ExportDescription, error) {
+	paginator := dynamodb.NewListExportsPaginator(client, &dynamodb.ListExportsInput{
+		TableArn: tableArn,
+	})
+
+	exports := make([]*types.ExportDescription, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the destination
+		for _, summary := range out.ExportSummaries {
+			if summary.ExportArn == nil {
+				continue
+			}
+
+			export, err := dynamodbExportGetFunc(ctx, client, scope, *summary.ExportArn)
+
+			if err != nil {
+				return nil, err
+			}
+
+			exports = append(exports, export)
+		}
+	}
+
+	return exports, nil
+}
+
+func dynamodbExportListFunc(ctx context.Context, client Client, scope string) ([]*types.ExportDescription, error) {
+	return dynamodbListExports(ctx, client, scope, nil)
+}
+
+// dynamodbExportSearchFunc Searches for exports of a table by table name or
+// ARN, or gets an export by ARN. Export ARNs are in the format
+// arn:aws:dynamodb:{region}:{account}:table/{tableName}/export/{id}
+func dynamodbExportSearchFunc(ctx context.Context, client Client, scope, query string) ([]*types.ExportDescription, error) {
+	tableArn := query
+
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		if strings.Contains(a.Resource, "/export/") {
+			export, err := dynamodbExportGetFunc(ctx, client, scope, query)
+
+			if err != nil {
+				return nil, err
+			}
+
+			return []*types.ExportDescription{export}, nil
+		}
+	} else {
+		// Exports can only be listed by table ARN, so build one from the name
+		accountID, region, err := adapterhelpers.ParseScope(scope)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tableArn = fmt.Sprintf("arn:%v:dynamodb:%v:%v:table/%v", adapterhelpers.PartitionFromRegion(region), region, accountID, query)
+	}
+
+	return dynamodbListExports(ctx, client, scope, &tableArn)
+}
+
+func dynamodbExportItemMapper(_, scope string, awsItem *types.ExportDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "dynamodb-export",
+		UniqueAttribute: "ExportArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.ExportStatus {
+	case types.ExportStatusCompleted:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ExportStatusInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ExportStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.TableArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.TableArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dynamodb-table",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.TableArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The export is a copy of the table's data
+					In: true,
+					// Exports are read from point-in-time recovery so don't
+					// affect the table
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.S3Bucket != nil {
+		// Exports can be written to buckets in other accounts
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		if awsItem.S3BucketOwner != nil {
+			accountID = *awsItem.S3BucketOwner
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.S3Bucket,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The export is lost if the bucket is deleted
+				In: true,
+				// The export writes its data to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.S3SseKmsKeyId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.S3SseKmsKeyId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewDynamoDBExportAdapter(client Client, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ExportDescription, Client, *dynamodb.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ExportDescription, Client, *dynamodb.Options]{
+		ItemType:        "dynamodb-export",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: dynamodbExportAdapterMetadata,
+		GetFunc:         dynamodbExportGetFunc,
+		ListFunc:        dynamodbExportListFunc,
+		SearchFunc:      dynamodbExportSearchFunc,
+		ItemMapper:      dynamodbExportItemMapper,
+	}
+}
+
+var dynamodbExportAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "dynamodb-export",
+	DescriptiveName: "DynamoDB Export",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a DynamoDB export by ARN",
+		ListDescription:   "List all DynamoDB exports",
+		SearchDescription: "Search for DynamoDB exports by table name, table ARN or export ARN",
+	},
+	PotentialLinks: []string{"dynamodb-table", "s3-bucket", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
@@ -0,0 +1,129 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *DynamoDBTestClient) DescribeExport(ctx context.Context, params *dynamodb.DescribeExportInput, optFns ...func(*dynamodb.Options)) (*dynamodb.DescribeExportOutput, error) {
+	return &dynamodb.DescribeExportOutput{
+		ExportDescription: &types.ExportDescription{
+			ExportArn:       adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test2/export/01673461724486-a6007753"),
+			ExportStatus:    types.ExportStatusCompleted,
+			ExportFormat:    types.ExportFormatDynamodbJson,
+			ExportType:      types.ExportTypeFullExport,
+			ExportTime:      adapterhelpers.PtrTime(time.Now()),
+			StartTime:       adapterhelpers.PtrTime(time.Now()),
+			EndTime:         adapterhelpers.PtrTime(time.Now()),
+			TableArn:        adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test2"), // link
+			TableId:         adapterhelpers.PtrString("12670f3b-8ca1-463b-b15e-f2e27eaf70b0"),
+			S3Bucket:        adapterhelpers.PtrString("test-exports"), // link
+			S3BucketOwner:   adapterhelpers.PtrString("111111111111"),
+			S3Prefix:        adapterhelpers.PtrString("test2/"),
+			S3SseAlgorithm:  types.S3SseAlgorithmKms,
+			S3SseKmsKeyId:   adapterhelpers.PtrString("arn:aws:kms:eu-west-1:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab"), // link
+			BilledSizeBytes: adapterhelpers.PtrInt64(1024),
+			ItemCount:       adapterhelpers.PtrInt64(10),
+		},
+	}, nil
+}
+
+func (t *DynamoDBTestClient) ListExports(ctx context.Context, params *dynamodb.ListExportsInput, optFns ...func(*dynamodb.Options)) (*dynamodb.ListExportsOutput, error) {
+	return &dynamodb.ListExportsOutput{
+		ExportSummaries: []types.ExportSummary{
+			{
+				ExportArn:    adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test2/export/01673461724486-a6007753"),
+				ExportStatus: types.ExportStatusCompleted,
+				ExportType:   types.ExportTypeFullExport,
+			},
+		},
+	}, nil
+}
+
+func TestDynamoDBExportItemMapper(t *testing.T) {
+	export, err := dynamodbExportGetFunc(context.Background(), &DynamoDBTestClient{}, "052392120703.eu-west-1", "arn:aws:dynamodb:eu-west-1:052392120703:table/test2/export/01673461724486-a6007753")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := dynamodbExportItemMapper("", "052392120703.eu-west-1", export)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:dynamodb:eu-west-1:052392120703:table/test2",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "test-exports",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:052392120703:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestDynamoDBExportSearchFunc(t *testing.T) {
+	queries := []string{
+		"test2",
+		"arn:aws:dynamodb:eu-west-1:052392120703:table/test2",
+		"arn:aws:dynamodb:eu-west-1:052392120703:table/test2/export/01673461724486-a6007753",
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			exports, err := dynamodbExportSearchFunc(context.Background(), &DynamoDBTestClient{}, "052392120703.eu-west-1", query)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(exports) != 1 {
+				t.Errorf("expected 1 export, got %v", len(exports))
+			}
+		})
+	}
+}
+
+func TestNewDynamoDBExportAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := dynamodb.NewFromConfig(config)
+
+	adapter := NewDynamoDBExportAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,192 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func dynamodbStreamGetFunc(ctx context.Context, client DynamoDBStreamsClient, scope, query string) (*types.StreamDescription, error) {
+	out, err := client.DescribeStream(ctx, &dynamodbstreams.DescribeStreamInput{
+		StreamArn: &query,
+		// We don't return the shards so there's no point getting more than
+		// one
+		Limit: adapterhelpers.PtrInt32(1),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.StreamDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "stream description was nil",
+		}
+	}
+
+	return out.StreamDescription, nil
+}
+
+// dynamodbListStreams Lists streams, optionally only those for a given table.
+// There isn't a paginator for this in the SDK
+func dynamodbListStreams(ctx context.Context, client DynamoDBStreamsClient, scope string, tableName *string) ([]*types.StreamDescription, error) {
+	streams := make([]*types.StreamDescription, 0)
+
+	var lastEvaluatedStreamArn *string
+
+	for {
+		out, err := client.ListStreams(ctx, &dynamodbstreams.ListStreamsInput{
+			TableName:               tableName,
+			ExclusiveStartStreamArn: lastEvaluatedStreamArn,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the status
+		for _, summary := range out.Streams {
+			if summary.StreamArn == nil {
+				continue
+			}
+
+			stream, err := dynamodbStreamGetFunc(ctx, client, scope, *summary.StreamArn)
+
+			if err != nil {
+				return nil, err
+			}
+
+			streams = append(streams, stream)
+		}
+
+		lastEvaluatedStreamArn = out.LastEvaluatedStreamArn
+
+		if lastEvaluatedStreamArn == nil {
+			break
+		}
+	}
+
+	return streams, nil
+}
+
+func dynamodbStreamListFunc(ctx context.Context, client DynamoDBStreamsClient, scope string) ([]*types.StreamDescription, error) {
+	return dynamodbListStreams(ctx, client, scope, nil)
+}
+
+// dynamodbStreamSearchFunc Searches for streams by table name, or gets a
+// stream by ARN. Stream ARNs are in the format
+// arn:aws:dynamodb:{region}:{account}:table/{tableName}/stream/{label} so the
+// default ARN handling doesn't work
+func dynamodbStreamSearchFunc(ctx context.Context, client DynamoDBStreamsClient, scope, query string) ([]*types.StreamDescription, error) {
+	if _, err := adapterhelpers.ParseARN(query); err == nil {
+		stream, err := dynamodbStreamGetFunc(ctx, client, scope, query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.StreamDescription{stream}, nil
+	}
+
+	return dynamodbListStreams(ctx, client, scope, &query)
+}
+
+func dynamodbStreamItemMapper(_, scope string, awsItem *types.StreamDescription) (*sdp.Item, error) {
+	// Shards are constantly split and expire, so they aren't worth keeping
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "Shards", "LastEvaluatedShardId")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "dynamodb-stream",
+		UniqueAttribute: "StreamArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.StreamStatus {
+	case types.StreamStatusEnabled:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StreamStatusEnabling, types.StreamStatusDisabling:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.StreamStatusDisabled:
+		// Disabled streams are kept for 24 hours so that consumers can finish
+		// reading them
+		item.Health = nil
+	}
+
+	if awsItem.TableName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.TableName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Writes to the table are what go into the stream
+				In: true,
+				// The stream can't affect the table
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.StreamArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-event-source-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.StreamArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the mapping change how the stream is consumed
+				In: true,
+				// Changes to the stream affect the functions that consume it
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewDynamoDBStreamAdapter(client DynamoDBStreamsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.StreamDescription, DynamoDBStreamsClient, *dynamodbstreams.Options] {
+	return &adapterhelpers.GetListAdapter[*types.StreamDescription, DynamoDBStreamsClient, *dynamodbstreams.Options]{
+		ItemType:        "dynamodb-stream",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: dynamodbStreamAdapterMetadata,
+		GetFunc:         dynamodbStreamGetFunc,
+		ListFunc:        dynamodbStreamListFunc,
+		SearchFunc:      dynamodbStreamSearchFunc,
+		ItemMapper:      dynamodbStreamItemMapper,
+	}
+}
+
+var dynamodbStreamAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "dynamodb-stream",
+	DescriptiveName: "DynamoDB Stream",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a DynamoDB stream by ARN",
+		ListDescription:   "List all DynamoDB streams",
+		SearchDescription: "Search for DynamoDB streams by table name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_dynamodb_table.stream_arn"},
+	},
+	PotentialLinks: []string{"dynamodb-table", "lambda-event-source-mapping"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
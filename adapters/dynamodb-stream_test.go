@@ -0,0 +1,118 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var dynamodbDescribeStreamOutput = dynamodbstreams.DescribeStreamOutput{
+	StreamDescription: &types.StreamDescription{
+		StreamArn:               adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371"), // link
+		StreamLabel:             adapterhelpers.PtrString("2023-01-11T16:53:02.371"),
+		StreamStatus:            types.StreamStatusEnabled,
+		StreamViewType:          types.StreamViewTypeKeysOnly,
+		TableName:               adapterhelpers.PtrString("test-DDBTable-1X52D7BWAAB2H"), // link
+		CreationRequestDateTime: adapterhelpers.PtrTime(time.Now()),
+		KeySchema: []types.KeySchemaElement{
+			{
+				AttributeName: adapterhelpers.PtrString("ArtistId"),
+				KeyType:       types.KeyTypeHash,
+			},
+		},
+		Shards: []types.Shard{
+			{
+				ShardId: adapterhelpers.PtrString("shardId-00000001673456789012-abcdef01"),
+				SequenceNumberRange: &types.SequenceNumberRange{
+					StartingSequenceNumber: adapterhelpers.PtrString("100000000000000000000001"),
+				},
+			},
+		},
+	},
+}
+
+func TestDynamoDBStreamItemMapper(t *testing.T) {
+	item, err := dynamodbStreamItemMapper("", "052392120703.eu-west-1", dynamodbDescribeStreamOutput.StreamDescription)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "test-DDBTable-1X52D7BWAAB2H",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
+		{
+			ExpectedType:   "lambda-event-source-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestDynamoDBStreamSearchFunc(t *testing.T) {
+	client := DynamoDBStreamsTestClient{
+		DescribeStreamOutput: &dynamodbDescribeStreamOutput,
+		ListStreamsOutput: &dynamodbstreams.ListStreamsOutput{
+			Streams: []types.Stream{
+				{
+					StreamArn:   adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371"),
+					StreamLabel: adapterhelpers.PtrString("2023-01-11T16:53:02.371"),
+					TableName:   adapterhelpers.PtrString("test-DDBTable-1X52D7BWAAB2H"),
+				},
+			},
+		},
+	}
+
+	queries := []string{
+		"test-DDBTable-1X52D7BWAAB2H",
+		"arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371",
+	}
+
+	for _, query := range queries {
+		t.Run(query, func(t *testing.T) {
+			streams, err := dynamodbStreamSearchFunc(context.Background(), client, "052392120703.eu-west-1", query)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(streams) != 1 {
+				t.Errorf("expected 1 stream, got %v", len(streams))
+			}
+		})
+	}
+}
+
+func TestNewDynamoDBStreamAdapter(t *testing.T) {
+	client, account, region := dynamodbstreamsGetAutoConfig(t)
+
+	adapter := NewDynamoDBStreamAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,210 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// DynamoDBTableReplicaDetails A replica of a global table along with the
+// table in this region that it is a replica of
+type DynamoDBTableReplicaDetails struct {
+	TableName string
+	Replica   types.ReplicaDescription
+}
+
+// dynamodbTableReplicaGetFunc Gets a replica by its unique name:
+// {tableName}/{region}. Replicas can't be described directly so we have to
+// describe the table
+func dynamodbTableReplicaGetFunc(ctx context.Context, client Client, scope, query string) (*DynamoDBTableReplicaDetails, error) {
+	tableName, region, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {tableName}/{region}",
+		}
+	}
+
+	replicas, err := dynamodbTableReplicaSearchFunc(ctx, client, scope, tableName)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, replica := range replicas {
+		if replica.Replica.RegionName != nil && *replica.Replica.RegionName == region {
+			return replica, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "table " + tableName + " has no replica in " + region,
+	}
+}
+
+// dynamodbTableReplicaSearchFunc Lists all replicas of a table, by table name
+// or ARN
+func dynamodbTableReplicaSearchFunc(ctx context.Context, client Client, scope, query string) ([]*DynamoDBTableReplicaDetails, error) {
+	tableName := query
+
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		tableName = strings.TrimPrefix(a.Resource, "table/")
+	}
+
+	out, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{
+		TableName: &tableName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Table == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "table was nil",
+		}
+	}
+
+	replicas := make([]*DynamoDBTableReplicaDetails, 0, len(out.Table.Replicas))
+
+	for _, replica := range out.Table.Replicas {
+		replicas = append(replicas, &DynamoDBTableReplicaDetails{
+			TableName: tableName,
+			Replica:   replica,
+		})
+	}
+
+	return replicas, nil
+}
+
+func dynamodbTableReplicaItemMapper(_, scope string, awsItem *DynamoDBTableReplicaDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.ReplicaDescription
+		TableName string
+	}{
+		ReplicaDescription: awsItem.Replica,
+		TableName:          awsItem.TableName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Replica.RegionName == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "replica is missing its region",
+		}
+	}
+
+	region := *awsItem.Replica.RegionName
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {tableName}/{region}
+	if err = attributes.Set("UniqueName", awsItem.TableName+"/"+region); err != nil {
+		return nil, err
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+	replicaScope := adapterhelpers.FormatScope(accountID, region)
+
+	item := sdp.Item{
+		Type:            "dynamodb-table-replica",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "dynamodb-table",
+					Method: sdp.QueryMethod_GET,
+					Query:  awsItem.TableName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to this table are replicated
+					In: true,
+					// Writes to the replica are replicated back
+					Out: true,
+				},
+			},
+			{
+				// The replica itself is a table with the same name in the
+				// other region
+				Query: &sdp.Query{
+					Type:   "dynamodb-table",
+					Method: sdp.QueryMethod_GET,
+					Query:  awsItem.TableName,
+					Scope:  replicaScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Tightly coupled, writes go both ways
+					In:  true,
+					Out: true,
+				},
+			},
+		},
+	}
+
+	switch awsItem.Replica.ReplicaStatus {
+	case types.ReplicaStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ReplicaStatusCreating, types.ReplicaStatusUpdating, types.ReplicaStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ReplicaStatusRegionDisabled:
+		// The region has been disabled, DynamoDB will remove the replica if
+		// it isn't re-enabled within 20 hours
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.ReplicaStatusCreationFailed, types.ReplicaStatusInaccessibleEncryptionCredentials:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	// Each replica has its own key in its own region
+	if awsItem.Replica.KMSMasterKeyId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.Replica.KMSMasterKeyId, replicaScope))
+	}
+
+	return &item, nil
+}
+
+func NewDynamoDBTableReplicaAdapter(client Client, accountID string, region string) *adapterhelpers.GetListAdapter[*DynamoDBTableReplicaDetails, Client, *dynamodb.Options] {
+	return &adapterhelpers.GetListAdapter[*DynamoDBTableReplicaDetails, Client, *dynamodb.Options]{
+		ItemType:        "dynamodb-table-replica",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: dynamodbTableReplicaAdapterMetadata,
+		GetFunc:         dynamodbTableReplicaGetFunc,
+		// Replicas can only be found by describing each table
+		DisableList: true,
+		SearchFunc:  dynamodbTableReplicaSearchFunc,
+		ItemMapper:  dynamodbTableReplicaItemMapper,
+	}
+}
+
+var dynamodbTableReplicaAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "dynamodb-table-replica",
+	DescriptiveName: "DynamoDB Global Table Replica",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a global table replica by {tableName}/{region}",
+		SearchDescription: "Search for global table replicas by table name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_dynamodb_table_replica.global_table_arn",
+		},
+	},
+	PotentialLinks: []string{"dynamodb-table", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDynamoDBTableReplicaGetFunc(t *testing.T) {
+	replica, err := dynamodbTableReplicaGetFunc(context.Background(), &DynamoDBTestClient{}, "052392120703.eu-west-1", "test-DDBTable-1X52D7BWAAB2H/eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := dynamodbTableReplicaItemMapper("", "052392120703.eu-west-1", replica)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "test-DDBTable-1X52D7BWAAB2H/eu-west-2" {
+		t.Errorf("expected unique attribute value to be test-DDBTable-1X52D7BWAAB2H/eu-west-2, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "test-DDBTable-1X52D7BWAAB2H",
+			ExpectedScope:  "052392120703.eu-west-1",
+		},
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "test-DDBTable-1X52D7BWAAB2H",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "keyID",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if _, err = dynamodbTableReplicaGetFunc(context.Background(), &DynamoDBTestClient{}, "052392120703.eu-west-1", "test-DDBTable-1X52D7BWAAB2H/us-east-1"); err == nil {
+		t.Error("expected an error for a region without a replica")
+	}
+}
+
+func TestDynamoDBTableReplicaSearchFunc(t *testing.T) {
+	replicas, err := dynamodbTableReplicaSearchFunc(context.Background(), &DynamoDBTestClient{}, "052392120703.eu-west-1", "arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(replicas) != 1 {
+		t.Fatalf("expected 1 replica, got %v", len(replicas))
+	}
+
+	if replicas[0].TableName != "test-DDBTable-1X52D7BWAAB2H" {
+		t.Errorf("expected table name test-DDBTable-1X52D7BWAAB2H, got %v", replicas[0].TableName)
+	}
+}
+
+func TestNewDynamoDBTableReplicaAdapter(t *testing.T) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := dynamodb.NewFromConfig(config)
+
+	adapter := NewDynamoDBTableReplicaAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
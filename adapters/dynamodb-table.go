@@ -162,6 +162,79 @@ func tableGetFunc(ctx context.Context, client Client, scope string, input *dynam
 		}
 	}
 
+	if len(table.Replicas) > 0 && table.TableName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-table-replica",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *table.TableName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the table are replicated to other regions
+				In:  true,
+				Out: true,
+			},
+		})
+
+		if accountID, _, err := adapterhelpers.ParseScope(scope); err == nil {
+			for _, replica := range table.Replicas {
+				if replica.RegionName != nil {
+					// The replica is a table with the same name in another
+					// region
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "dynamodb-table",
+							Method: sdp.QueryMethod_GET,
+							Query:  *table.TableName,
+							Scope:  adapterhelpers.FormatScope(accountID, *replica.RegionName),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Writes go both ways between replicas
+							In:  true,
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+	}
+
+	if table.LatestStreamArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dynamodb-stream",
+				Method: sdp.QueryMethod_GET,
+				Query:  *table.LatestStreamArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The stream can't affect the table
+				In: false,
+				// Writes to the table go into the stream
+				Out: true,
+			},
+		})
+
+		// Event source mappings are created against the stream rather than
+		// the table, so we search for consumers using the stream ARN
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-event-source-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *table.LatestStreamArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the mapping change how the stream is consumed
+				In: true,
+				// Changes to the table affect the functions that consume its
+				// stream
+				Out: true,
+			},
+		})
+	}
+
 	return &item, nil
 }
 
@@ -212,7 +285,7 @@ var dynamodbTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		SearchDescription: "Search for DynamoDB tables by ARN",
 	},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
-	PotentialLinks: []string{"kinesis-stream", "backup-recovery-point", "dynamodb-table", "kms-key"},
+	PotentialLinks: []string{"kinesis-stream", "backup-recovery-point", "dynamodb-table", "dynamodb-table-replica", "dynamodb-stream", "kms-key", "lambda-event-source-mapping"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformMethod: sdp.QueryMethod_SEARCH, TerraformQueryMap: "aws_dynamodb_table.arn"},
 	},
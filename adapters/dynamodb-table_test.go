@@ -83,7 +83,7 @@ func (t *DynamoDBTestClient) DescribeTable(context.Context, *dynamodb.DescribeTa
 				BillingMode: types.BillingModePayPerRequest,
 			},
 			GlobalTableVersion: adapterhelpers.PtrString("1"),
-			LatestStreamArn:    adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371"), // This doesn't get linked because there is no more data to get
+			LatestStreamArn:    adapterhelpers.PtrString("arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371"), // link
 			LatestStreamLabel:  adapterhelpers.PtrString("2023-01-11T16:53:02.371"),
 			LocalSecondaryIndexes: []types.LocalSecondaryIndexDescription{
 				{
@@ -216,6 +216,24 @@ func TestTableGetFunc(t *testing.T) {
 			ExpectedQuery:  "arn:aws:service:region:account:type/id",
 			ExpectedScope:  "account.region",
 		},
+		{
+			ExpectedType:   "dynamodb-table-replica",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "test-DDBTable-1X52D7BWAAB2H",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "dynamodb-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "lambda-event-source-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:dynamodb:eu-west-1:052392120703:table/test-DDBTable-1X52D7BWAAB2H/stream/2023-01-11T16:53:02.371",
+			ExpectedScope:  "foo",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type DynamoDBStreamsTestClient struct {
+	DescribeStreamOutput *dynamodbstreams.DescribeStreamOutput
+	ListStreamsOutput    *dynamodbstreams.ListStreamsOutput
+}
+
+func (t DynamoDBStreamsTestClient) DescribeStream(context.Context, *dynamodbstreams.DescribeStreamInput, ...func(*dynamodbstreams.Options)) (*dynamodbstreams.DescribeStreamOutput, error) {
+	return t.DescribeStreamOutput, nil
+}
+
+func (t DynamoDBStreamsTestClient) ListStreams(context.Context, *dynamodbstreams.ListStreamsInput, ...func(*dynamodbstreams.Options)) (*dynamodbstreams.ListStreamsOutput, error) {
+	return t.ListStreamsOutput, nil
+}
+
+func dynamodbstreamsGetAutoConfig(t *testing.T) (*dynamodbstreams.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := dynamodbstreams.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -24,6 +24,24 @@ func addressInputMapperList(scope string) (*ec2.DescribeAddressesInput, error) {
 	return &ec2.DescribeAddressesInput{}, nil
 }
 
+// addressInputMapperSearch Maps search queries to the correct input. Other
+// services such as Global Accelerator refer to addresses by their allocation
+// ID rather than their public IP, so we support searching by either the
+// allocation ID or the ARN, which contains the allocation ID
+func addressInputMapperSearch(_ context.Context, _ *ec2.Client, scope, query string) (*ec2.DescribeAddressesInput, error) {
+	allocationID := query
+
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		allocationID = a.ResourceID()
+	}
+
+	return &ec2.DescribeAddressesInput{
+		AllocationIds: []string{
+			allocationID,
+		},
+	}, nil
+}
+
 // AddressOutputMapper Maps API output to items
 func addressOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeAddressesInput, output *ec2.DescribeAddressesOutput) ([]*sdp.Item, error) {
 	if output == nil {
@@ -145,9 +163,10 @@ func NewEC2AddressAdapter(client *ec2.Client, accountID string, region string) *
 		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeAddressesInput) (*ec2.DescribeAddressesOutput, error) {
 			return client.DescribeAddresses(ctx, input)
 		},
-		InputMapperGet:  addressInputMapperGet,
-		InputMapperList: addressInputMapperList,
-		OutputMapper:    addressOutputMapper,
+		InputMapperGet:    addressInputMapperGet,
+		InputMapperList:   addressInputMapperList,
+		InputMapperSearch: addressInputMapperSearch,
+		OutputMapper:      addressOutputMapper,
 	}
 }
 
@@ -160,7 +179,7 @@ var addressAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		Search:            true,
 		GetDescription:    "Get an EC2 address by Public IP",
 		ListDescription:   "List EC2 addresses",
-		SearchDescription: "Search for EC2 addresses by ARN",
+		SearchDescription: "Search for EC2 addresses by allocation ID or ARN",
 	},
 	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 	TerraformMappings: []*sdp.TerraformMapping{
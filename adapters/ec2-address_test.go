@@ -40,6 +40,29 @@ func TestAddressInputMapperList(t *testing.T) {
 	}
 }
 
+func TestAddressInputMapperSearch(t *testing.T) {
+	queries := []string{
+		"eipalloc-0123456789abcdef0",
+		"arn:aws:ec2:eu-west-2:123456789012:elastic-ip/eipalloc-0123456789abcdef0",
+	}
+
+	for _, query := range queries {
+		input, err := addressInputMapperSearch(context.Background(), nil, "foo", query)
+
+		if err != nil {
+			t.Error(err)
+		}
+
+		if len(input.AllocationIds) != 1 {
+			t.Fatalf("expected 1 allocation ID, got %v", len(input.AllocationIds))
+		}
+
+		if input.AllocationIds[0] != "eipalloc-0123456789abcdef0" {
+			t.Errorf("expected allocation ID to be eipalloc-0123456789abcdef0, got %v", input.AllocationIds[0])
+		}
+	}
+}
+
 func TestAddressOutputMapper(t *testing.T) {
 	output := ec2.DescribeAddressesOutput{
 		Addresses: []types.Address{
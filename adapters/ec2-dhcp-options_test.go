@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDhcpOptionsInputMapperGet(t *testing.T) {
+	input, err := dhcpOptionsInputMapperGet("foo", "dopt-0959b838bf4a4c7b8")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.DhcpOptionsIds) != 1 {
+		t.Fatalf("expected 1 DHCP options ID, got %v", len(input.DhcpOptionsIds))
+	}
+
+	if input.DhcpOptionsIds[0] != "dopt-0959b838bf4a4c7b8" {
+		t.Errorf("expected DHCP options ID to be dopt-0959b838bf4a4c7b8, got %v", input.DhcpOptionsIds[0])
+	}
+}
+
+func TestDhcpOptionsInputMapperList(t *testing.T) {
+	input, err := dhcpOptionsInputMapperList("foo")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.Filters) != 0 || len(input.DhcpOptionsIds) != 0 {
+		t.Errorf("non-empty input: %v", input)
+	}
+}
+
+func TestDhcpOptionsOutputMapper(t *testing.T) {
+	output := &ec2.DescribeDhcpOptionsOutput{
+		DhcpOptions: []types.DhcpOptions{
+			{
+				DhcpOptionsId: adapterhelpers.PtrString("dopt-0959b838bf4a4c7b8"),
+				OwnerId:       adapterhelpers.PtrString("052392120703"),
+				DhcpConfigurations: []types.DhcpConfiguration{
+					{
+						Key: adapterhelpers.PtrString("domain-name"),
+						Values: []types.AttributeValue{
+							{Value: adapterhelpers.PtrString("eu-west-2.compute.internal")},
+						},
+					},
+					{
+						Key: adapterhelpers.PtrString("domain-name-servers"),
+						Values: []types.AttributeValue{
+							{Value: adapterhelpers.PtrString("AmazonProvidedDNS")},
+							{Value: adapterhelpers.PtrString("10.0.0.2")},
+						},
+					},
+					{
+						Key: adapterhelpers.PtrString("ntp-servers"),
+						Values: []types.AttributeValue{
+							{Value: adapterhelpers.PtrString("169.254.169.123")},
+						},
+					},
+				},
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("default"),
+					},
+				},
+			},
+		},
+	}
+
+	items, err := dhcpOptionsOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if len(item.GetLinkedItemQueries()) != 2 {
+		t.Errorf("expected 2 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.0.2",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "169.254.169.123",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEC2DhcpOptionsAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2DhcpOptionsAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,190 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func flowLogInputMapperGet(scope string, query string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{
+		FlowLogIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func flowLogInputMapperList(scope string) (*ec2.DescribeFlowLogsInput, error) {
+	return &ec2.DescribeFlowLogsInput{}, nil
+}
+
+// flowLogResourceLink Links a flow log to the resource that it is capturing
+// traffic for. This can be a VPC, subnet, network interface, transit gateway
+// or transit gateway attachment
+func flowLogResourceLink(resourceID string, scope string) *sdp.LinkedItemQuery {
+	var typ string
+
+	switch {
+	case strings.HasPrefix(resourceID, "vpc-"):
+		typ = "ec2-vpc"
+	case strings.HasPrefix(resourceID, "subnet-"):
+		typ = "ec2-subnet"
+	case strings.HasPrefix(resourceID, "eni-"):
+		typ = "ec2-network-interface"
+	case strings.HasPrefix(resourceID, "tgw-attach-"):
+		typ = "ec2-transit-gateway-attachment"
+	case strings.HasPrefix(resourceID, "tgw-"):
+		typ = "ec2-transit-gateway"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   typ,
+			Method: sdp.QueryMethod_GET,
+			Query:  resourceID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Deleting the resource deletes the flow log
+			In: true,
+			// The flow log doesn't affect the traffic it captures
+			Out: false,
+		},
+	}
+}
+
+func flowLogOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeFlowLogsInput, output *ec2.DescribeFlowLogsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, flowLog := range output.FlowLogs {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(flowLog, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-flow-log",
+			UniqueAttribute: "FlowLogId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(flowLog.Tags),
+		}
+
+		if flowLog.DeliverLogsStatus != nil {
+			switch *flowLog.DeliverLogsStatus {
+			case "SUCCESS":
+				item.Health = sdp.Health_HEALTH_OK.Enum()
+			case "FAILED":
+				item.Health = sdp.Health_HEALTH_ERROR.Enum()
+			}
+		}
+
+		if flowLog.ResourceId != nil {
+			if link := flowLogResourceLink(*flowLog.ResourceId, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if flowLog.LogDestination != nil {
+			if a, err := adapterhelpers.ParseARN(*flowLog.LogDestination); err == nil {
+				query := &sdp.Query{
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *flowLog.LogDestination,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				}
+
+				switch a.Service {
+				case "s3":
+					// S3 ARNs don't include the account, so we assume that
+					// the bucket is in the same account as the flow log. The
+					// ARN can also include a prefix after the bucket name
+					accountID, _, _ := adapterhelpers.ParseScope(scope)
+					bucket, _, _ := strings.Cut(a.Resource, "/")
+
+					query.Type = "s3-bucket"
+					query.Method = sdp.QueryMethod_GET
+					query.Query = bucket
+					query.Scope = adapterhelpers.FormatScope(accountID, "")
+				case "logs":
+					query.Type = "logs-log-group"
+				case "firehose":
+					query.Type = "firehose-delivery-stream"
+				}
+
+				if query.Type != "" {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: query,
+						BlastPropagation: &sdp.BlastPropagation{
+							// Changes to the destination can stop logs being
+							// delivered
+							In: true,
+							// Flow logs are written to the destination
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		for _, roleARN := range []*string{flowLog.DeliverLogsPermissionArn, flowLog.DeliverCrossAccountRole} {
+			if roleARN == nil {
+				continue
+			}
+
+			// The role is used to deliver the logs
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*roleARN, scope))
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2FlowLogAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeFlowLogsInput, *ec2.DescribeFlowLogsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-flow-log",
+		AdapterMetadata: flowLogAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeFlowLogsInput) (*ec2.DescribeFlowLogsOutput, error) {
+			return client.DescribeFlowLogs(ctx, input)
+		},
+		InputMapperGet:  flowLogInputMapperGet,
+		InputMapperList: flowLogInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeFlowLogsInput) adapterhelpers.Paginator[*ec2.DescribeFlowLogsOutput, *ec2.Options] {
+			return ec2.NewDescribeFlowLogsPaginator(client, params)
+		},
+		OutputMapper: flowLogOutputMapper,
+	}
+}
+
+var flowLogAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-flow-log",
+	DescriptiveName: "VPC Flow Log",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a flow log by ID",
+		ListDescription:   "List all flow logs",
+		SearchDescription: "Search flow logs by ARN",
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-network-interface", "ec2-transit-gateway", "ec2-transit-gateway-attachment", "s3-bucket", "logs-log-group", "firehose-delivery-stream", "iam-role"},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_flow_log.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFlowLogInputMapperGet(t *testing.T) {
+	input, err := flowLogInputMapperGet("foo", "fl-0123456789abcdef0")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.FlowLogIds) != 1 {
+		t.Fatalf("expected 1 flow log ID, got %v", len(input.FlowLogIds))
+	}
+
+	if input.FlowLogIds[0] != "fl-0123456789abcdef0" {
+		t.Errorf("expected flow log ID to be fl-0123456789abcdef0, got %v", input.FlowLogIds[0])
+	}
+}
+
+func TestFlowLogInputMapperList(t *testing.T) {
+	input, err := flowLogInputMapperList("foo")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.Filter) != 0 || len(input.FlowLogIds) != 0 {
+		t.Errorf("non-empty input: %v", input)
+	}
+}
+
+func TestFlowLogOutputMapper(t *testing.T) {
+	output := &ec2.DescribeFlowLogsOutput{
+		FlowLogs: []types.FlowLog{
+			{
+				CreationTime:             adapterhelpers.PtrTime(time.Now()),
+				DeliverLogsPermissionArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/flow-logs"),
+				DeliverLogsStatus:        adapterhelpers.PtrString("SUCCESS"),
+				FlowLogId:                adapterhelpers.PtrString("fl-0123456789abcdef0"),
+				FlowLogStatus:            adapterhelpers.PtrString("ACTIVE"),
+				LogDestination:           adapterhelpers.PtrString("arn:aws:logs:eu-west-2:123456789012:log-group:vpc-flow-logs"),
+				LogDestinationType:       types.LogDestinationTypeCloudWatchLogs,
+				LogGroupName:             adapterhelpers.PtrString("vpc-flow-logs"),
+				MaxAggregationInterval:   adapterhelpers.PtrInt32(600),
+				ResourceId:               adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				TrafficType:              types.TrafficTypeAll,
+			},
+			{
+				CreationTime:       adapterhelpers.PtrTime(time.Now()),
+				DeliverLogsStatus:  adapterhelpers.PtrString("FAILED"),
+				FlowLogId:          adapterhelpers.PtrString("fl-0fedcba9876543210"),
+				FlowLogStatus:      adapterhelpers.PtrString("ACTIVE"),
+				LogDestination:     adapterhelpers.PtrString("arn:aws:s3:::flow-log-bucket/prefix"),
+				LogDestinationType: types.LogDestinationTypeS3,
+				ResourceId:         adapterhelpers.PtrString("eni-0123456789abcdef0"),
+				TrafficType:        types.TrafficTypeReject,
+			},
+		},
+	}
+
+	items, err := flowLogOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	if items[0].GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", items[0].GetHealth())
+	}
+
+	if items[1].GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", items[1].GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d7892e00e573e701",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:logs:eu-west-2:123456789012:log-group:vpc-flow-logs",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/flow-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, items[0])
+
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eni-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "flow-log-bucket",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, items[1])
+}
+
+func TestFlowLogResourceLink(t *testing.T) {
+	tests := map[string]string{
+		"vpc-0d7892e00e573e701":          "ec2-vpc",
+		"subnet-06c0dea0437180c61":       "ec2-subnet",
+		"eni-0123456789abcdef0":          "ec2-network-interface",
+		"tgw-0123456789abcdef0":          "ec2-transit-gateway",
+		"tgw-attach-0123456789abcdef0":   "ec2-transit-gateway-attachment",
+		"something-0123456789abcdef0000": "",
+	}
+
+	for resourceID, expected := range tests {
+		link := flowLogResourceLink(resourceID, "foo")
+
+		if expected == "" {
+			if link != nil {
+				t.Errorf("expected no link for %v, got %v", resourceID, link.GetQuery().GetType())
+			}
+
+			continue
+		}
+
+		if link == nil {
+			t.Errorf("expected a link for %v", resourceID)
+			continue
+		}
+
+		if link.GetQuery().GetType() != expected {
+			t.Errorf("expected %v for %v, got %v", expected, resourceID, link.GetQuery().GetType())
+		}
+	}
+}
+
+func TestNewEC2FlowLogAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2FlowLogAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func managedPrefixListInputMapperGet(scope string, query string) (*ec2.DescribeManagedPrefixListsInput, error) {
+	return &ec2.DescribeManagedPrefixListsInput{
+		PrefixListIds: []string{
+			query,
+		},
+	}, nil
+}
+
+func managedPrefixListInputMapperList(scope string) (*ec2.DescribeManagedPrefixListsInput, error) {
+	return &ec2.DescribeManagedPrefixListsInput{}, nil
+}
+
+func managedPrefixListOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeManagedPrefixListsInput, output *ec2.DescribeManagedPrefixListsOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, prefixList := range output.PrefixLists {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(prefixList, "tags")
+
+		if err != nil {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_OTHER,
+				ErrorString: err.Error(),
+				Scope:       scope,
+			}
+		}
+
+		item := sdp.Item{
+			Type:            "ec2-managed-prefix-list",
+			UniqueAttribute: "PrefixListId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Tags:            ec2TagsToMap(prefixList.Tags),
+		}
+
+		switch prefixList.State {
+		case types.PrefixListStateCreateComplete, types.PrefixListStateModifyComplete, types.PrefixListStateRestoreComplete, types.PrefixListStateDeleteComplete:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.PrefixListStateCreateInProgress, types.PrefixListStateModifyInProgress, types.PrefixListStateRestoreInProgress, types.PrefixListStateDeleteInProgress:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.PrefixListStateCreateFailed, types.PrefixListStateModifyFailed, types.PrefixListStateRestoreFailed, types.PrefixListStateDeleteFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewEC2ManagedPrefixListAdapter(client *ec2.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeManagedPrefixListsInput, *ec2.DescribeManagedPrefixListsOutput, *ec2.Client, *ec2.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ec2.DescribeManagedPrefixListsInput, *ec2.DescribeManagedPrefixListsOutput, *ec2.Client, *ec2.Options]{
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		ItemType:        "ec2-managed-prefix-list",
+		AdapterMetadata: managedPrefixListAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeManagedPrefixListsInput) (*ec2.DescribeManagedPrefixListsOutput, error) {
+			return client.DescribeManagedPrefixLists(ctx, input)
+		},
+		InputMapperGet:  managedPrefixListInputMapperGet,
+		InputMapperList: managedPrefixListInputMapperList,
+		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeManagedPrefixListsInput) adapterhelpers.Paginator[*ec2.DescribeManagedPrefixListsOutput, *ec2.Options] {
+			return ec2.NewDescribeManagedPrefixListsPaginator(client, params)
+		},
+		OutputMapper: managedPrefixListOutputMapper,
+	}
+}
+
+var managedPrefixListAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ec2-managed-prefix-list",
+	DescriptiveName: "Managed Prefix List",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a managed prefix list by ID",
+		ListDescription:   "List all managed prefix lists",
+		SearchDescription: "Search managed prefix lists by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ec2_managed_prefix_list.id"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
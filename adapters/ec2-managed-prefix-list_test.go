@@ -0,0 +1,104 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestManagedPrefixListInputMapperGet(t *testing.T) {
+	input, err := managedPrefixListInputMapperGet("foo", "pl-7ca54015")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.PrefixListIds) != 1 {
+		t.Fatalf("expected 1 prefix list ID, got %v", len(input.PrefixListIds))
+	}
+
+	if input.PrefixListIds[0] != "pl-7ca54015" {
+		t.Errorf("expected prefix list ID to be pl-7ca54015, got %v", input.PrefixListIds[0])
+	}
+}
+
+func TestManagedPrefixListInputMapperList(t *testing.T) {
+	input, err := managedPrefixListInputMapperList("foo")
+
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(input.Filters) != 0 || len(input.PrefixListIds) != 0 {
+		t.Errorf("non-empty input: %v", input)
+	}
+}
+
+func TestManagedPrefixListOutputMapper(t *testing.T) {
+	output := &ec2.DescribeManagedPrefixListsOutput{
+		PrefixLists: []types.ManagedPrefixList{
+			{
+				AddressFamily:  adapterhelpers.PtrString("IPv4"),
+				MaxEntries:     adapterhelpers.PtrInt32(10),
+				OwnerId:        adapterhelpers.PtrString("123456789012"),
+				PrefixListArn:  adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:prefix-list/pl-0123456789abcdef0"),
+				PrefixListId:   adapterhelpers.PtrString("pl-0123456789abcdef0"),
+				PrefixListName: adapterhelpers.PtrString("office-ranges"),
+				State:          types.PrefixListStateModifyComplete,
+				Version:        adapterhelpers.PtrInt64(3),
+			},
+			{
+				AddressFamily:  adapterhelpers.PtrString("IPv4"),
+				OwnerId:        adapterhelpers.PtrString("AWS"),
+				PrefixListArn:  adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:aws:prefix-list/pl-7ca54015"),
+				PrefixListId:   adapterhelpers.PtrString("pl-7ca54015"),
+				PrefixListName: adapterhelpers.PtrString("com.amazonaws.eu-west-2.s3"),
+				State:          types.PrefixListStateModifyFailed,
+				StateMessage:   adapterhelpers.PtrString("failed"),
+			},
+		},
+	}
+
+	items, err := managedPrefixListOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	if items[0].GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", items[0].GetHealth())
+	}
+
+	if items[1].GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", items[1].GetHealth())
+	}
+}
+
+func TestNewEC2ManagedPrefixListAdapter(t *testing.T) {
+	client, account, region := ec2GetAutoConfig(t)
+
+	adapter := NewEC2ManagedPrefixListAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
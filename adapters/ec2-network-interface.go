@@ -2,8 +2,10 @@ package adapters
 
 import (
 	"context"
+	"net"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 
 	"github.com/overmindtech/aws-source/adapterhelpers"
 	"github.com/overmindtech/sdp-go"
@@ -21,6 +23,44 @@ func networkInterfaceInputMapperList(scope string) (*ec2.DescribeNetworkInterfac
 	return &ec2.DescribeNetworkInterfacesInput{}, nil
 }
 
+// networkInterfaceInputMapperSearch Searches for network interfaces by ARN or
+// by one of their private IPv4 or IPv6 addresses. Services that create
+// interfaces on your behalf often only return the IPs and not the interface IDs
+func networkInterfaceInputMapperSearch(_ context.Context, _ *ec2.Client, scope, query string) (*ec2.DescribeNetworkInterfacesInput, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		return &ec2.DescribeNetworkInterfacesInput{
+			NetworkInterfaceIds: []string{
+				a.ResourceID(),
+			},
+		}, nil
+	}
+
+	ip := net.ParseIP(query)
+
+	if ip == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an ARN or an IP address",
+			Scope:       scope,
+		}
+	}
+
+	filter := "addresses.private-ip-address"
+
+	if ip.To4() == nil {
+		filter = "ipv6-addresses.ipv6-address"
+	}
+
+	return &ec2.DescribeNetworkInterfacesInput{
+		Filters: []types.Filter{
+			{
+				Name:   &filter,
+				Values: []string{query},
+			},
+		},
+	}, nil
+}
+
 func networkInterfaceOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.DescribeNetworkInterfacesInput, output *ec2.DescribeNetworkInterfacesOutput) ([]*sdp.Item, error) {
 	items := make([]*sdp.Item, 0)
 
@@ -252,8 +292,9 @@ func NewEC2NetworkInterfaceAdapter(client *ec2.Client, accountID string, region
 		DescribeFunc: func(ctx context.Context, client *ec2.Client, input *ec2.DescribeNetworkInterfacesInput) (*ec2.DescribeNetworkInterfacesOutput, error) {
 			return client.DescribeNetworkInterfaces(ctx, input)
 		},
-		InputMapperGet:  networkInterfaceInputMapperGet,
-		InputMapperList: networkInterfaceInputMapperList,
+		InputMapperGet:    networkInterfaceInputMapperGet,
+		InputMapperList:   networkInterfaceInputMapperList,
+		InputMapperSearch: networkInterfaceInputMapperSearch,
 		PaginatorBuilder: func(client *ec2.Client, params *ec2.DescribeNetworkInterfacesInput) adapterhelpers.Paginator[*ec2.DescribeNetworkInterfacesOutput, *ec2.Options] {
 			return ec2.NewDescribeNetworkInterfacesPaginator(client, params)
 		},
@@ -270,7 +311,7 @@ var networkInterfaceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		Search:            true,
 		GetDescription:    "Get a network interface by ID",
 		ListDescription:   "List all network interfaces",
-		SearchDescription: "Search network interfaces by ARN",
+		SearchDescription: "Search network interfaces by ARN or private IP address",
 	},
 	PotentialLinks: []string{"ec2-instance", "ec2-security-group", "ip", "dns", "ec2-subnet", "ec2-vpc"},
 	TerraformMappings: []*sdp.TerraformMapping{
@@ -40,6 +40,55 @@ func TestNetworkInterfaceInputMapperList(t *testing.T) {
 	}
 }
 
+func TestNetworkInterfaceInputMapperSearch(t *testing.T) {
+	t.Run("ARN", func(t *testing.T) {
+		input, err := networkInterfaceInputMapperSearch(context.Background(), nil, "foo", "arn:aws:ec2:eu-west-2:123456789012:network-interface/eni-0123456789abcdef0")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.NetworkInterfaceIds) != 1 || input.NetworkInterfaceIds[0] != "eni-0123456789abcdef0" {
+			t.Errorf("expected network interface ID eni-0123456789abcdef0, got %v", input.NetworkInterfaceIds)
+		}
+	})
+
+	t.Run("IP addresses", func(t *testing.T) {
+		tests := map[string]string{
+			"10.0.1.10":   "addresses.private-ip-address",
+			"2001:db8::1": "ipv6-addresses.ipv6-address",
+		}
+
+		for query, filter := range tests {
+			input, err := networkInterfaceInputMapperSearch(context.Background(), nil, "foo", query)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(input.Filters) != 1 {
+				t.Fatalf("expected 1 filter, got %v", len(input.Filters))
+			}
+
+			if *input.Filters[0].Name != filter {
+				t.Errorf("expected filter %v, got %v", filter, *input.Filters[0].Name)
+			}
+
+			if len(input.Filters[0].Values) != 1 || input.Filters[0].Values[0] != query {
+				t.Errorf("expected filter value %v, got %v", query, input.Filters[0].Values)
+			}
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := networkInterfaceInputMapperSearch(context.Background(), nil, "foo", "eni-0123456789abcdef0")
+
+		if err == nil {
+			t.Error("expected error for a query that is neither an ARN nor an IP")
+		}
+	})
+}
+
 func TestNetworkInterfaceOutputMapper(t *testing.T) {
 	output := &ec2.DescribeNetworkInterfacesOutput{
 		NetworkInterfaces: []types.NetworkInterface{
@@ -83,6 +83,23 @@ func routeTableOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *e
 		}
 
 		for _, route := range rt.Routes {
+			if route.DestinationPrefixListId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-managed-prefix-list",
+						Method: sdp.QueryMethod_GET,
+						Query:  *route.DestinationPrefixListId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changing the entries in the prefix list changes
+						// which traffic the route applies to
+						In: true,
+						// The route table can't affect the prefix list
+						Out: false,
+					},
+				})
+			}
 			if route.GatewayId != nil {
 				if strings.HasPrefix(*route.GatewayId, "igw") {
 					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -278,7 +295,7 @@ var routeTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all route tables",
 		SearchDescription: "Search route tables by ARN",
 	},
-	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection"},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-internet-gateway", "ec2-vpc-endpoint", "ec2-carrier-gateway", "ec2-egress-only-internet-gateway", "ec2-instance", "ec2-local-gateway", "ec2-nat-gateway", "ec2-network-interface", "ec2-transit-gateway", "ec2-vpc-peering-connection", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_route_table.id"},
 		{TerraformQueryMap: "aws_route_table_association.route_table_id"},
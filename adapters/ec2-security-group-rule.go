@@ -79,6 +79,24 @@ func securityGroupRuleOutputMapper(_ context.Context, _ *ec2.Client, scope strin
 			}
 		}
 
+		if securityGroupRule.PrefixListId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-managed-prefix-list",
+					Method: sdp.QueryMethod_GET,
+					Query:  *securityGroupRule.PrefixListId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the entries in the prefix list changes what
+					// traffic the rule allows
+					In: true,
+					// The rule can't affect the prefix list
+					Out: false,
+				},
+			})
+		}
+
 		items = append(items, &item)
 	}
 
@@ -115,7 +133,7 @@ var securityGroupRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		ListDescription:   "List all security group rules",
 		SearchDescription: "Search security group rules by ARN",
 	},
-	PotentialLinks: []string{"ec2-security-group"},
+	PotentialLinks: []string{"ec2-security-group", "ec2-managed-prefix-list"},
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_security_group_rule.security_group_rule_id"},
 		{TerraformQueryMap: "aws_vpc_security_group_ingress_rule.security_group_rule_id"},
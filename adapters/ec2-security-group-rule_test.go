@@ -73,6 +73,17 @@ func TestSecurityGroupRuleOutputMapper(t *testing.T) {
 				Description: adapterhelpers.PtrString("Created by the LIW for EFS at 2022-12-16T19:14:27.349Z"),
 				Tags:        []types.Tag{},
 			},
+			{
+				SecurityGroupRuleId: adapterhelpers.PtrString("sgr-0a1b2c3d4e5f67890"),
+				GroupId:             adapterhelpers.PtrString("sg-09371b4a54fe7ab38"),
+				GroupOwnerId:        adapterhelpers.PtrString("052392120703"),
+				IsEgress:            adapterhelpers.PtrBool(true),
+				IpProtocol:          adapterhelpers.PtrString("tcp"),
+				FromPort:            adapterhelpers.PtrInt32(443),
+				ToPort:              adapterhelpers.PtrInt32(443),
+				PrefixListId:        adapterhelpers.PtrString("pl-7ca54015"),
+				Tags:                []types.Tag{},
+			},
 		},
 	}
 
@@ -82,8 +93,8 @@ func TestSecurityGroupRuleOutputMapper(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if len(items) != 2 {
-		t.Fatalf("expected 2 items, got %v", len(items))
+	if len(items) != 3 {
+		t.Fatalf("expected 3 items, got %v", len(items))
 	}
 
 	item := items[0]
@@ -107,6 +118,17 @@ func TestSecurityGroupRuleOutputMapper(t *testing.T) {
 
 	tests.Execute(t, item)
 
+	tests = adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-managed-prefix-list",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pl-7ca54015",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, items[2])
+
 }
 
 func TestNewEC2SecurityGroupRuleAdapter(t *testing.T) {
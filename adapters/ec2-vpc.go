@@ -45,6 +45,26 @@ func vpcOutputMapper(_ context.Context, _ *ec2.Client, scope string, _ *ec2.Desc
 			Tags:            ec2TagsToMap(vpc.Tags),
 		}
 
+		// VPCs that have no DHCP options set report "default", which isn't
+		// a real options set that can be looked up
+		if vpc.DhcpOptionsId != nil && *vpc.DhcpOptionsId != "default" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-dhcp-options",
+					Method: sdp.QueryMethod_GET,
+					Query:  *vpc.DhcpOptionsId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the DHCP options changes the DNS and NTP
+					// servers for everything in the VPC
+					In: true,
+					// The VPC can't affect the options set
+					Out: false,
+				},
+			})
+		}
+
 		items = append(items, &item)
 	}
 
@@ -82,5 +102,6 @@ var vpcAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_vpc.id"},
 	},
-	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+	PotentialLinks: []string{"ec2-dhcp-options"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
 })
@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 func TestVpcInputMapperGet(t *testing.T) {
@@ -95,6 +96,47 @@ func TestVpcOutputMapper(t *testing.T) {
 	if len(items) != 1 {
 		t.Fatalf("expected 1 item, got %v", len(items))
 	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-dhcp-options",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dopt-0959b838bf4a4c7b8",
+			ExpectedScope:  "foo",
+		},
+	}
+
+	tests.Execute(t, items[0])
+}
+
+func TestVpcOutputMapperDefaultDhcpOptions(t *testing.T) {
+	output := &ec2.DescribeVpcsOutput{
+		Vpcs: []types.Vpc{
+			{
+				CidrBlock:     adapterhelpers.PtrString("172.31.0.0/16"),
+				DhcpOptionsId: adapterhelpers.PtrString("default"),
+				State:         types.VpcStateAvailable,
+				VpcId:         adapterhelpers.PtrString("vpc-0d7892e00e573e701"),
+				OwnerId:       adapterhelpers.PtrString("052392120703"),
+			},
+		},
+	}
+
+	items, err := vpcOutputMapper(context.Background(), nil, "foo", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	for _, link := range items[0].GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "ec2-dhcp-options" {
+			t.Errorf("expected no ec2-dhcp-options link for the default options, got %v", link.GetQuery().GetQuery())
+		}
+	}
 }
 
 func TestNewEC2VpcAdapter(t *testing.T) {
@@ -0,0 +1,147 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ecrImageName Splits an image query in the format
+// {repositoryName}/{imageDigest} into its parts. Repository names can contain
+// slashes but digests can't, so the digest is everything after the last one
+func ecrImageName(query string) (string, string) {
+	i := strings.LastIndex(query, "/")
+
+	if i == -1 {
+		return "", ""
+	}
+
+	return query[:i], query[i+1:]
+}
+
+// imageGetFunc The input for this function is a DescribeImagesInput with the
+// RepositoryName set and a single image ID containing the digest
+func imageGetFunc(ctx context.Context, client ECRClient, scope string, input *ecr.DescribeImagesInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, errors.New("nil input")
+	}
+
+	out, err := client.DescribeImages(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ImageDetails) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "image not found",
+		}
+	}
+
+	image := out.ImageDetails[0]
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(image)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if image.RepositoryName != nil && image.ImageDigest != nil {
+		// Add a unique attribute since the same image can be pushed to more
+		// than one repository. This is also how Inspector and Security Hub
+		// findings refer to the image
+		attributes.Set("UniqueName", *image.RepositoryName+"/"+*image.ImageDigest)
+	}
+
+	item := sdp.Item{
+		Type:            "ecr-image",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewECRImageAdapter(client ECRClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*ecr.DescribeImagesInput, *ecr.DescribeImagesOutput, *ecr.DescribeImagesInput, *ecr.DescribeImagesOutput, ECRClient, *ecr.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*ecr.DescribeImagesInput, *ecr.DescribeImagesOutput, *ecr.DescribeImagesInput, *ecr.DescribeImagesOutput, ECRClient, *ecr.Options]{
+		ItemType:        "ecr-image",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ecrImageAdapterMetadata,
+		// Images can only be listed per repository
+		DisableList: true,
+		GetFunc:     imageGetFunc,
+		GetInputMapper: func(scope, query string) *ecr.DescribeImagesInput {
+			// The query is in the format {repositoryName}/{imageDigest}
+			repositoryName, digest := ecrImageName(query)
+
+			if repositoryName == "" || digest == "" {
+				return nil
+			}
+
+			return &ecr.DescribeImagesInput{
+				RepositoryName: &repositoryName,
+				ImageIds: []types.ImageIdentifier{
+					{
+						ImageDigest: &digest,
+					},
+				},
+			}
+		},
+		SearchInputMapper: func(scope, query string) (*ecr.DescribeImagesInput, error) {
+			repositoryName := query
+
+			if a, err := adapterhelpers.ParseARN(query); err == nil {
+				repositoryName = strings.TrimPrefix(a.Resource, "repository/")
+			}
+
+			return &ecr.DescribeImagesInput{
+				RepositoryName: &repositoryName,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client ECRClient, input *ecr.DescribeImagesInput) adapterhelpers.Paginator[*ecr.DescribeImagesOutput, *ecr.Options] {
+			return ecr.NewDescribeImagesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *ecr.DescribeImagesOutput, _ *ecr.DescribeImagesInput) ([]*ecr.DescribeImagesInput, error) {
+			inputs := make([]*ecr.DescribeImagesInput, 0, len(output.ImageDetails))
+
+			for _, image := range output.ImageDetails {
+				if image.RepositoryName == nil || image.ImageDigest == nil {
+					continue
+				}
+
+				inputs = append(inputs, &ecr.DescribeImagesInput{
+					RepositoryName: image.RepositoryName,
+					ImageIds: []types.ImageIdentifier{
+						{
+							ImageDigest: image.ImageDigest,
+						},
+					},
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var ecrImageAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ecr-image",
+	DescriptiveName: "ECR Image",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an image by {repositoryName}/{imageDigest}",
+		SearchDescription: "Search for images by the name or ARN of their repository",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
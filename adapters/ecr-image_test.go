@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestECRClient) DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	images := []types.ImageDetail{
+		{
+			RegistryId:       adapterhelpers.PtrString("123456789012"),
+			RepositoryName:   params.RepositoryName,
+			ImageDigest:      adapterhelpers.PtrString("sha256:9f2c5d2e0a5b4f3e8c1d7a6b5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f"),
+			ImageTags:        []string{"latest", "v1.4.2"},
+			ImagePushedAt:    adapterhelpers.PtrTime(time.Now()),
+			ImageSizeInBytes: adapterhelpers.PtrInt64(52428800),
+		},
+		{
+			RegistryId:     adapterhelpers.PtrString("123456789012"),
+			RepositoryName: params.RepositoryName,
+			ImageDigest:    adapterhelpers.PtrString("sha256:0b1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c"),
+			ImagePushedAt:  adapterhelpers.PtrTime(time.Now()),
+		},
+	}
+
+	if len(params.ImageIds) == 0 {
+		return &ecr.DescribeImagesOutput{
+			ImageDetails: images,
+		}, nil
+	}
+
+	out := &ecr.DescribeImagesOutput{}
+
+	for _, image := range images {
+		for _, id := range params.ImageIds {
+			if id.ImageDigest != nil && *id.ImageDigest == *image.ImageDigest {
+				out.ImageDetails = append(out.ImageDetails, image)
+			}
+		}
+	}
+
+	return out, nil
+}
+
+func TestEcrImageName(t *testing.T) {
+	tests := map[string][2]string{
+		"web/sha256:1a2b":           {"web", "sha256:1a2b"},
+		"team/platform/sha256:1a2b": {"team/platform", "sha256:1a2b"},
+		"sha256:1a2b":               {"", ""},
+	}
+
+	for query, expected := range tests {
+		repositoryName, digest := ecrImageName(query)
+
+		if repositoryName != expected[0] || digest != expected[1] {
+			t.Errorf("expected %v to be split into %v, got %v and %v", query, expected, repositoryName, digest)
+		}
+	}
+}
+
+func TestImageGetFunc(t *testing.T) {
+	item, err := imageGetFunc(context.Background(), &TestECRClient{}, "123456789012.eu-west-2", &ecr.DescribeImagesInput{
+		RepositoryName: adapterhelpers.PtrString("team/platform"),
+		ImageIds: []types.ImageIdentifier{
+			{
+				ImageDigest: adapterhelpers.PtrString("sha256:9f2c5d2e0a5b4f3e8c1d7a6b5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f"),
+			},
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	expected := "team/platform/sha256:9f2c5d2e0a5b4f3e8c1d7a6b5e4f3a2b1c0d9e8f7a6b5c4d3e2f1a0b9c8d7e6f"
+
+	if item.UniqueAttributeValue() != expected {
+		t.Errorf("expected unique attribute value to be %v, got %v", expected, item.UniqueAttributeValue())
+	}
+
+	// The unique attribute should match the name that findings are counted
+	// against, so that the findings adapter can find them
+	name := findingsResourceName("arn:aws:ecr:eu-west-2:123456789012:repository/" + expected)
+
+	if name != expected {
+		t.Errorf("expected findings to be counted against %v, got %v", expected, name)
+	}
+}
+
+func TestImageGetFuncNotFound(t *testing.T) {
+	_, err := imageGetFunc(context.Background(), &TestECRClient{}, "123456789012.eu-west-2", &ecr.DescribeImagesInput{
+		RepositoryName: adapterhelpers.PtrString("web"),
+		ImageIds: []types.ImageIdentifier{
+			{
+				ImageDigest: adapterhelpers.PtrString("sha256:0000"),
+			},
+		},
+	})
+
+	if err == nil {
+		t.Error("expected an error for a missing image")
+	}
+}
+
+func TestECRImageAdapterSearch(t *testing.T) {
+	adapter := NewECRImageAdapter(&TestECRClient{}, "123456789012", "eu-west-2")
+
+	for _, query := range []string{"team/platform", "arn:aws:ecr:eu-west-2:123456789012:repository/team/platform"} {
+		t.Run(query, func(t *testing.T) {
+			items := make([]*sdp.Item, 0)
+			errs := make([]error, 0)
+			stream := discovery.NewQueryResultStream(
+				func(item *sdp.Item) {
+					items = append(items, item)
+				},
+				func(err error) {
+					errs = append(errs, err)
+				},
+			)
+
+			adapter.SearchStream(context.Background(), "123456789012.eu-west-2", query, true, stream)
+			stream.Close()
+
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+
+			if len(items) != 2 {
+				t.Fatalf("expected 2 items, got %v", len(items))
+			}
+
+			for _, item := range items {
+				if err := item.Validate(); err != nil {
+					t.Error(err)
+				}
+			}
+		})
+	}
+}
+
+func TestNewECRImageAdapter(t *testing.T) {
+	client, account, region := ecrGetAutoConfig(t)
+
+	adapter := NewECRImageAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,9 @@
+package adapters
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
+
+type ECRClient interface {
+	ecr.DescribeImagesAPIClient
+}
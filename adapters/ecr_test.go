@@ -0,0 +1,17 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type TestECRClient struct{}
+
+func ecrGetAutoConfig(t *testing.T) (*ecr.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ecr.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -130,8 +130,15 @@ func taskDefinitionGetFunc(ctx context.Context, client ECSClient, scope string,
 // getSecretLinkedItem Converts a `types.Secret` to the linked item that the
 // secret is related to, if relevant
 func getSecretLinkedItem(secret types.Secret) *sdp.LinkedItemQuery {
-	if secret.ValueFrom != nil {
-		if a, err := adapterhelpers.ParseARN(*secret.ValueFrom); err == nil {
+	return secretValueFromLinkedItem(secret.ValueFrom)
+}
+
+// secretValueFromLinkedItem Converts the `ValueFrom` of a container secret to
+// the linked item that the secret is stored in. This is shared by ECS, Batch
+// and Lambda event source mappings, which all reference secrets by ARN
+func secretValueFromLinkedItem(valueFrom *string) *sdp.LinkedItemQuery {
+	if valueFrom != nil {
+		if a, err := adapterhelpers.ParseARN(*valueFrom); err == nil {
 			// The secret can refer to either something from secrets
 			// manager or SSN, so handle this
 			secretScope := adapterhelpers.FormatScope(a.AccountID, a.Region)
@@ -142,13 +149,13 @@ func getSecretLinkedItem(secret types.Secret) *sdp.LinkedItemQuery {
 					Query: &sdp.Query{
 						Type:   "secretsmanager-secret",
 						Method: sdp.QueryMethod_SEARCH,
-						Query:  *secret.ValueFrom,
+						Query:  *valueFrom,
 						Scope:  secretScope,
 					},
 					BlastPropagation: &sdp.BlastPropagation{
-						// The secret can affect the task definition
+						// The secret can affect the containers that use it
 						In: true,
-						// The task definition can't affect the secret
+						// The containers can't affect the secret
 						Out: false,
 					},
 				}
@@ -157,13 +164,13 @@ func getSecretLinkedItem(secret types.Secret) *sdp.LinkedItemQuery {
 					Query: &sdp.Query{
 						Type:   "ssm-parameter",
 						Method: sdp.QueryMethod_SEARCH,
-						Query:  *secret.ValueFrom,
+						Query:  *valueFrom,
 						Scope:  secretScope,
 					},
 					BlastPropagation: &sdp.BlastPropagation{
-						// The secret can affect the task definition
+						// The secret can affect the containers that use it
 						In: true,
-						// The task definition can't affect the secret
+						// The containers can't affect the secret
 						Out: false,
 					},
 				}
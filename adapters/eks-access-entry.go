@@ -0,0 +1,183 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func accessEntryGetFunc(ctx context.Context, client EKSClient, scope string, input *eks.DescribeAccessEntryInput) (*sdp.Item, error) {
+	out, err := client.DescribeAccessEntry(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.AccessEntry == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "access entry was nil",
+		}
+	}
+
+	entry := out.AccessEntry
+
+	// The access policies are what actually grant permissions within the
+	// cluster so we include them with the entry. If these can't be listed we
+	// still want to return the entry itself
+	policies := make([]types.AssociatedAccessPolicy, 0)
+	paginator := eks.NewListAssociatedAccessPoliciesPaginator(client, &eks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  entry.ClusterName,
+		PrincipalArn: entry.PrincipalArn,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			break
+		}
+
+		policies = append(policies, page.AssociatedAccessPolicies...)
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*types.AccessEntry
+		AccessPolicies []types.AssociatedAccessPolicy
+	}{
+		AccessEntry:    entry,
+		AccessPolicies: policies,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {clusterName}/{principalArn}
+	attributes.Set("UniqueName", (*entry.ClusterName + "/" + *entry.PrincipalArn))
+
+	item := sdp.Item{
+		Type:            "eks-access-entry",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            entry.Tags,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "eks-cluster",
+					Method: sdp.QueryMethod_GET,
+					Query:  *entry.ClusterName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the cluster deletes the access entry
+					In: true,
+					// The access entry controls who can access the cluster
+					Out: true,
+				},
+			},
+		},
+	}
+
+	// The principal can be a role or a user, and unlike most role links the
+	// access entry propagates out to it, so this doesn't use iamRoleLink
+	if a, err := adapterhelpers.ParseARN(*entry.PrincipalArn); err == nil {
+		var typ string
+		switch a.Type() {
+		case "role":
+			typ = "iam-role"
+		case "user":
+			typ = "iam-user"
+		}
+
+		if typ != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   typ,
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *entry.PrincipalArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing or deleting the principal changes who has
+					// access to the cluster
+					In: true,
+					// The access entry grants the principal permissions
+					// within the cluster
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEKSAccessEntryAdapter(client EKSClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*eks.ListAccessEntriesInput, *eks.ListAccessEntriesOutput, *eks.DescribeAccessEntryInput, *eks.DescribeAccessEntryOutput, EKSClient, *eks.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*eks.ListAccessEntriesInput, *eks.ListAccessEntriesOutput, *eks.DescribeAccessEntryInput, *eks.DescribeAccessEntryOutput, EKSClient, *eks.Options]{
+		ItemType:        "eks-access-entry",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		DisableList:     true,
+		AdapterMetadata: accessEntryAdapterMetadata,
+		SearchInputMapper: func(scope, query string) (*eks.ListAccessEntriesInput, error) {
+			return &eks.ListAccessEntriesInput{
+				ClusterName: &query,
+			}, nil
+		},
+		GetInputMapper: func(scope, query string) *eks.DescribeAccessEntryInput {
+			// The uniqueAttributeValue for this is a custom field:
+			// {clusterName}/{principalArn}. The principal ARN can contain
+			// slashes but the cluster name can't, so we only split on the
+			// first one
+			clusterName, principalArn, found := strings.Cut(query, "/")
+
+			if !found {
+				clusterName = ""
+				principalArn = ""
+			}
+
+			return &eks.DescribeAccessEntryInput{
+				ClusterName:  &clusterName,
+				PrincipalArn: &principalArn,
+			}
+		},
+		ListFuncPaginatorBuilder: func(client EKSClient, input *eks.ListAccessEntriesInput) adapterhelpers.Paginator[*eks.ListAccessEntriesOutput, *eks.Options] {
+			return eks.NewListAccessEntriesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *eks.ListAccessEntriesOutput, input *eks.ListAccessEntriesInput) ([]*eks.DescribeAccessEntryInput, error) {
+			inputs := make([]*eks.DescribeAccessEntryInput, 0, len(output.AccessEntries))
+
+			for i := range output.AccessEntries {
+				inputs = append(inputs, &eks.DescribeAccessEntryInput{
+					ClusterName:  input.ClusterName,
+					PrincipalArn: &output.AccessEntries[i],
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: accessEntryGetFunc,
+	}
+}
+
+var accessEntryAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "eks-access-entry",
+	DescriptiveName: "EKS Access Entry",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an access entry by unique name ({clusterName}/{principalArn})",
+		SearchDescription: "Search for access entries by cluster name",
+	},
+	PotentialLinks: []string{"eks-cluster", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
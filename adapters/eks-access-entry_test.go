@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var AccessEntryTestClient = EKSTestClient{
+	DescribeAccessEntryOutput: &eks.DescribeAccessEntryOutput{
+		AccessEntry: &types.AccessEntry{
+			AccessEntryArn: adapterhelpers.PtrString("arn:aws:eks:eu-west-2:801795385023:access-entry/dylan/role/801795385023/platform-admins/7cc9f8a4-bd48-0a5b-3fa6-0f2c1ad3b2c4"),
+			ClusterName:    adapterhelpers.PtrString("dylan"),
+			CreatedAt:      adapterhelpers.PtrTime(time.Now()),
+			KubernetesGroups: []string{
+				"platform",
+			},
+			ModifiedAt:   adapterhelpers.PtrTime(time.Now()),
+			PrincipalArn: adapterhelpers.PtrString("arn:aws:iam::801795385023:role/teams/platform-admins"),
+			Tags:         map[string]string{},
+			Type:         adapterhelpers.PtrString("STANDARD"),
+			Username:     adapterhelpers.PtrString("arn:aws:sts::801795385023:assumed-role/platform-admins/{{SessionName}}"),
+		},
+	},
+	ListAssociatedAccessPoliciesOutput: &eks.ListAssociatedAccessPoliciesOutput{
+		AssociatedAccessPolicies: []types.AssociatedAccessPolicy{
+			{
+				AccessScope: &types.AccessScope{
+					Type: types.AccessScopeTypeCluster,
+				},
+				PolicyArn: adapterhelpers.PtrString("arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy"),
+			},
+		},
+		ClusterName: adapterhelpers.PtrString("dylan"),
+	},
+}
+
+func TestAccessEntryGetFunc(t *testing.T) {
+	item, err := accessEntryGetFunc(context.Background(), AccessEntryTestClient, "foo", &eks.DescribeAccessEntryInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "dylan/arn:aws:iam::801795385023:role/teams/platform-admins" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	if _, err := item.GetAttributes().Get("AccessPolicies"); err != nil {
+		t.Errorf("expected access policies to be included: %v", err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "eks-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::801795385023:role/teams/platform-admins",
+			ExpectedScope:  "801795385023",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAccessEntryGetInputMapper(t *testing.T) {
+	adapter := NewEKSAccessEntryAdapter(AccessEntryTestClient, "801795385023", "eu-west-2")
+
+	input := adapter.GetInputMapper("801795385023.eu-west-2", "dylan/arn:aws:iam::801795385023:role/teams/platform-admins")
+
+	if *input.ClusterName != "dylan" {
+		t.Errorf("expected cluster name to be dylan, got %v", *input.ClusterName)
+	}
+
+	if *input.PrincipalArn != "arn:aws:iam::801795385023:role/teams/platform-admins" {
+		t.Errorf("unexpected principal ARN %v", *input.PrincipalArn)
+	}
+}
+
+func TestNewEKSAccessEntryAdapter(t *testing.T) {
+	client, account, region := eksGetAutoConfig(t)
+
+	adapter := NewEKSAccessEntryAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:           adapter,
+		Timeout:           10 * time.Second,
+		SkipNotFoundCheck: true,
+	}
+
+	test.Run(t)
+}
@@ -78,6 +78,45 @@ func clusterGetFunc(ctx context.Context, client EKSClient, scope string, input *
 					Out: true,
 				},
 			},
+			{
+				Query: &sdp.Query{
+					Type:   "eks-access-entry",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// These are tightly linked
+					In:  true,
+					Out: true,
+				},
+			},
+			{
+				Query: &sdp.Query{
+					Type:   "eks-pod-identity-association",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// These are tightly linked
+					In:  true,
+					Out: true,
+				},
+			},
+			{
+				Query: &sdp.Query{
+					Type:   "eks-identity-provider-config",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *cluster.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// These are tightly linked
+					In:  true,
+					Out: true,
+				},
+			},
 		},
 	}
 
@@ -200,6 +200,24 @@ func TestClusterGetFunc(t *testing.T) {
 			ExpectedQuery:  "dylan",
 			ExpectedScope:  item.GetScope(),
 		},
+		{
+			ExpectedType:   "eks-access-entry",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  item.GetScope(),
+		},
+		{
+			ExpectedType:   "eks-pod-identity-association",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  item.GetScope(),
+		},
+		{
+			ExpectedType:   "eks-identity-provider-config",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  item.GetScope(),
+		},
 	}
 
 	tests.Execute(t, item)
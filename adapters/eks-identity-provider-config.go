@@ -0,0 +1,191 @@
+package adapters
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// oidcIdentityProviderConfigType The only type of identity provider config
+// that EKS supports
+const oidcIdentityProviderConfigType = "oidc"
+
+func identityProviderConfigGetFunc(ctx context.Context, client EKSClient, scope string, input *eks.DescribeIdentityProviderConfigInput) (*sdp.Item, error) {
+	out, err := client.DescribeIdentityProviderConfig(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.IdentityProviderConfig == nil || out.IdentityProviderConfig.Oidc == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "identity provider config was nil",
+		}
+	}
+
+	config := out.IdentityProviderConfig.Oidc
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(config)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {clusterName}/{identityProviderConfigName}
+	attributes.Set("UniqueName", (*config.ClusterName + "/" + *config.IdentityProviderConfigName))
+
+	item := sdp.Item{
+		Type:            "eks-identity-provider-config",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            config.Tags,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "eks-cluster",
+					Method: sdp.QueryMethod_GET,
+					Query:  *config.ClusterName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the cluster deletes the config
+					In: true,
+					// The config controls who can authenticate to the cluster
+					Out: true,
+				},
+			},
+		},
+	}
+
+	switch config.Status {
+	case types.ConfigStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ConfigStatusCreating, types.ConfigStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if config.IssuerUrl != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  *config.IssuerUrl,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// If the issuer is unavailable users can't authenticate
+				In: true,
+				// The cluster can't affect the issuer
+				Out: false,
+			},
+		})
+
+		if u, err := url.Parse(*config.IssuerUrl); err == nil && u.Hostname() != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  u.Hostname(),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS is always linked bidirectionally
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEKSIdentityProviderConfigAdapter(client EKSClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*eks.ListIdentityProviderConfigsInput, *eks.ListIdentityProviderConfigsOutput, *eks.DescribeIdentityProviderConfigInput, *eks.DescribeIdentityProviderConfigOutput, EKSClient, *eks.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*eks.ListIdentityProviderConfigsInput, *eks.ListIdentityProviderConfigsOutput, *eks.DescribeIdentityProviderConfigInput, *eks.DescribeIdentityProviderConfigOutput, EKSClient, *eks.Options]{
+		ItemType:        "eks-identity-provider-config",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		DisableList:     true,
+		AdapterMetadata: identityProviderConfigAdapterMetadata,
+		SearchInputMapper: func(scope, query string) (*eks.ListIdentityProviderConfigsInput, error) {
+			// The ARN is in the format
+			// arn:aws:eks:region:account:identityproviderconfig/{clusterName}/oidc/{name}/{uuid}
+			// which doesn't map back to a unique name, but a cluster can only
+			// have one OIDC config so searching the cluster is equivalent
+			clusterName := query
+			if a, err := adapterhelpers.ParseARN(query); err == nil {
+				clusterName, _, _ = strings.Cut(a.ResourceID(), "/")
+			}
+
+			return &eks.ListIdentityProviderConfigsInput{
+				ClusterName: &clusterName,
+			}, nil
+		},
+		GetInputMapper: func(scope, query string) *eks.DescribeIdentityProviderConfigInput {
+			// The uniqueAttributeValue for this is a custom field:
+			// {clusterName}/{identityProviderConfigName}
+			fields := strings.Split(query, "/")
+
+			var clusterName string
+			var configName string
+
+			if len(fields) == 2 {
+				clusterName = fields[0]
+				configName = fields[1]
+			}
+
+			return &eks.DescribeIdentityProviderConfigInput{
+				ClusterName: &clusterName,
+				IdentityProviderConfig: &types.IdentityProviderConfig{
+					Name: &configName,
+					Type: adapterhelpers.PtrString(oidcIdentityProviderConfigType),
+				},
+			}
+		},
+		ListFuncPaginatorBuilder: func(client EKSClient, input *eks.ListIdentityProviderConfigsInput) adapterhelpers.Paginator[*eks.ListIdentityProviderConfigsOutput, *eks.Options] {
+			return eks.NewListIdentityProviderConfigsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *eks.ListIdentityProviderConfigsOutput, input *eks.ListIdentityProviderConfigsInput) ([]*eks.DescribeIdentityProviderConfigInput, error) {
+			inputs := make([]*eks.DescribeIdentityProviderConfigInput, 0, len(output.IdentityProviderConfigs))
+
+			for i := range output.IdentityProviderConfigs {
+				inputs = append(inputs, &eks.DescribeIdentityProviderConfigInput{
+					ClusterName:            input.ClusterName,
+					IdentityProviderConfig: &output.IdentityProviderConfigs[i],
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: identityProviderConfigGetFunc,
+	}
+}
+
+var identityProviderConfigAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "eks-identity-provider-config",
+	DescriptiveName: "EKS Identity Provider Config",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an identity provider config by unique name ({clusterName}/{identityProviderConfigName})",
+		SearchDescription: "Search for identity provider configs by cluster name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_eks_identity_provider_config.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"eks-cluster", "http", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var IdentityProviderConfigTestClient = EKSTestClient{
+	DescribeIdentityProviderConfigOutput: &eks.DescribeIdentityProviderConfigOutput{
+		IdentityProviderConfig: &types.IdentityProviderConfigResponse{
+			Oidc: &types.OidcIdentityProviderConfig{
+				ClientId:                   adapterhelpers.PtrString("kubernetes"),
+				ClusterName:                adapterhelpers.PtrString("dylan"),
+				GroupsClaim:                adapterhelpers.PtrString("groups"),
+				IdentityProviderConfigArn:  adapterhelpers.PtrString("arn:aws:eks:eu-west-2:801795385023:identityproviderconfig/dylan/oidc/okta/2ec4b1e6-4c4f-4a2a-9bc4-0b2f8e1f5d77"),
+				IdentityProviderConfigName: adapterhelpers.PtrString("okta"),
+				IssuerUrl:                  adapterhelpers.PtrString("https://example.okta.com/oauth2/default"),
+				Status:                     types.ConfigStatusActive,
+				Tags:                       map[string]string{},
+				UsernameClaim:              adapterhelpers.PtrString("email"),
+			},
+		},
+	},
+}
+
+func TestIdentityProviderConfigGetFunc(t *testing.T) {
+	item, err := identityProviderConfigGetFunc(context.Background(), IdentityProviderConfigTestClient, "foo", &eks.DescribeIdentityProviderConfigInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "dylan/okta" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "eks-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://example.okta.com/oauth2/default",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "example.okta.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestIdentityProviderConfigSearchInputMapper(t *testing.T) {
+	adapter := NewEKSIdentityProviderConfigAdapter(IdentityProviderConfigTestClient, "801795385023", "eu-west-2")
+
+	for _, query := range []string{
+		"dylan",
+		"arn:aws:eks:eu-west-2:801795385023:identityproviderconfig/dylan/oidc/okta/2ec4b1e6-4c4f-4a2a-9bc4-0b2f8e1f5d77",
+	} {
+		input, err := adapter.SearchInputMapper("801795385023.eu-west-2", query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if *input.ClusterName != "dylan" {
+			t.Errorf("expected cluster name to be dylan for query %v, got %v", query, *input.ClusterName)
+		}
+	}
+}
+
+func TestNewEKSIdentityProviderConfigAdapter(t *testing.T) {
+	client, account, region := eksGetAutoConfig(t)
+
+	adapter := NewEKSIdentityProviderConfigAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:           adapter,
+		Timeout:           10 * time.Second,
+		SkipNotFoundCheck: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,167 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func podIdentityAssociationGetFunc(ctx context.Context, client EKSClient, scope string, input *eks.DescribePodIdentityAssociationInput) (*sdp.Item, error) {
+	out, err := client.DescribePodIdentityAssociation(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Association == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "pod identity association was nil",
+		}
+	}
+
+	association := out.Association
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(association)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {clusterName}/{associationId}
+	attributes.Set("UniqueName", (*association.ClusterName + "/" + *association.AssociationId))
+
+	item := sdp.Item{
+		Type:            "eks-pod-identity-association",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            association.Tags,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "eks-cluster",
+					Method: sdp.QueryMethod_GET,
+					Query:  *association.ClusterName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the cluster deletes the association
+					In: true,
+					// The association controls what the cluster's pods can do
+					Out: true,
+				},
+			},
+		},
+	}
+
+	if association.RoleArn != nil {
+		// The role's permissions are what the service account gets, so changing
+		// the role affects the pods
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*association.RoleArn, scope))
+	}
+
+	if association.OwnerArn != nil {
+		// Associations that are managed by an addon have the addon's ARN as
+		// the owner. These are in the format
+		// arn:aws:eks:region:account:addon/{clusterName}/{addonName}/{uuid}
+		if a, err := adapterhelpers.ParseARN(*association.OwnerArn); err == nil && a.Type() == "addon" {
+			fields := strings.Split(a.ResourceID(), "/")
+
+			if len(fields) >= 2 {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "eks-addon",
+						Method: sdp.QueryMethod_GET,
+						Query:  fields[0] + "/" + fields[1],
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The addon manages the association
+						In: true,
+						// The addon's pods rely on the association
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEKSPodIdentityAssociationAdapter(client EKSClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*eks.ListPodIdentityAssociationsInput, *eks.ListPodIdentityAssociationsOutput, *eks.DescribePodIdentityAssociationInput, *eks.DescribePodIdentityAssociationOutput, EKSClient, *eks.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*eks.ListPodIdentityAssociationsInput, *eks.ListPodIdentityAssociationsOutput, *eks.DescribePodIdentityAssociationInput, *eks.DescribePodIdentityAssociationOutput, EKSClient, *eks.Options]{
+		ItemType:         "eks-pod-identity-association",
+		Client:           client,
+		AccountID:        accountID,
+		Region:           region,
+		DisableList:      true,
+		AlwaysSearchARNs: true,
+		AdapterMetadata:  podIdentityAssociationAdapterMetadata,
+		SearchInputMapper: func(scope, query string) (*eks.ListPodIdentityAssociationsInput, error) {
+			return &eks.ListPodIdentityAssociationsInput{
+				ClusterName: &query,
+			}, nil
+		},
+		GetInputMapper: func(scope, query string) *eks.DescribePodIdentityAssociationInput {
+			// The uniqueAttributeValue for this is a custom field:
+			// {clusterName}/{associationId}. This is also the resource ID of
+			// the association's ARN, so ARN searches work too
+			fields := strings.Split(query, "/")
+
+			var clusterName string
+			var associationID string
+
+			if len(fields) == 2 {
+				clusterName = fields[0]
+				associationID = fields[1]
+			}
+
+			return &eks.DescribePodIdentityAssociationInput{
+				AssociationId: &associationID,
+				ClusterName:   &clusterName,
+			}
+		},
+		ListFuncPaginatorBuilder: func(client EKSClient, input *eks.ListPodIdentityAssociationsInput) adapterhelpers.Paginator[*eks.ListPodIdentityAssociationsOutput, *eks.Options] {
+			return eks.NewListPodIdentityAssociationsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *eks.ListPodIdentityAssociationsOutput, input *eks.ListPodIdentityAssociationsInput) ([]*eks.DescribePodIdentityAssociationInput, error) {
+			inputs := make([]*eks.DescribePodIdentityAssociationInput, 0, len(output.Associations))
+
+			for _, association := range output.Associations {
+				inputs = append(inputs, &eks.DescribePodIdentityAssociationInput{
+					AssociationId: association.AssociationId,
+					ClusterName:   input.ClusterName,
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: podIdentityAssociationGetFunc,
+	}
+}
+
+var podIdentityAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "eks-pod-identity-association",
+	DescriptiveName: "EKS Pod Identity Association",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a pod identity association by unique name ({clusterName}/{associationId})",
+		SearchDescription: "Search for pod identity associations by cluster name or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_eks_pod_identity_association.association_arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"eks-cluster", "eks-addon", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var PodIdentityAssociationTestClient = EKSTestClient{
+	DescribePodIdentityAssociationOutput: &eks.DescribePodIdentityAssociationOutput{
+		Association: &types.PodIdentityAssociation{
+			AssociationArn: adapterhelpers.PtrString("arn:aws:eks:eu-west-2:801795385023:podidentityassociation/dylan/a-9njjin9gfghecgocd"),
+			AssociationId:  adapterhelpers.PtrString("a-9njjin9gfghecgocd"),
+			ClusterName:    adapterhelpers.PtrString("dylan"),
+			CreatedAt:      adapterhelpers.PtrTime(time.Now()),
+			ModifiedAt:     adapterhelpers.PtrTime(time.Now()),
+			Namespace:      adapterhelpers.PtrString("kube-system"),
+			OwnerArn:       adapterhelpers.PtrString("arn:aws:eks:eu-west-2:801795385023:addon/dylan/aws-ebs-csi-driver/a2c4b1e6-4c4f-4a2a-9bc4-0b2f8e1f5d77"),
+			RoleArn:        adapterhelpers.PtrString("arn:aws:iam::801795385023:role/ebs-csi-driver"),
+			ServiceAccount: adapterhelpers.PtrString("ebs-csi-controller-sa"),
+			Tags:           map[string]string{},
+		},
+	},
+}
+
+func TestPodIdentityAssociationGetFunc(t *testing.T) {
+	item, err := podIdentityAssociationGetFunc(context.Background(), PodIdentityAssociationTestClient, "foo", &eks.DescribePodIdentityAssociationInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "dylan/a-9njjin9gfghecgocd" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "eks-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dylan",
+			ExpectedScope:  "foo",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::801795385023:role/ebs-csi-driver",
+			ExpectedScope:  "801795385023",
+		},
+		{
+			ExpectedType:   "eks-addon",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dylan/aws-ebs-csi-driver",
+			ExpectedScope:  "801795385023.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEKSPodIdentityAssociationAdapter(t *testing.T) {
+	client, account, region := eksGetAutoConfig(t)
+
+	adapter := NewEKSPodIdentityAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:           adapter,
+		Timeout:           10 * time.Second,
+		SkipNotFoundCheck: true,
+	}
+
+	test.Run(t)
+}
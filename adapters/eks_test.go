@@ -19,6 +19,11 @@ type EKSTestClient struct {
 	DescribeIdentityProviderConfigOutput *eks.DescribeIdentityProviderConfigOutput
 	ListNodegroupsOutput                 *eks.ListNodegroupsOutput
 	DescribeNodegroupOutput              *eks.DescribeNodegroupOutput
+	ListAccessEntriesOutput              *eks.ListAccessEntriesOutput
+	DescribeAccessEntryOutput            *eks.DescribeAccessEntryOutput
+	ListAssociatedAccessPoliciesOutput   *eks.ListAssociatedAccessPoliciesOutput
+	ListPodIdentityAssociationsOutput    *eks.ListPodIdentityAssociationsOutput
+	DescribePodIdentityAssociationOutput *eks.DescribePodIdentityAssociationOutput
 }
 
 func (t EKSTestClient) ListClusters(context.Context, *eks.ListClustersInput, ...func(*eks.Options)) (*eks.ListClustersOutput, error) {
@@ -61,6 +66,30 @@ func (t EKSTestClient) DescribeNodegroup(ctx context.Context, params *eks.Descri
 	return t.DescribeNodegroupOutput, nil
 }
 
+func (t EKSTestClient) ListAccessEntries(ctx context.Context, params *eks.ListAccessEntriesInput, optFns ...func(*eks.Options)) (*eks.ListAccessEntriesOutput, error) {
+	return t.ListAccessEntriesOutput, nil
+}
+
+func (t EKSTestClient) DescribeAccessEntry(ctx context.Context, params *eks.DescribeAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	return t.DescribeAccessEntryOutput, nil
+}
+
+func (t EKSTestClient) ListAssociatedAccessPolicies(ctx context.Context, params *eks.ListAssociatedAccessPoliciesInput, optFns ...func(*eks.Options)) (*eks.ListAssociatedAccessPoliciesOutput, error) {
+	if t.ListAssociatedAccessPoliciesOutput == nil {
+		return &eks.ListAssociatedAccessPoliciesOutput{}, nil
+	}
+
+	return t.ListAssociatedAccessPoliciesOutput, nil
+}
+
+func (t EKSTestClient) ListPodIdentityAssociations(ctx context.Context, params *eks.ListPodIdentityAssociationsInput, optFns ...func(*eks.Options)) (*eks.ListPodIdentityAssociationsOutput, error) {
+	return t.ListPodIdentityAssociationsOutput, nil
+}
+
+func (t EKSTestClient) DescribePodIdentityAssociation(ctx context.Context, params *eks.DescribePodIdentityAssociationInput, optFns ...func(*eks.Options)) (*eks.DescribePodIdentityAssociationOutput, error) {
+	return t.DescribePodIdentityAssociationOutput, nil
+}
+
 func eksGetAutoConfig(t *testing.T) (*eks.Client, string, string) {
 	config, account, region := adapterhelpers.GetAutoConfig(t)
 	client := eks.NewFromConfig(config)
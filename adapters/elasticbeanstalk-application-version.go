@@ -0,0 +1,206 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// elasticBeanstalkApplicationVersionGetFunc Gets an application version by
+// its unique name: {applicationName}/{versionLabel}. Application names can't
+// contain slashes so we only split on the first one
+func elasticBeanstalkApplicationVersionGetFunc(ctx context.Context, client ElasticBeanstalkClient, scope, query string) (*types.ApplicationVersionDescription, error) {
+	applicationName, versionLabel, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {applicationName}/{versionLabel}",
+		}
+	}
+
+	out, err := client.DescribeApplicationVersions(ctx, &elasticbeanstalk.DescribeApplicationVersionsInput{
+		ApplicationName: &applicationName,
+		VersionLabels:   []string{versionLabel},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.ApplicationVersions) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "application version not found",
+		}
+	}
+
+	return &out.ApplicationVersions[0], nil
+}
+
+func elasticBeanstalkApplicationVersionListFunc(ctx context.Context, client ElasticBeanstalkClient, scope string) ([]*types.ApplicationVersionDescription, error) {
+	return elasticBeanstalkApplicationVersionListByApplication(ctx, client, nil)
+}
+
+// elasticBeanstalkApplicationVersionListByApplication Lists application
+// versions, optionally only those for a given application. The SDK doesn't
+// provide a paginator for this so we follow the token ourselves
+func elasticBeanstalkApplicationVersionListByApplication(ctx context.Context, client ElasticBeanstalkClient, applicationName *string) ([]*types.ApplicationVersionDescription, error) {
+	input := &elasticbeanstalk.DescribeApplicationVersionsInput{
+		ApplicationName: applicationName,
+	}
+
+	versions := make([]*types.ApplicationVersionDescription, 0)
+
+	for {
+		out, err := client.DescribeApplicationVersions(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.ApplicationVersions {
+			versions = append(versions, &out.ApplicationVersions[i])
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return versions, nil
+}
+
+// elasticBeanstalkApplicationVersionSearchFunc Searches for application
+// versions either by ARN, or by the name of the application they belong to
+func elasticBeanstalkApplicationVersionSearchFunc(ctx context.Context, client ElasticBeanstalkClient, scope, query string) ([]*types.ApplicationVersionDescription, error) {
+	// The ARN is in the format
+	// arn:aws:elasticbeanstalk:region:account:applicationversion/{applicationName}/{versionLabel}
+	// so the resource ID is the same as the unique name
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		version, err := elasticBeanstalkApplicationVersionGetFunc(ctx, client, scope, a.ResourceID())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.ApplicationVersionDescription{version}, nil
+	}
+
+	return elasticBeanstalkApplicationVersionListByApplication(ctx, client, &query)
+}
+
+func elasticBeanstalkApplicationVersionItemMapper(_, scope string, awsItem *types.ApplicationVersionDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {applicationName}/{versionLabel}
+	attributes.Set("UniqueName", (*awsItem.ApplicationName + "/" + *awsItem.VersionLabel))
+
+	item := sdp.Item{
+		Type:            "elasticbeanstalk-application-version",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "elasticbeanstalk-application",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.ApplicationName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the application deletes the version
+					In: true,
+					// The version can't affect the application
+					Out: false,
+				},
+			},
+		},
+	}
+
+	switch awsItem.Status {
+	case types.ApplicationVersionStatusProcessed, types.ApplicationVersionStatusUnprocessed:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ApplicationVersionStatusProcessing, types.ApplicationVersionStatusBuilding:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ApplicationVersionStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.SourceBundle != nil && awsItem.SourceBundle.S3Bucket != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.SourceBundle.S3Bucket,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The source bundle is deployed from the bucket, deleting it
+				// means the version can't be deployed
+				In: true,
+				// The version can't affect the bucket
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewElasticBeanstalkApplicationVersionAdapter(client ElasticBeanstalkClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ApplicationVersionDescription, ElasticBeanstalkClient, *elasticbeanstalk.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ApplicationVersionDescription, ElasticBeanstalkClient, *elasticbeanstalk.Options]{
+		ItemType:        "elasticbeanstalk-application-version",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: elasticBeanstalkApplicationVersionAdapterMetadata,
+		GetFunc:         elasticBeanstalkApplicationVersionGetFunc,
+		ListFunc:        elasticBeanstalkApplicationVersionListFunc,
+		SearchFunc:      elasticBeanstalkApplicationVersionSearchFunc,
+		ItemMapper:      elasticBeanstalkApplicationVersionItemMapper,
+		ListTagsFunc: func(ctx context.Context, version *types.ApplicationVersionDescription, client ElasticBeanstalkClient) (map[string]string, error) {
+			if version.ApplicationVersionArn == nil {
+				return nil, nil
+			}
+
+			return elasticBeanstalkListTags(ctx, client, *version.ApplicationVersionArn)
+		},
+	}
+}
+
+var elasticBeanstalkApplicationVersionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticbeanstalk-application-version",
+	DescriptiveName: "Elastic Beanstalk Application Version",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an application version by unique name ({applicationName}/{versionLabel})",
+		ListDescription:   "List all application versions",
+		SearchDescription: "Search for application versions by ARN, or by application name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_elastic_beanstalk_application_version.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"elasticbeanstalk-application", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
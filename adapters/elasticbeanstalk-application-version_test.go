@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestElasticBeanstalkApplicationVersionItemMapper(t *testing.T) {
+	version := &types.ApplicationVersionDescription{
+		ApplicationName:       adapterhelpers.PtrString("billing"),
+		ApplicationVersionArn: adapterhelpers.PtrString("arn:aws:elasticbeanstalk:eu-west-2:123456789012:applicationversion/billing/v2"),
+		DateCreated:           adapterhelpers.PtrTime(time.Now()),
+		SourceBundle: &types.S3Location{
+			S3Bucket: adapterhelpers.PtrString("elasticbeanstalk-eu-west-2-123456789012"),
+			S3Key:    adapterhelpers.PtrString("billing/v2.zip"),
+		},
+		Status:       types.ApplicationVersionStatusProcessed,
+		VersionLabel: adapterhelpers.PtrString("v2"),
+	}
+
+	item, err := elasticBeanstalkApplicationVersionItemMapper("", "123456789012.eu-west-2", version)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "billing/v2" {
+		t.Errorf("unexpected unique attribute value %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticbeanstalk-application",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "elasticbeanstalk-eu-west-2-123456789012",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestElasticBeanstalkApplicationVersionGetFunc(t *testing.T) {
+	client := ElasticBeanstalkTestClient{
+		DescribeApplicationVersionsOutput: &elasticbeanstalk.DescribeApplicationVersionsOutput{
+			ApplicationVersions: []types.ApplicationVersionDescription{
+				{
+					ApplicationName: adapterhelpers.PtrString("billing"),
+					VersionLabel:    adapterhelpers.PtrString("v2"),
+				},
+			},
+		},
+	}
+
+	version, err := elasticBeanstalkApplicationVersionGetFunc(context.Background(), client, "123456789012.eu-west-2", "billing/v2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *version.VersionLabel != "v2" {
+		t.Errorf("expected version label to be v2, got %v", *version.VersionLabel)
+	}
+
+	if _, err = elasticBeanstalkApplicationVersionGetFunc(context.Background(), client, "123456789012.eu-west-2", "billing"); err == nil {
+		t.Error("expected an error for a query without a version label")
+	}
+}
+
+func TestNewElasticBeanstalkApplicationVersionAdapter(t *testing.T) {
+	client, account, region := elasticBeanstalkGetAutoConfig(t)
+
+	adapter := NewElasticBeanstalkApplicationVersionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
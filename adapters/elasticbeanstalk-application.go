@@ -0,0 +1,135 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func elasticBeanstalkApplicationGetFunc(ctx context.Context, client ElasticBeanstalkClient, scope, query string) (*types.ApplicationDescription, error) {
+	out, err := client.DescribeApplications(ctx, &elasticbeanstalk.DescribeApplicationsInput{
+		ApplicationNames: []string{query},
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Applications) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "application not found",
+		}
+	}
+
+	return &out.Applications[0], nil
+}
+
+func elasticBeanstalkApplicationListFunc(ctx context.Context, client ElasticBeanstalkClient, scope string) ([]*types.ApplicationDescription, error) {
+	// This isn't paginated, it returns all applications at once
+	out, err := client.DescribeApplications(ctx, &elasticbeanstalk.DescribeApplicationsInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	applications := make([]*types.ApplicationDescription, 0, len(out.Applications))
+
+	for i := range out.Applications {
+		applications = append(applications, &out.Applications[i])
+	}
+
+	return applications, nil
+}
+
+func elasticBeanstalkApplicationItemMapper(_, scope string, awsItem *types.ApplicationDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "elasticbeanstalk-application",
+		UniqueAttribute: "ApplicationName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "elasticbeanstalk-environment",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ApplicationName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the application terminates its environments
+					In:  true,
+					Out: true,
+				},
+			},
+			{
+				Query: &sdp.Query{
+					Type:   "elasticbeanstalk-application-version",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.ApplicationName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the application deletes its versions
+					In:  true,
+					Out: true,
+				},
+			},
+		},
+	}
+
+	if awsItem.ResourceLifecycleConfig != nil && awsItem.ResourceLifecycleConfig.ServiceRole != nil {
+		// The role is used to clean up old versions
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.ResourceLifecycleConfig.ServiceRole, scope))
+	}
+
+	return &item, nil
+}
+
+func NewElasticBeanstalkApplicationAdapter(client ElasticBeanstalkClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.ApplicationDescription, ElasticBeanstalkClient, *elasticbeanstalk.Options] {
+	return &adapterhelpers.GetListAdapter[*types.ApplicationDescription, ElasticBeanstalkClient, *elasticbeanstalk.Options]{
+		ItemType:        "elasticbeanstalk-application",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: elasticBeanstalkApplicationAdapterMetadata,
+		GetFunc:         elasticBeanstalkApplicationGetFunc,
+		ListFunc:        elasticBeanstalkApplicationListFunc,
+		ItemMapper:      elasticBeanstalkApplicationItemMapper,
+		ListTagsFunc: func(ctx context.Context, application *types.ApplicationDescription, client ElasticBeanstalkClient) (map[string]string, error) {
+			if application.ApplicationArn == nil {
+				return nil, nil
+			}
+
+			return elasticBeanstalkListTags(ctx, client, *application.ApplicationArn)
+		},
+	}
+}
+
+var elasticBeanstalkApplicationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticbeanstalk-application",
+	DescriptiveName: "Elastic Beanstalk Application",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an application by name",
+		ListDescription:   "List all applications",
+		SearchDescription: "Search for applications by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elastic_beanstalk_application.name"},
+	},
+	PotentialLinks: []string{"elasticbeanstalk-environment", "elasticbeanstalk-application-version", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestElasticBeanstalkApplicationItemMapper(t *testing.T) {
+	application := &types.ApplicationDescription{
+		ApplicationArn:  adapterhelpers.PtrString("arn:aws:elasticbeanstalk:eu-west-2:123456789012:application/billing"),
+		ApplicationName: adapterhelpers.PtrString("billing"),
+		DateCreated:     adapterhelpers.PtrTime(time.Now()),
+		DateUpdated:     adapterhelpers.PtrTime(time.Now()),
+		ResourceLifecycleConfig: &types.ApplicationResourceLifecycleConfig{
+			ServiceRole: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/aws-elasticbeanstalk-service-role"),
+		},
+		Versions: []string{"v1", "v2"},
+	}
+
+	item, err := elasticBeanstalkApplicationItemMapper("", "123456789012.eu-west-2", application)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticbeanstalk-environment",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elasticbeanstalk-application-version",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-elasticbeanstalk-service-role",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestElasticBeanstalkApplicationGetFunc(t *testing.T) {
+	client := ElasticBeanstalkTestClient{
+		DescribeApplicationsOutput: &elasticbeanstalk.DescribeApplicationsOutput{},
+	}
+
+	_, err := elasticBeanstalkApplicationGetFunc(context.Background(), client, "123456789012.eu-west-2", "billing")
+
+	if err == nil {
+		t.Error("expected an error when the application isn't found")
+	}
+}
+
+func TestNewElasticBeanstalkApplicationAdapter(t *testing.T) {
+	client, account, region := elasticBeanstalkGetAutoConfig(t)
+
+	adapter := NewElasticBeanstalkApplicationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
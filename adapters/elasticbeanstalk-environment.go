@@ -0,0 +1,460 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ElasticBeanstalkEnvironmentDetails An environment along with the AWS
+// resources that Elastic Beanstalk created for it
+type ElasticBeanstalkEnvironmentDetails struct {
+	Environment *types.EnvironmentDescription
+	Resources   *types.EnvironmentResourceDescription
+}
+
+func elasticBeanstalkEnvironmentGetFunc(ctx context.Context, client ElasticBeanstalkClient, scope, query string) (*ElasticBeanstalkEnvironmentDetails, error) {
+	out, err := client.DescribeEnvironments(ctx, &elasticbeanstalk.DescribeEnvironmentsInput{
+		EnvironmentNames: []string{query},
+		IncludeDeleted:   adapterhelpers.PtrBool(false),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(out.Environments) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "environment not found",
+		}
+	}
+
+	return elasticBeanstalkEnvironmentWithResources(ctx, client, &out.Environments[0]), nil
+}
+
+// elasticBeanstalkEnvironmentWithResources Adds the environment's resources
+// e.g. its auto scaling groups and load balancers. These aren't included in
+// the description so have to be fetched separately. If they can't be fetched
+// we still want to return the environment itself
+func elasticBeanstalkEnvironmentWithResources(ctx context.Context, client ElasticBeanstalkClient, environment *types.EnvironmentDescription) *ElasticBeanstalkEnvironmentDetails {
+	details := ElasticBeanstalkEnvironmentDetails{
+		Environment: environment,
+	}
+
+	if environment.EnvironmentId == nil {
+		return &details
+	}
+
+	out, err := client.DescribeEnvironmentResources(ctx, &elasticbeanstalk.DescribeEnvironmentResourcesInput{
+		EnvironmentId: environment.EnvironmentId,
+	})
+
+	if err == nil {
+		details.Resources = out.EnvironmentResources
+	}
+
+	return &details
+}
+
+func elasticBeanstalkEnvironmentListFunc(ctx context.Context, client ElasticBeanstalkClient, scope string) ([]*ElasticBeanstalkEnvironmentDetails, error) {
+	return elasticBeanstalkEnvironmentListByApplication(ctx, client, nil)
+}
+
+// elasticBeanstalkEnvironmentListByApplication Lists environments, optionally
+// only those for a given application. The SDK doesn't provide a paginator for
+// this so we follow the token ourselves
+func elasticBeanstalkEnvironmentListByApplication(ctx context.Context, client ElasticBeanstalkClient, applicationName *string) ([]*ElasticBeanstalkEnvironmentDetails, error) {
+	input := &elasticbeanstalk.DescribeEnvironmentsInput{
+		ApplicationName: applicationName,
+		IncludeDeleted:  adapterhelpers.PtrBool(false),
+	}
+
+	environments := make([]*ElasticBeanstalkEnvironmentDetails, 0)
+
+	for {
+		out, err := client.DescribeEnvironments(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.Environments {
+			environments = append(environments, elasticBeanstalkEnvironmentWithResources(ctx, client, &out.Environments[i]))
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return environments, nil
+}
+
+// elasticBeanstalkEnvironmentSearchFunc Searches for environments either by
+// ARN, or by the name of the application they belong to
+func elasticBeanstalkEnvironmentSearchFunc(ctx context.Context, client ElasticBeanstalkClient, scope, query string) ([]*ElasticBeanstalkEnvironmentDetails, error) {
+	// The ARN is in the format
+	// arn:aws:elasticbeanstalk:region:account:environment/{applicationName}/{environmentName}
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		resourceID := a.ResourceID()
+		environment, err := elasticBeanstalkEnvironmentGetFunc(ctx, client, scope, resourceID[strings.LastIndex(resourceID, "/")+1:])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*ElasticBeanstalkEnvironmentDetails{environment}, nil
+	}
+
+	return elasticBeanstalkEnvironmentListByApplication(ctx, client, &query)
+}
+
+func elasticBeanstalkEnvironmentItemMapper(_, scope string, awsItem *ElasticBeanstalkEnvironmentDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*types.EnvironmentDescription
+		Resources *types.EnvironmentResourceDescription
+	}{
+		EnvironmentDescription: awsItem.Environment,
+		Resources:              awsItem.Resources,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	environment := awsItem.Environment
+
+	item := sdp.Item{
+		Type:            "elasticbeanstalk-environment",
+		UniqueAttribute: "EnvironmentName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch environment.Status {
+	case types.EnvironmentStatusLaunching, types.EnvironmentStatusUpdating, types.EnvironmentStatusLinkingFrom, types.EnvironmentStatusLinkingTo, types.EnvironmentStatusAborting, types.EnvironmentStatusTerminating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	default:
+		switch environment.Health {
+		case types.EnvironmentHealthGreen:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.EnvironmentHealthYellow:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.EnvironmentHealthRed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+	}
+
+	if environment.ApplicationName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "elasticbeanstalk-application",
+				Method: sdp.QueryMethod_GET,
+				Query:  *environment.ApplicationName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the application terminates the environment
+				In: true,
+				// The environment can't affect the application
+				Out: false,
+			},
+		})
+
+		if environment.VersionLabel != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "elasticbeanstalk-application-version",
+					Method: sdp.QueryMethod_GET,
+					Query:  *environment.ApplicationName + "/" + *environment.VersionLabel,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The version is the code that is deployed to the
+					// environment
+					In: true,
+					// The environment can't affect the version
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, link := range environment.EnvironmentLinks {
+		if link.EnvironmentName == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "elasticbeanstalk-environment",
+				Method: sdp.QueryMethod_GET,
+				Query:  *link.EnvironmentName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Linked environments e.g. a web tier and a worker tier
+				// depend on each other
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if environment.OperationsRole != nil {
+		// The role is used to manage the environment
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*environment.OperationsRole, scope))
+	}
+
+	if environment.CNAME != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *environment.CNAME,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS is always linked bidirectionally
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	// The endpoint is the load balancer's DNS name, or the instance's IP
+	// address for single instance environments
+	if environment.EndpointURL != nil {
+		if net.ParseIP(*environment.EndpointURL) != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  *environment.EndpointURL,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked bidirectionally
+					In:  true,
+					Out: true,
+				},
+			})
+		} else {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *environment.EndpointURL,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS is always linked bidirectionally
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if resources := awsItem.Resources; resources != nil {
+		// All of these resources are created and managed by Elastic
+		// Beanstalk, so changes to the environment will change them and vice
+		// versa
+		for _, asg := range resources.AutoScalingGroups {
+			if asg.Name == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "autoscaling-auto-scaling-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *asg.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, instance := range resources.Instances {
+			if instance.Id == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-instance",
+					Method: sdp.QueryMethod_GET,
+					Query:  *instance.Id,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, launchConfiguration := range resources.LaunchConfigurations {
+			if launchConfiguration.Name == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "autoscaling-launch-configuration",
+					Method: sdp.QueryMethod_GET,
+					Query:  *launchConfiguration.Name,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, launchTemplate := range resources.LaunchTemplates {
+			if launchTemplate.Id == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-launch-template",
+					Method: sdp.QueryMethod_GET,
+					Query:  *launchTemplate.Id,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, loadBalancer := range resources.LoadBalancers {
+			if loadBalancer.Name == nil {
+				continue
+			}
+
+			// Application and network load balancers are returned as ARNs,
+			// classic load balancers are returned by name
+			if a, err := adapterhelpers.ParseARN(*loadBalancer.Name); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "elbv2-load-balancer",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *loadBalancer.Name,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						In:  true,
+						Out: true,
+					},
+				})
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "elb-load-balancer",
+						Method: sdp.QueryMethod_GET,
+						Query:  *loadBalancer.Name,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		for _, queue := range resources.Queues {
+			if queue.URL == nil {
+				continue
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sqs-queue",
+					Method: sdp.QueryMethod_GET,
+					Query:  *queue.URL,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewElasticBeanstalkEnvironmentAdapter(client ElasticBeanstalkClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ElasticBeanstalkEnvironmentDetails, ElasticBeanstalkClient, *elasticbeanstalk.Options] {
+	return &adapterhelpers.GetListAdapter[*ElasticBeanstalkEnvironmentDetails, ElasticBeanstalkClient, *elasticbeanstalk.Options]{
+		ItemType:        "elasticbeanstalk-environment",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: elasticBeanstalkEnvironmentAdapterMetadata,
+		GetFunc:         elasticBeanstalkEnvironmentGetFunc,
+		ListFunc:        elasticBeanstalkEnvironmentListFunc,
+		SearchFunc:      elasticBeanstalkEnvironmentSearchFunc,
+		ItemMapper:      elasticBeanstalkEnvironmentItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *ElasticBeanstalkEnvironmentDetails, client ElasticBeanstalkClient) (map[string]string, error) {
+			if details.Environment.EnvironmentArn == nil {
+				return nil, nil
+			}
+
+			return elasticBeanstalkListTags(ctx, client, *details.Environment.EnvironmentArn)
+		},
+	}
+}
+
+var elasticBeanstalkEnvironmentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "elasticbeanstalk-environment",
+	DescriptiveName: "Elastic Beanstalk Environment",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an environment by name",
+		ListDescription:   "List all environments",
+		SearchDescription: "Search for environments by ARN, or by application name",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_elastic_beanstalk_environment.name"},
+	},
+	PotentialLinks: []string{
+		"elasticbeanstalk-application",
+		"elasticbeanstalk-application-version",
+		"elasticbeanstalk-environment",
+		"iam-role",
+		"dns",
+		"ip",
+		"autoscaling-auto-scaling-group",
+		"ec2-instance",
+		"autoscaling-launch-configuration",
+		"ec2-launch-template",
+		"elbv2-load-balancer",
+		"elb-load-balancer",
+		"sqs-queue",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
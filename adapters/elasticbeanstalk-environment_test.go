@@ -0,0 +1,191 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var ElasticBeanstalkEnvironmentTestClient = ElasticBeanstalkTestClient{
+	DescribeEnvironmentsOutput: &elasticbeanstalk.DescribeEnvironmentsOutput{
+		Environments: []types.EnvironmentDescription{
+			{
+				ApplicationName: adapterhelpers.PtrString("billing"),
+				CNAME:           adapterhelpers.PtrString("billing-prod.eu-west-2.elasticbeanstalk.com"),
+				DateCreated:     adapterhelpers.PtrTime(time.Now()),
+				EndpointURL:     adapterhelpers.PtrString("awseb-e-a-AWSEBLoa-1X2Y3Z4-123456789.eu-west-2.elb.amazonaws.com"),
+				EnvironmentArn:  adapterhelpers.PtrString("arn:aws:elasticbeanstalk:eu-west-2:123456789012:environment/billing/billing-prod"),
+				EnvironmentId:   adapterhelpers.PtrString("e-abcd1234"),
+				EnvironmentLinks: []types.EnvironmentLink{
+					{
+						EnvironmentName: adapterhelpers.PtrString("billing-worker"),
+						LinkName:        adapterhelpers.PtrString("WORKERQUEUE"),
+					},
+				},
+				EnvironmentName: adapterhelpers.PtrString("billing-prod"),
+				Health:          types.EnvironmentHealthGreen,
+				OperationsRole:  adapterhelpers.PtrString("arn:aws:iam::123456789012:role/aws-elasticbeanstalk-operations-role"),
+				Status:          types.EnvironmentStatusReady,
+				VersionLabel:    adapterhelpers.PtrString("v2"),
+			},
+		},
+	},
+	DescribeEnvironmentResourcesOutput: &elasticbeanstalk.DescribeEnvironmentResourcesOutput{
+		EnvironmentResources: &types.EnvironmentResourceDescription{
+			AutoScalingGroups: []types.AutoScalingGroup{
+				{Name: adapterhelpers.PtrString("awseb-e-abcd1234-stack-AWSEBAutoScalingGroup-1ABC")},
+			},
+			EnvironmentName: adapterhelpers.PtrString("billing-prod"),
+			Instances: []types.Instance{
+				{Id: adapterhelpers.PtrString("i-0123456789abcdef0")},
+			},
+			LaunchTemplates: []types.LaunchTemplate{
+				{Id: adapterhelpers.PtrString("lt-0123456789abcdef0")},
+			},
+			LoadBalancers: []types.LoadBalancer{
+				{Name: adapterhelpers.PtrString("arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/awseb-AWSEB-1ABC/0123456789abcdef")},
+				{Name: adapterhelpers.PtrString("awseb-e-a-AWSEBLoa-1X2Y3Z4")},
+			},
+			Queues: []types.Queue{
+				{
+					Name: adapterhelpers.PtrString("WorkerQueue"),
+					URL:  adapterhelpers.PtrString("https://sqs.eu-west-2.amazonaws.com/123456789012/awseb-e-abcd1234-stack-AWSEBWorkerQueue-1ABC"),
+				},
+			},
+		},
+	},
+}
+
+func TestElasticBeanstalkEnvironmentGetFunc(t *testing.T) {
+	details, err := elasticBeanstalkEnvironmentGetFunc(context.Background(), ElasticBeanstalkEnvironmentTestClient, "123456789012.eu-west-2", "billing-prod")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details.Resources == nil {
+		t.Fatal("expected the environment's resources to be included")
+	}
+
+	item, err := elasticBeanstalkEnvironmentItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "elasticbeanstalk-application",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elasticbeanstalk-application-version",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing/v2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elasticbeanstalk-environment",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "billing-worker",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-elasticbeanstalk-operations-role",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing-prod.eu-west-2.elasticbeanstalk.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "awseb-e-a-AWSEBLoa-1X2Y3Z4-123456789.eu-west-2.elb.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "autoscaling-auto-scaling-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "awseb-e-abcd1234-stack-AWSEBAutoScalingGroup-1ABC",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-launch-template",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lt-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/awseb-AWSEB-1ABC/0123456789abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "elb-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "awseb-e-a-AWSEBLoa-1X2Y3Z4",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.eu-west-2.amazonaws.com/123456789012/awseb-e-abcd1234-stack-AWSEBWorkerQueue-1ABC",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestElasticBeanstalkEnvironmentSearchFunc(t *testing.T) {
+	environments, err := elasticBeanstalkEnvironmentSearchFunc(context.Background(), ElasticBeanstalkEnvironmentTestClient, "123456789012.eu-west-2", "arn:aws:elasticbeanstalk:eu-west-2:123456789012:environment/billing/billing-prod")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(environments) != 1 {
+		t.Errorf("expected 1 environment, got %v", len(environments))
+	}
+}
+
+func TestNewElasticBeanstalkEnvironmentAdapter(t *testing.T) {
+	client, account, region := elasticBeanstalkGetAutoConfig(t)
+
+	adapter := NewElasticBeanstalkEnvironmentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
+	"github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type ElasticBeanstalkTestClient struct {
+	DescribeApplicationsOutput         *elasticbeanstalk.DescribeApplicationsOutput
+	DescribeApplicationVersionsOutput  *elasticbeanstalk.DescribeApplicationVersionsOutput
+	DescribeEnvironmentsOutput         *elasticbeanstalk.DescribeEnvironmentsOutput
+	DescribeEnvironmentResourcesOutput *elasticbeanstalk.DescribeEnvironmentResourcesOutput
+	ListTagsForResourceOutput          *elasticbeanstalk.ListTagsForResourceOutput
+}
+
+func (t ElasticBeanstalkTestClient) DescribeApplications(context.Context, *elasticbeanstalk.DescribeApplicationsInput, ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeApplicationsOutput, error) {
+	return t.DescribeApplicationsOutput, nil
+}
+
+func (t ElasticBeanstalkTestClient) DescribeApplicationVersions(context.Context, *elasticbeanstalk.DescribeApplicationVersionsInput, ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeApplicationVersionsOutput, error) {
+	return t.DescribeApplicationVersionsOutput, nil
+}
+
+func (t ElasticBeanstalkTestClient) DescribeEnvironments(context.Context, *elasticbeanstalk.DescribeEnvironmentsInput, ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentsOutput, error) {
+	return t.DescribeEnvironmentsOutput, nil
+}
+
+func (t ElasticBeanstalkTestClient) DescribeEnvironmentResources(context.Context, *elasticbeanstalk.DescribeEnvironmentResourcesInput, ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.DescribeEnvironmentResourcesOutput, error) {
+	return t.DescribeEnvironmentResourcesOutput, nil
+}
+
+func (t ElasticBeanstalkTestClient) ListTagsForResource(context.Context, *elasticbeanstalk.ListTagsForResourceInput, ...func(*elasticbeanstalk.Options)) (*elasticbeanstalk.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func elasticBeanstalkGetAutoConfig(t *testing.T) (*elasticbeanstalk.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := elasticbeanstalk.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestElasticBeanstalkListTags(t *testing.T) {
+	client := ElasticBeanstalkTestClient{
+		ListTagsForResourceOutput: &elasticbeanstalk.ListTagsForResourceOutput{
+			ResourceTags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("billing"),
+				},
+				{
+					Key: adapterhelpers.PtrString("no-value"),
+				},
+			},
+		},
+	}
+
+	tags, err := elasticBeanstalkListTags(context.Background(), client, "arn:aws:elasticbeanstalk:eu-west-2:123456789012:application/billing")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tags) != 1 || tags["team"] != "billing" {
+		t.Errorf("unexpected tags %v", tags)
+	}
+}
@@ -0,0 +1,329 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func emrClusterGetFunc(ctx context.Context, client EMRClient, scope, query string) (*types.Cluster, error) {
+	out, err := client.DescribeCluster(ctx, &emr.DescribeClusterInput{
+		ClusterId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Cluster == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "cluster was nil",
+		}
+	}
+
+	return out.Cluster, nil
+}
+
+func emrClusterListFunc(ctx context.Context, client EMRClient, scope string) ([]*types.Cluster, error) {
+	ids, err := emrListClusterIDs(ctx, client)
+
+	if err != nil {
+		return nil, err
+	}
+
+	clusters := make([]*types.Cluster, 0, len(ids))
+
+	// The summaries don't include the network, roles or configuration
+	for _, id := range ids {
+		cluster, err := emrClusterGetFunc(ctx, client, scope, id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		clusters = append(clusters, cluster)
+	}
+
+	return clusters, nil
+}
+
+func emrClusterItemMapper(_, scope string, awsItem *types.Cluster) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "emr-cluster",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            emrTagsToMap(awsItem.Tags),
+	}
+
+	if awsItem.Status != nil {
+		switch awsItem.Status.State {
+		case types.ClusterStateRunning, types.ClusterStateWaiting:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.ClusterStateStarting, types.ClusterStateBootstrapping, types.ClusterStateTerminating:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.ClusterStateTerminatedWithErrors:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case types.ClusterStateTerminated:
+			item.Health = nil
+		}
+	}
+
+	if awsItem.Id != nil {
+		// The cluster's capacity is either made up of instance groups or
+		// instance fleets, but never both
+		capacityType := "emr-instance-group"
+
+		if awsItem.InstanceCollectionType == types.InstanceCollectionTypeInstanceFleet {
+			capacityType = "emr-instance-fleet"
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   capacityType,
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Tightly coupled, the instances are the cluster's capacity
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, role := range []*string{awsItem.ServiceRole, awsItem.AutoScalingRole} {
+		if role != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*role, scope))
+		}
+	}
+
+	if awsItem.SecurityConfiguration != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "emr-security-configuration",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.SecurityConfiguration,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The configuration controls encryption and authentication
+				In: true,
+				// The cluster can't affect the configuration
+				Out: false,
+			},
+		})
+	}
+
+	if attrs := awsItem.Ec2InstanceAttributes; attrs != nil {
+		if attrs.IamInstanceProfile != nil {
+			// This is the job flow role, which is actually an instance
+			// profile and can be a name or ARN
+			query := &sdp.Query{
+				Type:   "iam-instance-profile",
+				Method: sdp.QueryMethod_GET,
+				Query:  *attrs.IamInstanceProfile,
+			}
+
+			if a, err := adapterhelpers.ParseARN(*attrs.IamInstanceProfile); err == nil {
+				query.Method = sdp.QueryMethod_SEARCH
+				query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+			} else {
+				accountID, _, _ := adapterhelpers.ParseScope(scope)
+				query.Scope = adapterhelpers.FormatScope(accountID, "")
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: query,
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the profile affect what the instances can
+					// access
+					In: true,
+					// The cluster can't affect the profile
+					Out: false,
+				},
+			})
+		}
+
+		// The subnet that the cluster was launched in is normally one of
+		// the requested ones
+		subnets := make([]string, 0, len(attrs.RequestedEc2SubnetIds)+1)
+
+		if attrs.Ec2SubnetId != nil {
+			subnets = append(subnets, *attrs.Ec2SubnetId)
+		}
+
+		for _, subnet := range attrs.RequestedEc2SubnetIds {
+			if attrs.Ec2SubnetId == nil || subnet != *attrs.Ec2SubnetId {
+				subnets = append(subnets, subnet)
+			}
+		}
+
+		for _, subnet := range subnets {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet will affect the cluster
+					In: true,
+					// The cluster shouldn't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		// The EMR managed groups are often also added as additional groups
+		securityGroups := make([]string, 0)
+		seen := make(map[string]bool)
+
+		for _, group := range []*string{attrs.EmrManagedMasterSecurityGroup, attrs.EmrManagedSlaveSecurityGroup, attrs.ServiceAccessSecurityGroup} {
+			if group != nil && !seen[*group] {
+				seen[*group] = true
+				securityGroups = append(securityGroups, *group)
+			}
+		}
+
+		for _, groups := range [][]string{attrs.AdditionalMasterSecurityGroups, attrs.AdditionalSlaveSecurityGroups} {
+			for _, group := range groups {
+				if !seen[group] {
+					seen[group] = true
+					securityGroups = append(securityGroups, group)
+				}
+			}
+		}
+
+		for _, group := range securityGroups {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  group,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the security group will affect the cluster
+					In: true,
+					// The cluster shouldn't affect the security group
+					Out: false,
+				},
+			})
+		}
+
+		if attrs.Ec2KeyName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-key-pair",
+					Method: sdp.QueryMethod_GET,
+					Query:  *attrs.Ec2KeyName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The key pair is installed on the instances
+					In: true,
+					// The cluster can't affect the key pair
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.CustomAmiId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, emrImageLink(*awsItem.CustomAmiId, scope))
+	}
+
+	if awsItem.LogUri != nil {
+		link := s3BucketLink(*awsItem.LogUri, scope, &sdp.BlastPropagation{
+			// Logs can't be written if the bucket is removed
+			In: true,
+			// The cluster writes logs to the bucket
+			Out: true,
+		})
+
+		if link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if awsItem.LogEncryptionKmsKeyId != nil {
+		// Used to encrypt the logs that are written to S3
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.LogEncryptionKmsKeyId, scope))
+	}
+
+	if awsItem.MasterPublicDnsName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.MasterPublicDnsName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS always links
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewEMRClusterAdapter(client EMRClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Cluster, EMRClient, *emr.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Cluster, EMRClient, *emr.Options]{
+		ItemType:        "emr-cluster",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: emrClusterAdapterMetadata,
+		GetFunc:         emrClusterGetFunc,
+		ListFunc:        emrClusterListFunc,
+		ItemMapper:      emrClusterItemMapper,
+	}
+}
+
+var emrClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "emr-cluster",
+	DescriptiveName: "EMR Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an EMR cluster by ID",
+		ListDescription:   "List all active EMR clusters",
+		SearchDescription: "Search for EMR clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_emr_cluster.id"},
+	},
+	PotentialLinks: []string{
+		"emr-instance-group",
+		"emr-instance-fleet",
+		"emr-security-configuration",
+		"iam-role",
+		"iam-instance-profile",
+		"ec2-subnet",
+		"ec2-security-group",
+		"ec2-key-pair",
+		"ec2-image",
+		"s3-bucket",
+		"kms-key",
+		"dns",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
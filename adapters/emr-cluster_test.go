@@ -0,0 +1,256 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var emrDescribeClusterOutput = emr.DescribeClusterOutput{
+	Cluster: &types.Cluster{
+		Id:                     adapterhelpers.PtrString("j-2AXXXXXXGAPLF"),
+		Name:                   adapterhelpers.PtrString("spark-adhoc"),
+		InstanceCollectionType: types.InstanceCollectionTypeInstanceFleet,
+		Status: &types.ClusterStatus{
+			State: types.ClusterStateRunning,
+		},
+	},
+}
+
+func TestEMRClusterItemMapper(t *testing.T) {
+	cluster := types.Cluster{
+		Id:                     adapterhelpers.PtrString("j-2AXXXXXXGAPLF"),
+		Name:                   adapterhelpers.PtrString("spark-nightly"),
+		ClusterArn:             adapterhelpers.PtrString("arn:aws:elasticmapreduce:eu-west-1:123456789012:cluster/j-2AXXXXXXGAPLF"),
+		ReleaseLabel:           adapterhelpers.PtrString("emr-7.1.0"),
+		InstanceCollectionType: types.InstanceCollectionTypeInstanceGroup,
+		ServiceRole:            adapterhelpers.PtrString("EMR_DefaultRole"),
+		AutoScalingRole:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/EMR_AutoScaling_DefaultRole"),
+		SecurityConfiguration:  adapterhelpers.PtrString("encrypted"),
+		CustomAmiId:            adapterhelpers.PtrString("ami-0123456789abcdef0"),
+		LogUri:                 adapterhelpers.PtrString("s3n://aws-logs-123456789012-eu-west-1/elasticmapreduce/"),
+		LogEncryptionKmsKeyId:  adapterhelpers.PtrString("arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+		MasterPublicDnsName:    adapterhelpers.PtrString("ec2-3-250-1-1.eu-west-1.compute.amazonaws.com"),
+		Status: &types.ClusterStatus{
+			State: types.ClusterStateWaiting,
+			Timeline: &types.ClusterTimeline{
+				CreationDateTime: adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+		Ec2InstanceAttributes: &types.Ec2InstanceAttributes{
+			Ec2KeyName:                     adapterhelpers.PtrString("data-eng"),
+			Ec2SubnetId:                    adapterhelpers.PtrString("subnet-0a1b2c3d"),
+			RequestedEc2SubnetIds:          []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"},
+			IamInstanceProfile:             adapterhelpers.PtrString("EMR_EC2_DefaultRole"),
+			EmrManagedMasterSecurityGroup:  adapterhelpers.PtrString("sg-0master"),
+			EmrManagedSlaveSecurityGroup:   adapterhelpers.PtrString("sg-0slave"),
+			ServiceAccessSecurityGroup:     adapterhelpers.PtrString("sg-0service"),
+			AdditionalMasterSecurityGroups: []string{"sg-0master", "sg-0bastion"},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("team"),
+				Value: adapterhelpers.PtrString("data"),
+			},
+		},
+	}
+
+	item, err := emrClusterItemMapper("", "123456789012.eu-west-1", &cluster)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected tag team=data, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "emr-instance-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "j-2AXXXXXXGAPLF",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "EMR_DefaultRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/EMR_AutoScaling_DefaultRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "emr-security-configuration",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "encrypted",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-instance-profile",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "EMR_EC2_DefaultRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0master",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0slave",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0service",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0bastion",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-key-pair",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "data-eng",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "aws-logs-123456789012-eu-west-1",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "ec2-3-250-1-1.eu-west-1.compute.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The security groups that are repeated should only be linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v linked items, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestEMRClusterHealth(t *testing.T) {
+	tests := map[types.ClusterState]sdp.Health{
+		types.ClusterStateRunning:              sdp.Health_HEALTH_OK,
+		types.ClusterStateBootstrapping:        sdp.Health_HEALTH_PENDING,
+		types.ClusterStateTerminatedWithErrors: sdp.Health_HEALTH_ERROR,
+	}
+
+	for state, expected := range tests {
+		item, err := emrClusterItemMapper("", "123456789012.eu-west-1", &types.Cluster{
+			Id: adapterhelpers.PtrString("j-2AXXXXXXGAPLF"),
+			Status: &types.ClusterStatus{
+				State: state,
+			},
+		})
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if item.GetHealth() != expected {
+			t.Errorf("expected health %v for state %v, got %v", expected, state, item.GetHealth())
+		}
+	}
+}
+
+func TestEMRClusterGetFunc(t *testing.T) {
+	client := EMRTestClient{
+		DescribeClusterOutput: &emrDescribeClusterOutput,
+	}
+
+	cluster, err := emrClusterGetFunc(context.Background(), client, "123456789012.eu-west-1", "j-2AXXXXXXGAPLF")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cluster.InstanceCollectionType != types.InstanceCollectionTypeInstanceFleet {
+		t.Errorf("expected instance fleet collection type, got %v", cluster.InstanceCollectionType)
+	}
+
+	item, err := emrClusterItemMapper("", "123456789012.eu-west-1", cluster)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "emr-instance-fleet",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "j-2AXXXXXXGAPLF",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}.Execute(t, item)
+}
+
+func TestNewEMRClusterAdapter(t *testing.T) {
+	client, account, region := emrGetAutoConfig(t)
+
+	adapter := NewEMRClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
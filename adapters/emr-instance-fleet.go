@@ -0,0 +1,192 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// EMRInstanceFleetDetails An instance fleet along with the cluster it belongs
+// to and the instances that are running in it
+type EMRInstanceFleetDetails struct {
+	ClusterId     string
+	InstanceFleet types.InstanceFleet
+	Instances     []types.Instance
+}
+
+// emrInstanceFleetGetFunc Gets an instance fleet by its unique name:
+// {clusterId}/{instanceFleetId}. Instance fleets can't be described directly
+// so we have to list the fleets in the cluster
+func emrInstanceFleetGetFunc(ctx context.Context, client EMRClient, scope, query string) (*EMRInstanceFleetDetails, error) {
+	clusterID, fleetID, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {clusterId}/{instanceFleetId}",
+		}
+	}
+
+	fleets, err := emrInstanceFleetSearchFunc(ctx, client, scope, clusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fleet := range fleets {
+		if fleet.InstanceFleet.Id != nil && *fleet.InstanceFleet.Id == fleetID {
+			return fleet, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "instance fleet " + fleetID + " not found in cluster " + clusterID,
+	}
+}
+
+// emrInstanceFleetSearchFunc Lists all instance fleets in a cluster
+func emrInstanceFleetSearchFunc(ctx context.Context, client EMRClient, scope, query string) ([]*EMRInstanceFleetDetails, error) {
+	paginator := emr.NewListInstanceFleetsPaginator(client, &emr.ListInstanceFleetsInput{
+		ClusterId: &query,
+	})
+
+	fleets := make([]*EMRInstanceFleetDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, fleet := range out.InstanceFleets {
+			if fleet.Id == nil {
+				continue
+			}
+
+			instances, err := emrListInstances(ctx, client, &emr.ListInstancesInput{
+				ClusterId:       &query,
+				InstanceFleetId: fleet.Id,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			fleets = append(fleets, &EMRInstanceFleetDetails{
+				ClusterId:     query,
+				InstanceFleet: fleet,
+				Instances:     instances,
+			})
+		}
+	}
+
+	return fleets, nil
+}
+
+func emrInstanceFleetItemMapper(_, scope string, awsItem *EMRInstanceFleetDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.InstanceFleet
+		ClusterId string
+		Instances []types.Instance
+	}{
+		InstanceFleet: awsItem.InstanceFleet,
+		ClusterId:     awsItem.ClusterId,
+		Instances:     awsItem.Instances,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.InstanceFleet.Id == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "instance fleet is missing its ID",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {clusterId}/{instanceFleetId}
+	if err = attributes.Set("UniqueName", awsItem.ClusterId+"/"+*awsItem.InstanceFleet.Id); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "emr-instance-fleet",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			emrClusterLink(awsItem.ClusterId, scope),
+		},
+	}
+
+	if awsItem.InstanceFleet.Status != nil {
+		switch awsItem.InstanceFleet.Status.State {
+		case types.InstanceFleetStateRunning:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.InstanceFleetStateProvisioning, types.InstanceFleetStateBootstrapping, types.InstanceFleetStateResizing, types.InstanceFleetStateTerminating:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.InstanceFleetStateSuspended:
+			// Resizing has been suspended after repeated failures
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.InstanceFleetStateTerminated:
+			item.Health = nil
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, emrInstanceLinks(awsItem.Instances, scope)...)
+
+	// Each instance type in the fleet can use a different AMI
+	seen := make(map[string]bool)
+
+	for _, spec := range awsItem.InstanceFleet.InstanceTypeSpecifications {
+		if spec.CustomAmiId != nil && !seen[*spec.CustomAmiId] {
+			seen[*spec.CustomAmiId] = true
+			item.LinkedItemQueries = append(item.LinkedItemQueries, emrImageLink(*spec.CustomAmiId, scope))
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEMRInstanceFleetAdapter(client EMRClient, accountID string, region string) *adapterhelpers.GetListAdapter[*EMRInstanceFleetDetails, EMRClient, *emr.Options] {
+	return &adapterhelpers.GetListAdapter[*EMRInstanceFleetDetails, EMRClient, *emr.Options]{
+		ItemType:        "emr-instance-fleet",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: emrInstanceFleetAdapterMetadata,
+		GetFunc:         emrInstanceFleetGetFunc,
+		// Instance fleets can only be listed per cluster
+		DisableList: true,
+		SearchFunc:  emrInstanceFleetSearchFunc,
+		ItemMapper:  emrInstanceFleetItemMapper,
+	}
+}
+
+var emrInstanceFleetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "emr-instance-fleet",
+	DescriptiveName: "EMR Instance Fleet",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an instance fleet by {clusterId}/{instanceFleetId}",
+		SearchDescription: "Search for instance fleets by cluster ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_emr_instance_fleet.cluster_id",
+		},
+	},
+	PotentialLinks: []string{"emr-cluster", "ec2-instance", "ec2-image"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
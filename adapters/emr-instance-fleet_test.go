@@ -0,0 +1,140 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEMRInstanceFleetSearchFunc(t *testing.T) {
+	client := EMRTestClient{
+		ListInstanceFleetsOutput: &emr.ListInstanceFleetsOutput{
+			InstanceFleets: []types.InstanceFleet{
+				{
+					Id:                     adapterhelpers.PtrString("if-1CORE"),
+					Name:                   adapterhelpers.PtrString("Core"),
+					InstanceFleetType:      types.InstanceFleetTypeCore,
+					TargetOnDemandCapacity: adapterhelpers.PtrInt32(2),
+					TargetSpotCapacity:     adapterhelpers.PtrInt32(4),
+					InstanceTypeSpecifications: []types.InstanceTypeSpecification{
+						{
+							InstanceType: adapterhelpers.PtrString("m5.xlarge"),
+							CustomAmiId:  adapterhelpers.PtrString("ami-0123456789abcdef0"),
+						},
+						{
+							InstanceType: adapterhelpers.PtrString("m5a.xlarge"),
+							CustomAmiId:  adapterhelpers.PtrString("ami-0123456789abcdef0"),
+						},
+						{
+							InstanceType: adapterhelpers.PtrString("m6g.xlarge"),
+							CustomAmiId:  adapterhelpers.PtrString("ami-0fedcba9876543210"),
+						},
+					},
+					Status: &types.InstanceFleetStatus{
+						State: types.InstanceFleetStateResizing,
+					},
+				},
+			},
+		},
+		ListInstancesOutput: &emr.ListInstancesOutput{
+			Instances: []types.Instance{
+				{
+					Id:            adapterhelpers.PtrString("ci-1ABCDEF"),
+					Ec2InstanceId: adapterhelpers.PtrString("i-0123456789abcdef0"),
+				},
+				{
+					Id:            adapterhelpers.PtrString("ci-2ABCDEF"),
+					Ec2InstanceId: adapterhelpers.PtrString("i-0fedcba9876543210"),
+				},
+			},
+		},
+	}
+
+	fleets, err := emrInstanceFleetSearchFunc(context.Background(), client, "123456789012.eu-west-1", "j-2AXXXXXXGAPLF")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(fleets) != 1 {
+		t.Fatalf("expected 1 instance fleet, got %v", len(fleets))
+	}
+
+	item, err := emrInstanceFleetItemMapper("", "123456789012.eu-west-1", fleets[0])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "j-2AXXXXXXGAPLF/if-1CORE" {
+		t.Errorf("expected unique attribute value j-2AXXXXXXGAPLF/if-1CORE, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "emr-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "j-2AXXXXXXGAPLF",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0fedcba9876543210",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0fedcba9876543210",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The AMI shared by two instance types should only be linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v linked items, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewEMRInstanceFleetAdapter(t *testing.T) {
+	client, account, region := emrGetAutoConfig(t)
+
+	adapter := NewEMRInstanceFleetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
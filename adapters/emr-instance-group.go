@@ -0,0 +1,189 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// EMRInstanceGroupDetails An instance group along with the cluster it belongs
+// to and the instances that are running in it
+type EMRInstanceGroupDetails struct {
+	ClusterId     string
+	InstanceGroup types.InstanceGroup
+	Instances     []types.Instance
+}
+
+// emrInstanceGroupGetFunc Gets an instance group by its unique name:
+// {clusterId}/{instanceGroupId}. Instance groups can't be described directly
+// so we have to list the groups in the cluster
+func emrInstanceGroupGetFunc(ctx context.Context, client EMRClient, scope, query string) (*EMRInstanceGroupDetails, error) {
+	clusterID, groupID, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {clusterId}/{instanceGroupId}",
+		}
+	}
+
+	groups, err := emrInstanceGroupSearchFunc(ctx, client, scope, clusterID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, group := range groups {
+		if group.InstanceGroup.Id != nil && *group.InstanceGroup.Id == groupID {
+			return group, nil
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "instance group " + groupID + " not found in cluster " + clusterID,
+	}
+}
+
+// emrInstanceGroupSearchFunc Lists all instance groups in a cluster
+func emrInstanceGroupSearchFunc(ctx context.Context, client EMRClient, scope, query string) ([]*EMRInstanceGroupDetails, error) {
+	paginator := emr.NewListInstanceGroupsPaginator(client, &emr.ListInstanceGroupsInput{
+		ClusterId: &query,
+	})
+
+	groups := make([]*EMRInstanceGroupDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range out.InstanceGroups {
+			if group.Id == nil {
+				continue
+			}
+
+			instances, err := emrListInstances(ctx, client, &emr.ListInstancesInput{
+				ClusterId:       &query,
+				InstanceGroupId: group.Id,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			groups = append(groups, &EMRInstanceGroupDetails{
+				ClusterId:     query,
+				InstanceGroup: group,
+				Instances:     instances,
+			})
+		}
+	}
+
+	return groups, nil
+}
+
+func emrInstanceGroupItemMapper(_, scope string, awsItem *EMRInstanceGroupDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.InstanceGroup
+		ClusterId string
+		Instances []types.Instance
+	}{
+		InstanceGroup: awsItem.InstanceGroup,
+		ClusterId:     awsItem.ClusterId,
+		Instances:     awsItem.Instances,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.InstanceGroup.Id == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "instance group is missing its ID",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {clusterId}/{instanceGroupId}
+	if err = attributes.Set("UniqueName", awsItem.ClusterId+"/"+*awsItem.InstanceGroup.Id); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "emr-instance-group",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			emrClusterLink(awsItem.ClusterId, scope),
+		},
+	}
+
+	if awsItem.InstanceGroup.Status != nil {
+		switch awsItem.InstanceGroup.Status.State {
+		case types.InstanceGroupStateRunning:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.InstanceGroupStateProvisioning, types.InstanceGroupStateBootstrapping, types.InstanceGroupStateReconfiguring, types.InstanceGroupStateResizing, types.InstanceGroupStateTerminating, types.InstanceGroupStateShuttingDown:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.InstanceGroupStateSuspended:
+			// Resizing has been suspended after repeated failures
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		case types.InstanceGroupStateArrested:
+			// The group couldn't provision the instances it needs
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case types.InstanceGroupStateTerminated, types.InstanceGroupStateEnded:
+			item.Health = nil
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, emrInstanceLinks(awsItem.Instances, scope)...)
+
+	if awsItem.InstanceGroup.CustomAmiId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, emrImageLink(*awsItem.InstanceGroup.CustomAmiId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewEMRInstanceGroupAdapter(client EMRClient, accountID string, region string) *adapterhelpers.GetListAdapter[*EMRInstanceGroupDetails, EMRClient, *emr.Options] {
+	return &adapterhelpers.GetListAdapter[*EMRInstanceGroupDetails, EMRClient, *emr.Options]{
+		ItemType:        "emr-instance-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: emrInstanceGroupAdapterMetadata,
+		GetFunc:         emrInstanceGroupGetFunc,
+		// Instance groups can only be listed per cluster
+		DisableList: true,
+		SearchFunc:  emrInstanceGroupSearchFunc,
+		ItemMapper:  emrInstanceGroupItemMapper,
+	}
+}
+
+var emrInstanceGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "emr-instance-group",
+	DescriptiveName: "EMR Instance Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an instance group by {clusterId}/{instanceGroupId}",
+		SearchDescription: "Search for instance groups by cluster ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_emr_instance_group.cluster_id",
+		},
+	},
+	PotentialLinks: []string{"emr-cluster", "ec2-instance", "ec2-image"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
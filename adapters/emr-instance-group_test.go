@@ -0,0 +1,153 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEMRInstanceGroupSearchFunc(t *testing.T) {
+	client := EMRTestClient{
+		ListInstanceGroupsOutput: &emr.ListInstanceGroupsOutput{
+			InstanceGroups: []types.InstanceGroup{
+				{
+					Id:                adapterhelpers.PtrString("ig-1MASTER"),
+					Name:              adapterhelpers.PtrString("Master"),
+					InstanceGroupType: types.InstanceGroupTypeMaster,
+					InstanceType:      adapterhelpers.PtrString("m5.xlarge"),
+					CustomAmiId:       adapterhelpers.PtrString("ami-0123456789abcdef0"),
+					Status: &types.InstanceGroupStatus{
+						State: types.InstanceGroupStateRunning,
+					},
+				},
+				{
+					Id:                adapterhelpers.PtrString("ig-2CORE"),
+					Name:              adapterhelpers.PtrString("Core"),
+					InstanceGroupType: types.InstanceGroupTypeCore,
+					Status: &types.InstanceGroupStatus{
+						State: types.InstanceGroupStateArrested,
+					},
+				},
+			},
+		},
+		ListInstancesOutput: &emr.ListInstancesOutput{
+			Instances: []types.Instance{
+				{
+					Id:            adapterhelpers.PtrString("ci-1ABCDEF"),
+					Ec2InstanceId: adapterhelpers.PtrString("i-0123456789abcdef0"),
+				},
+			},
+		},
+	}
+
+	groups, err := emrInstanceGroupSearchFunc(context.Background(), client, "123456789012.eu-west-1", "j-2AXXXXXXGAPLF")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(groups) != 2 {
+		t.Fatalf("expected 2 instance groups, got %v", len(groups))
+	}
+
+	item, err := emrInstanceGroupItemMapper("", "123456789012.eu-west-1", groups[0])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "j-2AXXXXXXGAPLF/ig-1MASTER" {
+		t.Errorf("expected unique attribute value j-2AXXXXXXGAPLF/ig-1MASTER, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "emr-cluster",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "j-2AXXXXXXGAPLF",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-image",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ami-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	item, err = emrInstanceGroupItemMapper("", "123456789012.eu-west-1", groups[1])
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+}
+
+func TestEMRInstanceGroupGetFunc(t *testing.T) {
+	client := EMRTestClient{
+		ListInstanceGroupsOutput: &emr.ListInstanceGroupsOutput{
+			InstanceGroups: []types.InstanceGroup{
+				{Id: adapterhelpers.PtrString("ig-1MASTER")},
+				{Id: adapterhelpers.PtrString("ig-2CORE")},
+			},
+		},
+		ListInstancesOutput: &emr.ListInstancesOutput{},
+	}
+
+	group, err := emrInstanceGroupGetFunc(context.Background(), client, "123456789012.eu-west-1", "j-2AXXXXXXGAPLF/ig-2CORE")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *group.InstanceGroup.Id != "ig-2CORE" {
+		t.Errorf("expected instance group ig-2CORE, got %v", *group.InstanceGroup.Id)
+	}
+
+	if _, err = emrInstanceGroupGetFunc(context.Background(), client, "123456789012.eu-west-1", "j-2AXXXXXXGAPLF/ig-3TASK"); err == nil {
+		t.Error("expected an error for a missing instance group")
+	}
+
+	if _, err = emrInstanceGroupGetFunc(context.Background(), client, "123456789012.eu-west-1", "ig-2CORE"); err == nil {
+		t.Error("expected an error for a query without a cluster ID")
+	}
+}
+
+func TestNewEMRInstanceGroupAdapter(t *testing.T) {
+	client, account, region := emrGetAutoConfig(t)
+
+	adapter := NewEMRInstanceGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
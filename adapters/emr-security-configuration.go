@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// emrSecurityConfigurationDocument The parts of a security configuration's
+// JSON document that reference other resources
+type emrSecurityConfigurationDocument struct {
+	EncryptionConfiguration struct {
+		AtRestEncryptionConfiguration struct {
+			S3EncryptionConfiguration struct {
+				EncryptionMode string
+				AwsKmsKey      string
+			}
+			LocalDiskEncryptionConfiguration struct {
+				EncryptionKeyProviderType string
+				AwsKmsKey                 string
+			}
+		}
+		InTransitEncryptionConfiguration struct {
+			TLSCertificateConfiguration struct {
+				CertificateProviderType string
+				S3Object                string
+			}
+		}
+	}
+}
+
+func emrSecurityConfigurationGetFunc(ctx context.Context, client EMRClient, scope, query string) (*emr.DescribeSecurityConfigurationOutput, error) {
+	return client.DescribeSecurityConfiguration(ctx, &emr.DescribeSecurityConfigurationInput{
+		Name: &query,
+	})
+}
+
+func emrSecurityConfigurationListFunc(ctx context.Context, client EMRClient, scope string) ([]*emr.DescribeSecurityConfigurationOutput, error) {
+	paginator := emr.NewListSecurityConfigurationsPaginator(client, &emr.ListSecurityConfigurationsInput{})
+
+	configs := make([]*emr.DescribeSecurityConfigurationOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the configuration itself
+		for _, summary := range out.SecurityConfigurations {
+			if summary.Name == nil {
+				continue
+			}
+
+			config, err := emrSecurityConfigurationGetFunc(ctx, client, scope, *summary.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			configs = append(configs, config)
+		}
+	}
+
+	return configs, nil
+}
+
+func emrSecurityConfigurationItemMapper(_, scope string, awsItem *emr.DescribeSecurityConfigurationOutput) (*sdp.Item, error) {
+	// The configuration is a JSON document, parse it so that its contents
+	// can be queried rather than being a single string
+	var raw map[string]interface{}
+	var document emrSecurityConfigurationDocument
+
+	if awsItem.SecurityConfiguration != nil {
+		if err := json.Unmarshal([]byte(*awsItem.SecurityConfiguration), &raw); err != nil {
+			return nil, err
+		}
+
+		if err := json.Unmarshal([]byte(*awsItem.SecurityConfiguration), &document); err != nil {
+			return nil, err
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		Name                  *string
+		CreationDateTime      *time.Time
+		SecurityConfiguration map[string]interface{}
+	}{
+		Name:                  awsItem.Name,
+		CreationDateTime:      awsItem.CreationDateTime,
+		SecurityConfiguration: raw,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "emr-security-configuration",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	atRest := document.EncryptionConfiguration.AtRestEncryptionConfiguration
+
+	// Used for EMRFS data in S3, and for the instances' local disks
+	for _, key := range []string{atRest.S3EncryptionConfiguration.AwsKmsKey, atRest.LocalDiskEncryptionConfiguration.AwsKmsKey} {
+		if key != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(key, scope))
+		}
+	}
+
+	// PEM certificates for in-transit encryption are provided as a zip in S3
+	if certificates := document.EncryptionConfiguration.InTransitEncryptionConfiguration.TLSCertificateConfiguration.S3Object; certificates != "" {
+		link := s3BucketLink(certificates, scope, &sdp.BlastPropagation{
+			// Clusters can't start if the certificates are removed
+			In: true,
+			// The configuration can't affect the bucket
+			Out: false,
+		})
+
+		if link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEMRSecurityConfigurationAdapter(client EMRClient, accountID string, region string) *adapterhelpers.GetListAdapter[*emr.DescribeSecurityConfigurationOutput, EMRClient, *emr.Options] {
+	return &adapterhelpers.GetListAdapter[*emr.DescribeSecurityConfigurationOutput, EMRClient, *emr.Options]{
+		ItemType:        "emr-security-configuration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: emrSecurityConfigurationAdapterMetadata,
+		GetFunc:         emrSecurityConfigurationGetFunc,
+		ListFunc:        emrSecurityConfigurationListFunc,
+		ItemMapper:      emrSecurityConfigurationItemMapper,
+	}
+}
+
+var emrSecurityConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "emr-security-configuration",
+	DescriptiveName: "EMR Security Configuration",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		// Security configurations don't have ARNs
+		Get:             true,
+		List:            true,
+		GetDescription:  "Get a security configuration by name",
+		ListDescription: "List all security configurations",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_emr_security_configuration.name"},
+	},
+	PotentialLinks: []string{"kms-key", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestEMRSecurityConfigurationItemMapper(t *testing.T) {
+	output := emr.DescribeSecurityConfigurationOutput{
+		Name:             adapterhelpers.PtrString("encrypted"),
+		CreationDateTime: adapterhelpers.PtrTime(time.Now()),
+		SecurityConfiguration: adapterhelpers.PtrString(`{
+			"EncryptionConfiguration": {
+				"EnableInTransitEncryption": true,
+				"EnableAtRestEncryption": true,
+				"InTransitEncryptionConfiguration": {
+					"TLSCertificateConfiguration": {
+						"CertificateProviderType": "PEM",
+						"S3Object": "s3://emr-config-bucket/certs/my-certs.zip"
+					}
+				},
+				"AtRestEncryptionConfiguration": {
+					"S3EncryptionConfiguration": {
+						"EncryptionMode": "SSE-KMS",
+						"AwsKmsKey": "arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"
+					},
+					"LocalDiskEncryptionConfiguration": {
+						"EncryptionKeyProviderType": "AwsKms",
+						"AwsKmsKey": "alias/emr-local-disk"
+					}
+				}
+			}
+		}`),
+	}
+
+	item, err := emrSecurityConfigurationItemMapper("", "123456789012.eu-west-1", &output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "encrypted" {
+		t.Errorf("expected unique attribute value encrypted, got %v", item.UniqueAttributeValue())
+	}
+
+	// The document should be parsed rather than stored as a string
+	mode, err := item.GetAttributes().Get("SecurityConfiguration.EncryptionConfiguration.AtRestEncryptionConfiguration.S3EncryptionConfiguration.EncryptionMode")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if mode != "SSE-KMS" {
+		t.Errorf("expected encryption mode SSE-KMS, got %v", mode)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "alias/emr-local-disk",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "emr-config-bucket",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewEMRSecurityConfigurationAdapter(t *testing.T) {
+	client, account, region := emrGetAutoConfig(t)
+
+	adapter := NewEMRSecurityConfigurationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
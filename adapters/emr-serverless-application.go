@@ -0,0 +1,272 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func emrServerlessApplicationGetFunc(ctx context.Context, client EMRServerlessClient, scope, query string) (*types.Application, error) {
+	out, err := client.GetApplication(ctx, &emrserverless.GetApplicationInput{
+		ApplicationId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Application == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "application was nil",
+		}
+	}
+
+	return out.Application, nil
+}
+
+func emrServerlessApplicationListFunc(ctx context.Context, client EMRServerlessClient, scope string) ([]*types.Application, error) {
+	paginator := emrserverless.NewListApplicationsPaginator(client, &emrserverless.ListApplicationsInput{})
+
+	applications := make([]*types.Application, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the network or image configuration
+		for _, summary := range out.Applications {
+			if summary.Id == nil {
+				continue
+			}
+
+			application, err := emrServerlessApplicationGetFunc(ctx, client, scope, *summary.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			applications = append(applications, application)
+		}
+	}
+
+	return applications, nil
+}
+
+// emrServerlessApplicationSearchFunc Searches for an application by ARN. The
+// ARN is in the format
+// arn:aws:emr-serverless:region:account:/applications/{applicationId} which
+// the default ARN handling doesn't support because of the leading slash
+func emrServerlessApplicationSearchFunc(ctx context.Context, client EMRServerlessClient, scope, query string) ([]*types.Application, error) {
+	a, err := adapterhelpers.ParseARN(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+		}
+	}
+
+	id := a.Resource[strings.LastIndex(a.Resource, "/")+1:]
+
+	application, err := emrServerlessApplicationGetFunc(ctx, client, scope, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*types.Application{application}, nil
+}
+
+func emrServerlessApplicationItemMapper(_, scope string, awsItem *types.Application) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "emr-serverless-application",
+		UniqueAttribute: "ApplicationId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.State {
+	case types.ApplicationStateCreated, types.ApplicationStateStarted, types.ApplicationStateStopped:
+		// Applications stop automatically when idle and start again when a
+		// job is submitted, so being stopped is normal
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ApplicationStateCreating, types.ApplicationStateStarting, types.ApplicationStateStopping:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ApplicationStateTerminated:
+		item.Health = nil
+	}
+
+	if network := awsItem.NetworkConfiguration; network != nil {
+		for _, subnet := range network.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet will affect the application
+					In: true,
+					// The application shouldn't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range network.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  sg,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the security group will affect the application
+					In: true,
+					// The application shouldn't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	// Custom images can be set for the whole application, and overridden for
+	// each worker type
+	images := make([]string, 0)
+
+	if awsItem.ImageConfiguration != nil && awsItem.ImageConfiguration.ImageUri != nil {
+		images = append(images, *awsItem.ImageConfiguration.ImageUri)
+	}
+
+	for _, spec := range awsItem.WorkerTypeSpecifications {
+		if spec.ImageConfiguration != nil && spec.ImageConfiguration.ImageUri != nil {
+			images = append(images, *spec.ImageConfiguration.ImageUri)
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, image := range images {
+		if seen[image] {
+			continue
+		}
+
+		seen[image] = true
+
+		if link := batchImageLink(image); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if monitoring := awsItem.MonitoringConfiguration; monitoring != nil {
+		keys := make([]string, 0)
+
+		if s3 := monitoring.S3MonitoringConfiguration; s3 != nil {
+			if s3.LogUri != nil {
+				link := s3BucketLink(*s3.LogUri, scope, &sdp.BlastPropagation{
+					// Logs can't be written if the bucket is removed
+					In: true,
+					// Job runs write logs to the bucket
+					Out: true,
+				})
+
+				if link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+
+			if s3.EncryptionKeyArn != nil {
+				keys = append(keys, *s3.EncryptionKeyArn)
+			}
+		}
+
+		if cw := monitoring.CloudWatchLoggingConfiguration; cw != nil && cw.Enabled != nil && *cw.Enabled {
+			if cw.LogGroupName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "logs-log-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *cw.LogGroupName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the log group loses the job logs
+						In: true,
+						// Job runs write logs to the group
+						Out: true,
+					},
+				})
+			}
+
+			if cw.EncryptionKeyArn != nil {
+				keys = append(keys, *cw.EncryptionKeyArn)
+			}
+		}
+
+		if managed := monitoring.ManagedPersistenceMonitoringConfiguration; managed != nil && managed.EncryptionKeyArn != nil {
+			keys = append(keys, *managed.EncryptionKeyArn)
+		}
+
+		// The same key is often used for all of the logs
+		seenKeys := make(map[string]bool)
+
+		for _, key := range keys {
+			if !seenKeys[key] {
+				seenKeys[key] = true
+				item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(key, scope))
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewEMRServerlessApplicationAdapter(client EMRServerlessClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Application, EMRServerlessClient, *emrserverless.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Application, EMRServerlessClient, *emrserverless.Options]{
+		ItemType:        "emr-serverless-application",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: emrServerlessApplicationAdapterMetadata,
+		GetFunc:         emrServerlessApplicationGetFunc,
+		ListFunc:        emrServerlessApplicationListFunc,
+		SearchFunc:      emrServerlessApplicationSearchFunc,
+		ItemMapper:      emrServerlessApplicationItemMapper,
+	}
+}
+
+var emrServerlessApplicationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "emr-serverless-application",
+	DescriptiveName: "EMR Serverless Application",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an EMR Serverless application by ID",
+		ListDescription:   "List all EMR Serverless applications",
+		SearchDescription: "Search for EMR Serverless applications by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_emrserverless_application.id"},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group", "ecr-repository", "s3-bucket", "logs-log-group", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var emrServerlessGetApplicationOutput = emrserverless.GetApplicationOutput{
+	Application: &types.Application{
+		ApplicationId: adapterhelpers.PtrString("00f1abcdexample"),
+		Arn:           adapterhelpers.PtrString("arn:aws:emr-serverless:eu-west-1:123456789012:/applications/00f1abcdexample"),
+		Name:          adapterhelpers.PtrString("spark-jobs"),
+		ReleaseLabel:  adapterhelpers.PtrString("emr-7.1.0"),
+		Type:          adapterhelpers.PtrString("Spark"),
+		State:         types.ApplicationStateStopped,
+		CreatedAt:     adapterhelpers.PtrTime(time.Now()),
+		UpdatedAt:     adapterhelpers.PtrTime(time.Now()),
+		NetworkConfiguration: &types.NetworkConfiguration{
+			SubnetIds:        []string{"subnet-0a1b2c3d"},
+			SecurityGroupIds: []string{"sg-0a1b2c3d"},
+		},
+		ImageConfiguration: &types.ImageConfiguration{
+			ImageUri: adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-1.amazonaws.com/spark-custom:latest"),
+		},
+		WorkerTypeSpecifications: map[string]types.WorkerTypeSpecification{
+			"Driver": {
+				ImageConfiguration: &types.ImageConfiguration{
+					ImageUri: adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-1.amazonaws.com/spark-custom:latest"),
+				},
+			},
+		},
+		MonitoringConfiguration: &types.MonitoringConfiguration{
+			S3MonitoringConfiguration: &types.S3MonitoringConfiguration{
+				LogUri:           adapterhelpers.PtrString("s3://emr-serverless-logs/spark-jobs/"),
+				EncryptionKeyArn: adapterhelpers.PtrString("arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+			},
+			CloudWatchLoggingConfiguration: &types.CloudWatchLoggingConfiguration{
+				Enabled:          adapterhelpers.PtrBool(true),
+				LogGroupName:     adapterhelpers.PtrString("/aws/emr-serverless"),
+				EncryptionKeyArn: adapterhelpers.PtrString("arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+			},
+		},
+		Tags: map[string]string{
+			"team": "data",
+		},
+	},
+}
+
+func TestEMRServerlessApplicationItemMapper(t *testing.T) {
+	item, err := emrServerlessApplicationItemMapper("", "123456789012.eu-west-1", emrServerlessGetApplicationOutput.Application)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "00f1abcdexample" {
+		t.Errorf("expected unique attribute value 00f1abcdexample, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "data" {
+		t.Errorf("expected tag team=data, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "spark-custom",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "emr-serverless-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/emr-serverless",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-1:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The repeated image and key should only be linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v linked items, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestEMRServerlessApplicationSearchFunc(t *testing.T) {
+	client := EMRServerlessTestClient{
+		GetApplicationOutput: &emrServerlessGetApplicationOutput,
+	}
+
+	applications, err := emrServerlessApplicationSearchFunc(context.Background(), client, "123456789012.eu-west-1", "arn:aws:emr-serverless:eu-west-1:123456789012:/applications/00f1abcdexample")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(applications) != 1 {
+		t.Fatalf("expected 1 application, got %v", len(applications))
+	}
+
+	if _, err = emrServerlessApplicationSearchFunc(context.Background(), client, "123456789012.eu-west-1", "spark-jobs"); err == nil {
+		t.Error("expected an error for a query that isn't an ARN")
+	}
+}
+
+func TestNewEMRServerlessApplicationAdapter(t *testing.T) {
+	client, account, region := emrserverlessGetAutoConfig(t)
+
+	adapter := NewEMRServerlessApplicationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/aws/aws-sdk-go-v2/service/emr/types"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+type EMRClient interface {
+	DescribeCluster(ctx context.Context, params *emr.DescribeClusterInput, optFns ...func(*emr.Options)) (*emr.DescribeClusterOutput, error)
+	DescribeSecurityConfiguration(ctx context.Context, params *emr.DescribeSecurityConfigurationInput, optFns ...func(*emr.Options)) (*emr.DescribeSecurityConfigurationOutput, error)
+
+	emr.ListClustersAPIClient
+	emr.ListInstanceFleetsAPIClient
+	emr.ListInstanceGroupsAPIClient
+	emr.ListInstancesAPIClient
+	emr.ListSecurityConfigurationsAPIClient
+}
+
+// emrActiveClusterStates The states of clusters that are returned when
+// listing. EMR keeps terminated clusters for two months, these can still be
+// found with a Get but would otherwise swamp the results
+var emrActiveClusterStates = []types.ClusterState{
+	types.ClusterStateStarting,
+	types.ClusterStateBootstrapping,
+	types.ClusterStateRunning,
+	types.ClusterStateWaiting,
+	types.ClusterStateTerminating,
+}
+
+// emrActiveInstanceStates The states of instances that are still running in
+// EC2
+var emrActiveInstanceStates = []types.InstanceState{
+	types.InstanceStateAwaitingFulfillment,
+	types.InstanceStateProvisioning,
+	types.InstanceStateBootstrapping,
+	types.InstanceStateRunning,
+}
+
+func emrTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// emrListClusterIDs Lists the IDs of all active clusters
+func emrListClusterIDs(ctx context.Context, client EMRClient) ([]string, error) {
+	paginator := emr.NewListClustersPaginator(client, &emr.ListClustersInput{
+		ClusterStates: emrActiveClusterStates,
+	})
+
+	ids := make([]string, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Clusters {
+			if summary.Id != nil {
+				ids = append(ids, *summary.Id)
+			}
+		}
+	}
+
+	return ids, nil
+}
+
+// emrListInstances Lists the instances that are running in an instance group
+// or fleet
+func emrListInstances(ctx context.Context, client EMRClient, input *emr.ListInstancesInput) ([]types.Instance, error) {
+	input.InstanceStates = emrActiveInstanceStates
+
+	paginator := emr.NewListInstancesPaginator(client, input)
+
+	instances := make([]types.Instance, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, out.Instances...)
+	}
+
+	return instances, nil
+}
+
+// emrInstanceLinks Links to the EC2 instances that make up an instance group
+// or fleet
+func emrInstanceLinks(instances []types.Instance, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, instance := range instances {
+		if instance.Ec2InstanceId == nil {
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  *instance.Ec2InstanceId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The instances are managed by EMR and do the work for the
+				// cluster
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// emrClusterLink Links to the cluster that an instance group or fleet belongs
+// to
+func emrClusterLink(clusterID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "emr-cluster",
+			Method: sdp.QueryMethod_GET,
+			Query:  clusterID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Tightly coupled, the instances are the cluster's capacity
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+// emrImageLink Links to a custom AMI that instances are launched from
+func emrImageLink(imageID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ec2-image",
+			Method: sdp.QueryMethod_GET,
+			Query:  imageID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// The image is used to launch the instances
+			In: true,
+			// EMR can't affect the image
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/emr"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type EMRTestClient struct {
+	DescribeClusterOutput               *emr.DescribeClusterOutput
+	DescribeSecurityConfigurationOutput *emr.DescribeSecurityConfigurationOutput
+	ListClustersOutput                  *emr.ListClustersOutput
+	ListInstanceFleetsOutput            *emr.ListInstanceFleetsOutput
+	ListInstanceGroupsOutput            *emr.ListInstanceGroupsOutput
+	ListInstancesOutput                 *emr.ListInstancesOutput
+	ListSecurityConfigurationsOutput    *emr.ListSecurityConfigurationsOutput
+}
+
+func (t EMRTestClient) DescribeCluster(context.Context, *emr.DescribeClusterInput, ...func(*emr.Options)) (*emr.DescribeClusterOutput, error) {
+	return t.DescribeClusterOutput, nil
+}
+
+func (t EMRTestClient) DescribeSecurityConfiguration(context.Context, *emr.DescribeSecurityConfigurationInput, ...func(*emr.Options)) (*emr.DescribeSecurityConfigurationOutput, error) {
+	return t.DescribeSecurityConfigurationOutput, nil
+}
+
+func (t EMRTestClient) ListClusters(context.Context, *emr.ListClustersInput, ...func(*emr.Options)) (*emr.ListClustersOutput, error) {
+	return t.ListClustersOutput, nil
+}
+
+func (t EMRTestClient) ListInstanceFleets(context.Context, *emr.ListInstanceFleetsInput, ...func(*emr.Options)) (*emr.ListInstanceFleetsOutput, error) {
+	return t.ListInstanceFleetsOutput, nil
+}
+
+func (t EMRTestClient) ListInstanceGroups(context.Context, *emr.ListInstanceGroupsInput, ...func(*emr.Options)) (*emr.ListInstanceGroupsOutput, error) {
+	return t.ListInstanceGroupsOutput, nil
+}
+
+func (t EMRTestClient) ListInstances(context.Context, *emr.ListInstancesInput, ...func(*emr.Options)) (*emr.ListInstancesOutput, error) {
+	return t.ListInstancesOutput, nil
+}
+
+func (t EMRTestClient) ListSecurityConfigurations(context.Context, *emr.ListSecurityConfigurationsInput, ...func(*emr.Options)) (*emr.ListSecurityConfigurationsOutput, error) {
+	return t.ListSecurityConfigurationsOutput, nil
+}
+
+func emrGetAutoConfig(t *testing.T) (*emr.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := emr.NewFromConfig(config)
+
+	return client, account, region
+}
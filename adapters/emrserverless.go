@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+)
+
+type EMRServerlessClient interface {
+	GetApplication(ctx context.Context, params *emrserverless.GetApplicationInput, optFns ...func(*emrserverless.Options)) (*emrserverless.GetApplicationOutput, error)
+
+	emrserverless.ListApplicationsAPIClient
+}
@@ -0,0 +1,29 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type EMRServerlessTestClient struct {
+	GetApplicationOutput   *emrserverless.GetApplicationOutput
+	ListApplicationsOutput *emrserverless.ListApplicationsOutput
+}
+
+func (t EMRServerlessTestClient) GetApplication(context.Context, *emrserverless.GetApplicationInput, ...func(*emrserverless.Options)) (*emrserverless.GetApplicationOutput, error) {
+	return t.GetApplicationOutput, nil
+}
+
+func (t EMRServerlessTestClient) ListApplications(context.Context, *emrserverless.ListApplicationsInput, ...func(*emrserverless.Options)) (*emrserverless.ListApplicationsOutput, error) {
+	return t.ListApplicationsOutput, nil
+}
+
+func emrserverlessGetAutoConfig(t *testing.T) (*emrserverless.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := emrserverless.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -0,0 +1,690 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	inspectortypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	securityhubtypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+	"github.com/overmindtech/sdpcache"
+)
+
+// How long to wait before trying to load findings again if they couldn't be
+// loaded at all, for example because neither Security Hub nor Inspector is
+// enabled in the region
+const findingsRetryDuration = 5 * time.Minute
+
+// How long loading the findings for a region is allowed to take. Loads aren't
+// tied to the query that started them, so this stops one from hanging forever
+const findingsLoadTimeout = 5 * time.Minute
+
+// securityhubFindingsResourceTypes Maps the Security Hub resource types that we
+// count findings for to the type of item that the counts are reported on
+var securityhubFindingsResourceTypes = map[string]string{
+	"AwsEc2Instance":       "ec2-instance",
+	"AwsEcrContainerImage": "ecr-image",
+	"AwsS3Bucket":          "s3-bucket",
+	"AwsIamRole":           "iam-role",
+	"AwsIamUser":           "iam-user",
+	"AwsLambdaFunction":    "lambda-function",
+}
+
+// inspectorFindingsResourceTypes Maps the Inspector resource types that we
+// count findings for to the type of item that the counts are reported on
+var inspectorFindingsResourceTypes = map[inspectortypes.ResourceType]string{
+	inspectortypes.ResourceTypeAwsEc2Instance:       "ec2-instance",
+	inspectortypes.ResourceTypeAwsEcrContainerImage: "ecr-image",
+	inspectortypes.ResourceTypeAwsLambdaFunction:    "lambda-function",
+}
+
+// FindingsCount The number of open findings for a resource, by severity
+type FindingsCount struct {
+	Critical      int
+	High          int
+	Medium        int
+	Low           int
+	Informational int
+}
+
+// Total The total number of open findings
+func (f FindingsCount) Total() int {
+	return f.Critical + f.High + f.Medium + f.Low + f.Informational
+}
+
+// add Counts a finding with the given severity. Anything that isn't a known
+// severity, such as Inspector's UNTRIAGED, is counted as informational
+func (f *FindingsCount) add(severity string) {
+	switch severity {
+	case "CRITICAL":
+		f.Critical++
+	case "HIGH":
+		f.High++
+	case "MEDIUM":
+		f.Medium++
+	case "LOW":
+		f.Low++
+	default:
+		f.Informational++
+	}
+}
+
+// findingsCounts Open findings counts indexed by item type, then by the unique
+// attribute value of the item
+type findingsCounts map[string]map[string]*FindingsCount
+
+// add Counts a finding against a resource
+func (c findingsCounts) add(itemType string, resourceID string, severity string) {
+	name := findingsResourceName(resourceID)
+
+	if c[itemType] == nil {
+		c[itemType] = make(map[string]*FindingsCount)
+	}
+
+	if c[itemType][name] == nil {
+		c[itemType][name] = &FindingsCount{}
+	}
+
+	c[itemType][name].add(severity)
+}
+
+// merge Adds all of the counts from another set of counts
+func (c findingsCounts) merge(other findingsCounts) {
+	for itemType, names := range other {
+		for name, count := range names {
+			if c[itemType] == nil {
+				c[itemType] = make(map[string]*FindingsCount)
+			}
+
+			if c[itemType][name] == nil {
+				c[itemType][name] = &FindingsCount{}
+			}
+
+			c[itemType][name].Critical += count.Critical
+			c[itemType][name].High += count.High
+			c[itemType][name].Medium += count.Medium
+			c[itemType][name].Low += count.Low
+			c[itemType][name].Informational += count.Informational
+		}
+	}
+}
+
+// findingsResourceName Converts the resource ID from a finding into the unique
+// attribute value of the item that it relates to. Findings usually reference
+// resources by ARN, but Inspector uses the instance ID for EC2 instances e.g.
+//
+//	arn:aws:ec2:eu-west-2:123456789012:instance/i-0123456789abcdef0 => i-0123456789abcdef0
+//	arn:aws:iam::123456789012:role/service-role/MyRole => MyRole
+//	arn:aws:s3:::my-bucket => my-bucket
+//	arn:aws:lambda:eu-west-2:123456789012:function:my-function:$LATEST => my-function
+//	arn:aws:ecr:eu-west-2:123456789012:repository/team/app/sha256:1a2b => team/app/sha256:1a2b
+func findingsResourceName(resourceID string) string {
+	a, err := adapterhelpers.ParseARN(resourceID)
+
+	if err != nil {
+		return resourceID
+	}
+
+	if a.Service == "ecr" {
+		// Image ARNs are the repository ARN followed by the digest, and
+		// repository names can include slashes
+		return strings.TrimPrefix(a.Resource, "repository/")
+	}
+
+	if a.Service == "lambda" {
+		// Lambda ARNs can also include a version or alias after the name
+		sections := strings.Split(a.Resource, ":")
+
+		if len(sections) >= 2 {
+			return sections[1]
+		}
+	}
+
+	return a.Resource[strings.LastIndex(a.Resource, "/")+1:]
+}
+
+// FindingsCountSource Anything that can return the open findings for an item
+type FindingsCountSource interface {
+	// Count Returns the open findings for an item. The second return value is
+	// false if findings couldn't be loaded, in which case the count should
+	// not be reported
+	Count(ctx context.Context, itemType string, uniqueAttributeValue string) (FindingsCount, bool)
+}
+
+// FindingsCounter Counts the open Security Hub and Inspector findings for the
+// resources in a single region. All findings for the supported resource types
+// are loaded in one go and cached, since looking them up per item would be
+// far too slow. Either client can be nil if that service shouldn't be used
+type FindingsCounter struct {
+	SecurityHub   SecurityHubClient
+	Inspector     Inspector2Client
+	Region        string        // The region that the clients are for
+	CacheDuration time.Duration // How long to cache the counts for
+
+	mu      sync.Mutex
+	counts  findingsCounts
+	loaded  bool
+	expires time.Time
+	loading chan struct{} // Closed when the running load finishes, nil if there isn't one
+}
+
+func (c *FindingsCounter) cacheDuration() time.Duration {
+	if c.CacheDuration == 0 {
+		return adapterhelpers.DefaultCacheDuration
+	}
+
+	return c.CacheDuration
+}
+
+// Count Returns the open findings for an item. The second return value is false
+// if findings couldn't be loaded from any source, or the context was cancelled
+// while waiting for them, in which case the count should not be reported
+func (c *FindingsCounter) Count(ctx context.Context, itemType string, uniqueAttributeValue string) (FindingsCount, bool) {
+	select {
+	case <-c.refresh(ctx):
+	case <-ctx.Done():
+		return FindingsCount{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		return FindingsCount{}, false
+	}
+
+	if count, ok := c.counts[itemType][uniqueAttributeValue]; ok {
+		return *count, true
+	}
+
+	return FindingsCount{}, true
+}
+
+// refresh Returns a channel that is closed once the counts are up to date,
+// starting a load if they have expired. Only one load runs at a time and
+// concurrent callers all wait for it. The load runs in the background on a
+// context that is detached from the query that started it, so cancelling that
+// query doesn't cause the load to fail for everyone else
+func (c *FindingsCounter) refresh(ctx context.Context) <-chan struct{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.loading != nil {
+		return c.loading
+	}
+
+	done := make(chan struct{})
+
+	if time.Now().Before(c.expires) {
+		close(done)
+		return done
+	}
+
+	c.loading = done
+
+	go c.load(context.WithoutCancel(ctx))
+
+	return done
+}
+
+// load Reloads the counts from all sources. A source that fails is skipped
+// so that one service being disabled doesn't hide the findings from the other
+func (c *FindingsCounter) load(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, findingsLoadTimeout)
+	defer cancel()
+
+	counts := make(findingsCounts)
+	loaded := false
+	inspectorLoaded := false
+
+	if c.Inspector != nil {
+		inspectorCounts, err := c.loadInspector(ctx)
+
+		if err == nil {
+			counts.merge(inspectorCounts)
+			loaded = true
+			inspectorLoaded = true
+		} else {
+			log.WithError(err).Debug("Could not load Inspector findings")
+		}
+	}
+
+	if c.SecurityHub != nil {
+		securityhubCounts, err := c.loadSecurityHub(ctx, inspectorLoaded)
+
+		if err == nil {
+			counts.merge(securityhubCounts)
+			loaded = true
+		} else {
+			log.WithError(err).Debug("Could not load Security Hub findings")
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.counts = counts
+	c.loaded = loaded
+
+	if loaded {
+		c.expires = time.Now().Add(c.cacheDuration())
+	} else {
+		c.expires = time.Now().Add(findingsRetryDuration)
+	}
+
+	close(c.loading)
+	c.loading = nil
+}
+
+// loadSecurityHub Counts the active findings in Security Hub that haven't been
+// resolved or suppressed. If the Inspector findings have already been counted
+// directly then the copies that Inspector sends to Security Hub are excluded
+func (c *FindingsCounter) loadSecurityHub(ctx context.Context, excludeInspector bool) (findingsCounts, error) {
+	filters := &securityhubtypes.AwsSecurityFindingFilters{
+		RecordState: []securityhubtypes.StringFilter{
+			{
+				Comparison: securityhubtypes.StringFilterComparisonEquals,
+				Value:      aws.String(string(securityhubtypes.RecordStateActive)),
+			},
+		},
+		WorkflowStatus: []securityhubtypes.StringFilter{
+			{
+				Comparison: securityhubtypes.StringFilterComparisonEquals,
+				Value:      aws.String(string(securityhubtypes.WorkflowStatusNew)),
+			},
+			{
+				Comparison: securityhubtypes.StringFilterComparisonEquals,
+				Value:      aws.String(string(securityhubtypes.WorkflowStatusNotified)),
+			},
+		},
+	}
+
+	resourceTypes := make([]string, 0, len(securityhubFindingsResourceTypes))
+	for resourceType := range securityhubFindingsResourceTypes {
+		resourceTypes = append(resourceTypes, resourceType)
+	}
+	sort.Strings(resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		filters.ResourceType = append(filters.ResourceType, securityhubtypes.StringFilter{
+			Comparison: securityhubtypes.StringFilterComparisonEquals,
+			Value:      aws.String(resourceType),
+		})
+	}
+
+	if excludeInspector {
+		filters.ProductName = []securityhubtypes.StringFilter{
+			{
+				Comparison: securityhubtypes.StringFilterComparisonNotEquals,
+				Value:      aws.String("Inspector"),
+			},
+		}
+	}
+
+	paginator := securityhub.NewGetFindingsPaginator(c.SecurityHub, &securityhub.GetFindingsInput{
+		Filters:    filters,
+		MaxResults: aws.Int32(100),
+	})
+
+	counts := make(findingsCounts)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, finding := range out.Findings {
+			// When cross-region aggregation is enabled the aggregation region
+			// also returns the findings from the linked regions. Those are
+			// counted by the counters for their own regions, so skip them
+			// here to avoid counting them twice across the account
+			if c.Region != "" && finding.Region != nil && *finding.Region != c.Region {
+				continue
+			}
+
+			var severity string
+
+			if finding.Severity != nil {
+				severity = string(finding.Severity.Label)
+			}
+
+			for _, resource := range finding.Resources {
+				if resource.Type == nil || resource.Id == nil {
+					continue
+				}
+
+				if itemType, ok := securityhubFindingsResourceTypes[*resource.Type]; ok {
+					counts.add(itemType, *resource.Id, severity)
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// loadInspector Counts the active findings in Inspector. Returns an error if
+// Inspector isn't enabled, since its findings can't be relied on then
+func (c *FindingsCounter) loadInspector(ctx context.Context) (findingsCounts, error) {
+	status, err := c.Inspector.BatchGetAccountStatus(ctx, &inspector2.BatchGetAccountStatusInput{})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// With no account IDs this returns the status of the calling account
+	if len(status.Accounts) == 0 || status.Accounts[0].State == nil || status.Accounts[0].State.Status != inspectortypes.StatusEnabled {
+		return nil, errors.New("inspector is not enabled")
+	}
+
+	criteria := &inspectortypes.FilterCriteria{
+		FindingStatus: []inspectortypes.StringFilter{
+			{
+				Comparison: inspectortypes.StringComparisonEquals,
+				Value:      aws.String(string(inspectortypes.FindingStatusActive)),
+			},
+		},
+		ResourceType: []inspectortypes.StringFilter{
+			{
+				Comparison: inspectortypes.StringComparisonEquals,
+				Value:      aws.String(string(inspectortypes.ResourceTypeAwsEc2Instance)),
+			},
+			{
+				Comparison: inspectortypes.StringComparisonEquals,
+				Value:      aws.String(string(inspectortypes.ResourceTypeAwsEcrContainerImage)),
+			},
+			{
+				Comparison: inspectortypes.StringComparisonEquals,
+				Value:      aws.String(string(inspectortypes.ResourceTypeAwsLambdaFunction)),
+			},
+		},
+	}
+
+	paginator := inspector2.NewListFindingsPaginator(c.Inspector, &inspector2.ListFindingsInput{
+		FilterCriteria: criteria,
+		MaxResults:     aws.Int32(100),
+	})
+
+	counts := make(findingsCounts)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, finding := range out.Findings {
+			for _, resource := range finding.Resources {
+				if resource.Id == nil {
+					continue
+				}
+
+				if itemType, ok := inspectorFindingsResourceTypes[resource.Type]; ok {
+					counts.add(itemType, *resource.Id, string(finding.Severity))
+				}
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// AccountFindingsCounter Counts the open findings across every region in an
+// account. This is used for global resources such as S3 buckets and IAM roles,
+// whose findings are reported in whichever region found them
+type AccountFindingsCounter struct {
+	mu       sync.Mutex
+	counters map[string]*FindingsCounter
+}
+
+// Add Adds the counter for a region, replacing any existing counter for the
+// same region. Counters can be added after the adapters using this have been
+// created, since regions are initialised concurrently
+func (a *AccountFindingsCounter) Add(region string, counter *FindingsCounter) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.counters == nil {
+		a.counters = make(map[string]*FindingsCounter)
+	}
+
+	a.counters[region] = counter
+}
+
+// Count Returns the total open findings for an item across all regions. The
+// second return value is false if findings couldn't be loaded in any region
+func (a *AccountFindingsCounter) Count(ctx context.Context, itemType string, uniqueAttributeValue string) (FindingsCount, bool) {
+	a.mu.Lock()
+	counters := make([]*FindingsCounter, 0, len(a.counters))
+	for _, counter := range a.counters {
+		counters = append(counters, counter)
+	}
+	a.mu.Unlock()
+
+	counts := make([]FindingsCount, len(counters))
+	loaded := make([]bool, len(counters))
+
+	// Regions load their findings independently, so wait for them in
+	// parallel rather than one after the other
+	var wg sync.WaitGroup
+
+	for i, counter := range counters {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+			counts[i], loaded[i] = counter.Count(ctx, itemType, uniqueAttributeValue)
+		}()
+	}
+
+	wg.Wait()
+
+	var total FindingsCount
+	anyLoaded := false
+
+	for i, count := range counts {
+		if !loaded[i] {
+			continue
+		}
+
+		anyLoaded = true
+		total.Critical += count.Critical
+		total.High += count.High
+		total.Medium += count.Medium
+		total.Low += count.Low
+		total.Informational += count.Informational
+	}
+
+	return total, anyLoaded
+}
+
+// enrichWithFindings Returns a copy of the item with its open findings added
+// as the "OpenFindings" attribute. Critical findings set the health of the
+// item to ERROR, and high severity findings set it to WARNING unless it's
+// already in a worse state. The original item is never changed, so the health
+// always starts from what the adapter worked out and goes back to that once
+// the findings are closed
+func enrichWithFindings(ctx context.Context, counter FindingsCountSource, item *sdp.Item) *sdp.Item {
+	if item == nil || item.GetAttributes() == nil {
+		return item
+	}
+
+	count, ok := counter.Count(ctx, item.GetType(), item.UniqueAttributeValue())
+
+	if !ok {
+		return item
+	}
+
+	enriched := &sdp.Item{}
+	item.Copy(enriched)
+
+	err := enriched.GetAttributes().Set("OpenFindings", map[string]interface{}{
+		"Critical":      count.Critical,
+		"High":          count.High,
+		"Medium":        count.Medium,
+		"Low":           count.Low,
+		"Informational": count.Informational,
+		"Total":         count.Total(),
+	})
+
+	if err != nil {
+		return item
+	}
+
+	switch {
+	case count.Critical > 0:
+		enriched.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case count.High > 0:
+		switch enriched.GetHealth() { // nolint:exhaustive
+		case sdp.Health_HEALTH_UNKNOWN, sdp.Health_HEALTH_OK:
+			enriched.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+	}
+
+	return enriched
+}
+
+// EnrichableAdapter The methods that all adapters in this package implement,
+// which the findings adapter needs to pass through
+type EnrichableAdapter interface {
+	discovery.Adapter
+
+	Cache() *sdpcache.Cache
+}
+
+// NewFindingsAdapter Wraps an adapter so that every item it returns includes
+// the count of its open findings. The wrapper supports the same query methods
+// as the adapter it wraps, as long as it either streams results or supports
+// both List and Search like all of the adapters in this package
+func NewFindingsAdapter(adapter EnrichableAdapter, counter FindingsCountSource) discovery.Adapter {
+	base := findingsAdapter{
+		EnrichableAdapter: adapter,
+		counter:           counter,
+	}
+
+	if streaming, ok := adapter.(discovery.StreamingAdapter); ok {
+		return &findingsStreamingAdapter{
+			findingsAdapter: base,
+			streaming:       streaming,
+		}
+	}
+
+	listable, isListable := adapter.(discovery.ListableAdapter)
+	searchable, isSearchable := adapter.(discovery.SearchableAdapter)
+
+	if isListable && isSearchable {
+		return &findingsListSearchAdapter{
+			findingsAdapter: base,
+			listable:        listable,
+			searchable:      searchable,
+		}
+	}
+
+	return &base
+}
+
+type findingsAdapter struct {
+	EnrichableAdapter
+
+	counter FindingsCountSource
+}
+
+// Weight Passes through the weight of the wrapped adapter, if it has one
+func (a *findingsAdapter) Weight() int {
+	if w, ok := a.EnrichableAdapter.(interface{ Weight() int }); ok {
+		return w.Weight()
+	}
+
+	return 0
+}
+
+// Validate Passes through validation to the wrapped adapter, if it supports it
+func (a *findingsAdapter) Validate() error {
+	if v, ok := a.EnrichableAdapter.(interface{ Validate() error }); ok {
+		return v.Validate()
+	}
+
+	return nil
+}
+
+func (a *findingsAdapter) Get(ctx context.Context, scope string, query string, ignoreCache bool) (*sdp.Item, error) {
+	item, err := a.EnrichableAdapter.Get(ctx, scope, query, ignoreCache)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return enrichWithFindings(ctx, a.counter, item), nil
+}
+
+type findingsStreamingAdapter struct {
+	findingsAdapter
+
+	streaming discovery.StreamingAdapter
+}
+
+// enrichStream Returns a stream that adds findings to items before passing
+// them on to the original stream
+func (a *findingsStreamingAdapter) enrichStream(ctx context.Context, stream *discovery.QueryResultStream) *discovery.QueryResultStream {
+	return discovery.NewQueryResultStream(
+		func(item *sdp.Item) {
+			stream.SendItem(enrichWithFindings(ctx, a.counter, item))
+		},
+		func(err error) {
+			stream.SendError(err)
+		},
+	)
+}
+
+func (a *findingsStreamingAdapter) ListStream(ctx context.Context, scope string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	enriched := a.enrichStream(ctx, stream)
+	a.streaming.ListStream(ctx, scope, ignoreCache, enriched)
+	enriched.Close()
+}
+
+func (a *findingsStreamingAdapter) SearchStream(ctx context.Context, scope string, query string, ignoreCache bool, stream *discovery.QueryResultStream) {
+	enriched := a.enrichStream(ctx, stream)
+	a.streaming.SearchStream(ctx, scope, query, ignoreCache, enriched)
+	enriched.Close()
+}
+
+type findingsListSearchAdapter struct {
+	findingsAdapter
+
+	listable   discovery.ListableAdapter
+	searchable discovery.SearchableAdapter
+}
+
+func (a *findingsListSearchAdapter) List(ctx context.Context, scope string, ignoreCache bool) ([]*sdp.Item, error) {
+	items, err := a.listable.List(ctx, scope, ignoreCache)
+
+	for i, item := range items {
+		items[i] = enrichWithFindings(ctx, a.counter, item)
+	}
+
+	return items, err
+}
+
+func (a *findingsListSearchAdapter) Search(ctx context.Context, scope string, query string, ignoreCache bool) ([]*sdp.Item, error) {
+	items, err := a.searchable.Search(ctx, scope, query, ignoreCache)
+
+	for i, item := range items {
+		items[i] = enrichWithFindings(ctx, a.counter, item)
+	}
+
+	return items, err
+}
@@ -0,0 +1,720 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+	inspectortypes "github.com/aws/aws-sdk-go-v2/service/inspector2/types"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	securityhubtypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+	"github.com/overmindtech/sdpcache"
+)
+
+var testSecurityHubFindings = &securityhub.GetFindingsOutput{
+	Findings: []securityhubtypes.AwsSecurityFinding{
+		{
+			Title: adapterhelpers.PtrString("EC2 instances should not have a public IPv4 address"),
+			Severity: &securityhubtypes.Severity{
+				Label: securityhubtypes.SeverityLabelCritical,
+			},
+			Resources: []securityhubtypes.Resource{
+				{
+					Type: adapterhelpers.PtrString("AwsEc2Instance"),
+					Id:   adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:instance/i-0123456789abcdef0"),
+				},
+			},
+		},
+		{
+			Title: adapterhelpers.PtrString("S3 general purpose buckets should block public access"),
+			Severity: &securityhubtypes.Severity{
+				Label: securityhubtypes.SeverityLabelHigh,
+			},
+			Resources: []securityhubtypes.Resource{
+				{
+					Type: adapterhelpers.PtrString("AwsS3Bucket"),
+					Id:   adapterhelpers.PtrString("arn:aws:s3:::my-bucket"),
+				},
+			},
+		},
+		{
+			Title: adapterhelpers.PtrString("IAM roles should not have unused permissions"),
+			Severity: &securityhubtypes.Severity{
+				Label: securityhubtypes.SeverityLabelMedium,
+			},
+			Resources: []securityhubtypes.Resource{
+				{
+					Type: adapterhelpers.PtrString("AwsIamRole"),
+					Id:   adapterhelpers.PtrString("arn:aws:iam::123456789012:role/service-role/MyRole"),
+				},
+				{
+					// Not a type that we count findings for
+					Type: adapterhelpers.PtrString("AwsAccount"),
+					Id:   adapterhelpers.PtrString("AWS::::Account:123456789012"),
+				},
+			},
+		},
+	},
+}
+
+var testInspectorFindings = &inspector2.ListFindingsOutput{
+	Findings: []inspectortypes.Finding{
+		{
+			Title:    adapterhelpers.PtrString("CVE-2024-6387 - openssh-server"),
+			Severity: inspectortypes.SeverityHigh,
+			Resources: []inspectortypes.Resource{
+				{
+					Type: inspectortypes.ResourceTypeAwsEc2Instance,
+					Id:   adapterhelpers.PtrString("i-0123456789abcdef0"),
+				},
+			},
+		},
+		{
+			Title:    adapterhelpers.PtrString("CVE-2024-3094 - xz"),
+			Severity: inspectortypes.SeverityCritical,
+			Resources: []inspectortypes.Resource{
+				{
+					Type: inspectortypes.ResourceTypeAwsLambdaFunction,
+					Id:   adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:my-function:$LATEST"),
+				},
+			},
+		},
+		{
+			Title:    adapterhelpers.PtrString("CVE-2023-44487 - golang.org/x/net"),
+			Severity: inspectortypes.SeverityUntriaged,
+			Resources: []inspectortypes.Resource{
+				{
+					Type: inspectortypes.ResourceTypeAwsLambdaFunction,
+					Id:   adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:my-function"),
+				},
+			},
+		},
+		{
+			Title:    adapterhelpers.PtrString("CVE-2023-4911 - glibc"),
+			Severity: inspectortypes.SeverityHigh,
+			Resources: []inspectortypes.Resource{
+				{
+					Type: inspectortypes.ResourceTypeAwsEcrContainerImage,
+					Id:   adapterhelpers.PtrString("arn:aws:ecr:eu-west-2:123456789012:repository/team/web/sha256:9f2c5d2e"),
+				},
+			},
+		},
+	},
+}
+
+var testInspectorEnabled = &inspector2.BatchGetAccountStatusOutput{
+	Accounts: []inspectortypes.AccountState{
+		{
+			AccountId: adapterhelpers.PtrString("123456789012"),
+			State: &inspectortypes.State{
+				Status: inspectortypes.StatusEnabled,
+			},
+		},
+	},
+}
+
+func TestFindingsResourceName(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:ec2:eu-west-2:123456789012:instance/i-0123456789abcdef0":    "i-0123456789abcdef0",
+		"arn:aws:iam::123456789012:role/service-role/MyRole":                 "MyRole",
+		"arn:aws:iam::123456789012:user/alice":                               "alice",
+		"arn:aws:s3:::my-bucket":                                             "my-bucket",
+		"arn:aws:lambda:eu-west-2:123456789012:function:my-function":         "my-function",
+		"arn:aws:lambda:eu-west-2:123456789012:function:my-function:$LATEST": "my-function",
+		"arn:aws:lambda:eu-west-2:123456789012:function:my-function:prod":    "my-function",
+		"i-0123456789abcdef0":                                                "i-0123456789abcdef0",
+		"arn:aws:ecr:eu-west-2:123456789012:repository/web/sha256:9f2c5d2e":  "web/sha256:9f2c5d2e",
+		"arn:aws:ecr:eu-west-2:123456789012:repository/team/web/sha256:1a2b": "team/web/sha256:1a2b",
+	}
+
+	for resourceID, expected := range tests {
+		if name := findingsResourceName(resourceID); name != expected {
+			t.Errorf("expected %v for %v, got %v", expected, resourceID, name)
+		}
+	}
+}
+
+func TestFindingsCounterCount(t *testing.T) {
+	counter := &FindingsCounter{
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: testSecurityHubFindings,
+		},
+		Inspector: Inspector2TestClient{
+			BatchGetAccountStatusOutput: testInspectorEnabled,
+			ListFindingsOutput:          testInspectorFindings,
+		},
+	}
+
+	tests := []struct {
+		Type     string
+		Name     string
+		Expected FindingsCount
+	}{
+		{
+			Type:     "ec2-instance",
+			Name:     "i-0123456789abcdef0",
+			Expected: FindingsCount{Critical: 1, High: 1},
+		},
+		{
+			Type:     "s3-bucket",
+			Name:     "my-bucket",
+			Expected: FindingsCount{High: 1},
+		},
+		{
+			Type:     "iam-role",
+			Name:     "MyRole",
+			Expected: FindingsCount{Medium: 1},
+		},
+		{
+			Type:     "lambda-function",
+			Name:     "my-function",
+			Expected: FindingsCount{Critical: 1, Informational: 1},
+		},
+		{
+			Type:     "ecr-image",
+			Name:     "team/web/sha256:9f2c5d2e",
+			Expected: FindingsCount{High: 1},
+		},
+		{
+			Type:     "ec2-instance",
+			Name:     "i-00000000000000000",
+			Expected: FindingsCount{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Type+"/"+test.Name, func(t *testing.T) {
+			count, ok := counter.Count(context.Background(), test.Type, test.Name)
+
+			if !ok {
+				t.Fatal("expected findings to be loaded")
+			}
+
+			if count != test.Expected {
+				t.Errorf("expected %+v, got %+v", test.Expected, count)
+			}
+		})
+	}
+}
+
+func TestFindingsCounterSourceErrors(t *testing.T) {
+	t.Run("one source fails", func(t *testing.T) {
+		counter := &FindingsCounter{
+			SecurityHub: SecurityHubTestClient{
+				GetFindingsError: &securityhubtypes.InvalidAccessException{
+					Message: adapterhelpers.PtrString("Account 123456789012 is not subscribed to AWS Security Hub"),
+				},
+			},
+			Inspector: Inspector2TestClient{
+				BatchGetAccountStatusOutput: testInspectorEnabled,
+				ListFindingsOutput:          testInspectorFindings,
+			},
+		}
+
+		count, ok := counter.Count(context.Background(), "ec2-instance", "i-0123456789abcdef0")
+
+		if !ok {
+			t.Fatal("expected Inspector findings to be loaded")
+		}
+
+		if count.Total() != 1 {
+			t.Errorf("expected 1 finding, got %v", count.Total())
+		}
+	})
+
+	t.Run("all sources fail", func(t *testing.T) {
+		counter := &FindingsCounter{
+			Inspector: Inspector2TestClient{
+				BatchGetAccountStatusOutput: testInspectorEnabled,
+				ListFindingsError:           errors.New("access denied"),
+			},
+		}
+
+		if _, ok := counter.Count(context.Background(), "ec2-instance", "i-0123456789abcdef0"); ok {
+			t.Error("expected findings not to be loaded")
+		}
+	})
+}
+
+func testFindingsItem(t *testing.T, instanceID string, health *sdp.Health) *sdp.Item {
+	attributes, err := sdp.ToAttributes(map[string]interface{}{
+		"InstanceId": instanceID,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &sdp.Item{
+		Type:            "ec2-instance",
+		UniqueAttribute: "InstanceId",
+		Attributes:      attributes,
+		Scope:           "123456789012.eu-west-2",
+		Health:          health,
+	}
+}
+
+func TestFindingsCounterEnrich(t *testing.T) {
+	counter := &FindingsCounter{
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: &securityhub.GetFindingsOutput{
+				Findings: []securityhubtypes.AwsSecurityFinding{
+					{
+						Severity: &securityhubtypes.Severity{
+							Label: securityhubtypes.SeverityLabelHigh,
+						},
+						Resources: []securityhubtypes.Resource{
+							{
+								Type: adapterhelpers.PtrString("AwsEc2Instance"),
+								Id:   adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:instance/i-0000000000000000a"),
+							},
+						},
+					},
+					{
+						Severity: &securityhubtypes.Severity{
+							Label: securityhubtypes.SeverityLabelCritical,
+						},
+						Resources: []securityhubtypes.Resource{
+							{
+								Type: adapterhelpers.PtrString("AwsEc2Instance"),
+								Id:   adapterhelpers.PtrString("arn:aws:ec2:eu-west-2:123456789012:instance/i-0123456789abcdef0"),
+							},
+						},
+					},
+				},
+			},
+		},
+		Inspector: Inspector2TestClient{
+			BatchGetAccountStatusOutput: testInspectorEnabled,
+			ListFindingsOutput:          testInspectorFindings,
+		},
+	}
+
+	tests := []struct {
+		Name           string
+		InstanceID     string
+		Health         *sdp.Health
+		ExpectedHealth sdp.Health
+		ExpectedTotal  string
+	}{
+		{
+			Name:           "critical findings",
+			InstanceID:     "i-0123456789abcdef0",
+			Health:         sdp.Health_HEALTH_OK.Enum(),
+			ExpectedHealth: sdp.Health_HEALTH_ERROR,
+			ExpectedTotal:  "2",
+		},
+		{
+			Name:           "high findings",
+			InstanceID:     "i-0000000000000000a",
+			Health:         sdp.Health_HEALTH_OK.Enum(),
+			ExpectedHealth: sdp.Health_HEALTH_WARNING,
+			ExpectedTotal:  "1",
+		},
+		{
+			Name:           "high findings on a pending instance",
+			InstanceID:     "i-0000000000000000a",
+			Health:         sdp.Health_HEALTH_PENDING.Enum(),
+			ExpectedHealth: sdp.Health_HEALTH_PENDING,
+			ExpectedTotal:  "1",
+		},
+		{
+			Name:           "no findings",
+			InstanceID:     "i-00000000000000000",
+			Health:         sdp.Health_HEALTH_OK.Enum(),
+			ExpectedHealth: sdp.Health_HEALTH_OK,
+			ExpectedTotal:  "0",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			original := testFindingsItem(t, test.InstanceID, test.Health)
+
+			item := enrichWithFindings(context.Background(), counter, original)
+
+			if original.GetHealth() != *test.Health {
+				t.Errorf("expected the original item not to be changed, got health %v", original.GetHealth())
+			}
+
+			if item.GetHealth() != test.ExpectedHealth {
+				t.Errorf("expected health %v, got %v", test.ExpectedHealth, item.GetHealth())
+			}
+
+			findings, err := item.GetAttributes().Get("OpenFindings")
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			findingsMap, ok := findings.(map[string]interface{})
+
+			if !ok {
+				t.Fatalf("expected OpenFindings to be a map, got %T", findings)
+			}
+
+			if total := fmt.Sprint(findingsMap["Total"]); total != test.ExpectedTotal {
+				t.Errorf("expected %v total findings, got %v", test.ExpectedTotal, total)
+			}
+		})
+	}
+}
+
+func TestFindingsCounterClosedFindings(t *testing.T) {
+	counter := &FindingsCounter{
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: testSecurityHubFindings,
+		},
+	}
+
+	adapter := NewFindingsAdapter(testFindingsAdapter{}, counter)
+
+	item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "i-0123456789abcdef0", false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Fatalf("expected health %v while the finding is open, got %v", sdp.Health_HEALTH_ERROR, item.GetHealth())
+	}
+
+	// Close the finding and expire the cached counts
+	counter.mu.Lock()
+	counter.SecurityHub = SecurityHubTestClient{
+		GetFindingsOutput: &securityhub.GetFindingsOutput{},
+	}
+	counter.expires = time.Time{}
+	counter.mu.Unlock()
+
+	item, err = adapter.Get(context.Background(), "123456789012.eu-west-2", "i-0123456789abcdef0", false)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_UNKNOWN {
+		t.Errorf("expected health to go back to %v once the finding is closed, got %v", sdp.Health_HEALTH_UNKNOWN, item.GetHealth())
+	}
+
+	findings, err := item.GetAttributes().Get("OpenFindings.Total")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fmt.Sprint(findings) != "0" {
+		t.Errorf("expected 0 open findings, got %v", findings)
+	}
+}
+
+func TestFindingsCounterCancelledQuery(t *testing.T) {
+	counter := &FindingsCounter{
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: testSecurityHubFindings,
+		},
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The result for the cancelled query doesn't matter, but the load that
+	// it started must not fail because of it
+	counter.Count(ctx, "ec2-instance", "i-0123456789abcdef0")
+
+	count, ok := counter.Count(context.Background(), "ec2-instance", "i-0123456789abcdef0")
+
+	if !ok {
+		t.Fatal("expected findings to be loaded after a cancelled query")
+	}
+
+	if count.Critical != 1 {
+		t.Errorf("expected 1 critical finding, got %v", count.Critical)
+	}
+}
+
+// testSecurityHubFiltersClient Records the filters that findings were
+// requested with
+type testSecurityHubFiltersClient struct {
+	SecurityHubTestClient
+
+	filters **securityhubtypes.AwsSecurityFindingFilters
+}
+
+func (t testSecurityHubFiltersClient) GetFindings(ctx context.Context, input *securityhub.GetFindingsInput, optFns ...func(*securityhub.Options)) (*securityhub.GetFindingsOutput, error) {
+	*t.filters = input.Filters
+	return t.SecurityHubTestClient.GetFindings(ctx, input, optFns...)
+}
+
+func TestFindingsCounterInspectorEnablement(t *testing.T) {
+	tests := []struct {
+		Name                    string
+		Status                  *inspector2.BatchGetAccountStatusOutput
+		ExpectInspectorExcluded bool
+	}{
+		{
+			Name:                    "enabled",
+			Status:                  testInspectorEnabled,
+			ExpectInspectorExcluded: true,
+		},
+		{
+			Name: "disabled",
+			Status: &inspector2.BatchGetAccountStatusOutput{
+				Accounts: []inspectortypes.AccountState{
+					{
+						AccountId: adapterhelpers.PtrString("123456789012"),
+						State: &inspectortypes.State{
+							Status: inspectortypes.StatusDisabled,
+						},
+					},
+				},
+			},
+			ExpectInspectorExcluded: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			var filters *securityhubtypes.AwsSecurityFindingFilters
+
+			counter := &FindingsCounter{
+				SecurityHub: testSecurityHubFiltersClient{
+					SecurityHubTestClient: SecurityHubTestClient{
+						GetFindingsOutput: testSecurityHubFindings,
+					},
+					filters: &filters,
+				},
+				Inspector: Inspector2TestClient{
+					BatchGetAccountStatusOutput: test.Status,
+					ListFindingsOutput:          testInspectorFindings,
+				},
+			}
+
+			if _, ok := counter.Count(context.Background(), "ec2-instance", "i-0123456789abcdef0"); !ok {
+				t.Fatal("expected findings to be loaded")
+			}
+
+			if filters == nil {
+				t.Fatal("expected Security Hub findings to be requested")
+			}
+
+			if excluded := len(filters.ProductName) > 0; excluded != test.ExpectInspectorExcluded {
+				t.Errorf("expected Inspector findings excluded from Security Hub to be %v, got %v", test.ExpectInspectorExcluded, excluded)
+			}
+		})
+	}
+}
+
+func TestAccountFindingsCounter(t *testing.T) {
+	bucketFinding := func(region string, severity securityhubtypes.SeverityLabel) securityhubtypes.AwsSecurityFinding {
+		return securityhubtypes.AwsSecurityFinding{
+			Region: adapterhelpers.PtrString(region),
+			Severity: &securityhubtypes.Severity{
+				Label: severity,
+			},
+			Resources: []securityhubtypes.Resource{
+				{
+					Type: adapterhelpers.PtrString("AwsS3Bucket"),
+					Id:   adapterhelpers.PtrString("arn:aws:s3:::my-bucket"),
+				},
+			},
+		}
+	}
+
+	account := &AccountFindingsCounter{}
+
+	// eu-west-2 is the aggregation region so it also returns the finding from
+	// us-east-1, which must only be counted once
+	account.Add("eu-west-2", &FindingsCounter{
+		Region: "eu-west-2",
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: &securityhub.GetFindingsOutput{
+				Findings: []securityhubtypes.AwsSecurityFinding{
+					bucketFinding("eu-west-2", securityhubtypes.SeverityLabelHigh),
+					bucketFinding("us-east-1", securityhubtypes.SeverityLabelCritical),
+				},
+			},
+		},
+	})
+	account.Add("us-east-1", &FindingsCounter{
+		Region: "us-east-1",
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsOutput: &securityhub.GetFindingsOutput{
+				Findings: []securityhubtypes.AwsSecurityFinding{
+					bucketFinding("us-east-1", securityhubtypes.SeverityLabelCritical),
+				},
+			},
+		},
+	})
+	account.Add("ap-southeast-2", &FindingsCounter{
+		Region: "ap-southeast-2",
+		SecurityHub: SecurityHubTestClient{
+			GetFindingsError: errors.New("not subscribed"),
+		},
+	})
+
+	count, ok := account.Count(context.Background(), "s3-bucket", "my-bucket")
+
+	if !ok {
+		t.Fatal("expected findings to be loaded")
+	}
+
+	expected := FindingsCount{Critical: 1, High: 1}
+
+	if count != expected {
+		t.Errorf("expected %+v, got %+v", expected, count)
+	}
+
+	if _, ok := (&AccountFindingsCounter{}).Count(context.Background(), "s3-bucket", "my-bucket"); ok {
+		t.Error("expected an account with no regions not to have loaded findings")
+	}
+}
+
+// testFindingsAdapter A minimal adapter that returns a single EC2 instance
+type testFindingsAdapter struct{}
+
+func (testFindingsAdapter) Type() string                   { return "ec2-instance" }
+func (testFindingsAdapter) Name() string                   { return "test-findings-adapter" }
+func (testFindingsAdapter) Scopes() []string               { return []string{"123456789012.eu-west-2"} }
+func (testFindingsAdapter) Metadata() *sdp.AdapterMetadata { return &sdp.AdapterMetadata{} }
+func (testFindingsAdapter) Cache() *sdpcache.Cache         { return nil }
+func (testFindingsAdapter) Weight() int                    { return 100 }
+
+func (testFindingsAdapter) item() *sdp.Item {
+	attributes, _ := sdp.ToAttributes(map[string]interface{}{
+		"InstanceId": "i-0123456789abcdef0",
+	})
+
+	return &sdp.Item{
+		Type:            "ec2-instance",
+		UniqueAttribute: "InstanceId",
+		Attributes:      attributes,
+		Scope:           "123456789012.eu-west-2",
+	}
+}
+
+func (a testFindingsAdapter) Get(context.Context, string, string, bool) (*sdp.Item, error) {
+	return a.item(), nil
+}
+
+func (a testFindingsAdapter) List(context.Context, string, bool) ([]*sdp.Item, error) {
+	return []*sdp.Item{a.item()}, nil
+}
+
+func (a testFindingsAdapter) Search(context.Context, string, string, bool) ([]*sdp.Item, error) {
+	return []*sdp.Item{a.item()}, nil
+}
+
+// testFindingsStreamingAdapter A minimal streaming adapter that returns a
+// single EC2 instance
+type testFindingsStreamingAdapter struct {
+	testFindingsAdapter
+}
+
+func (a testFindingsStreamingAdapter) ListStream(_ context.Context, _ string, _ bool, stream *discovery.QueryResultStream) {
+	stream.SendItem(a.item())
+}
+
+func (a testFindingsStreamingAdapter) SearchStream(_ context.Context, _ string, _ string, _ bool, stream *discovery.QueryResultStream) {
+	stream.SendItem(a.item())
+}
+
+func TestNewFindingsAdapter(t *testing.T) {
+	counter := &FindingsCounter{
+		Inspector: Inspector2TestClient{
+			BatchGetAccountStatusOutput: testInspectorEnabled,
+			ListFindingsOutput:          testInspectorFindings,
+		},
+	}
+
+	t.Run("list and search", func(t *testing.T) {
+		adapter := NewFindingsAdapter(testFindingsAdapter{}, counter)
+
+		if _, ok := adapter.(discovery.StreamingAdapter); ok {
+			t.Error("expected adapter not to be streaming")
+		}
+
+		item, err := adapter.Get(context.Background(), "123456789012.eu-west-2", "i-0123456789abcdef0", false)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Errorf("expected Get to return an enriched item, got health %v", item.GetHealth())
+		}
+
+		listable, ok := adapter.(discovery.ListableAdapter)
+
+		if !ok {
+			t.Fatal("expected adapter to be listable")
+		}
+
+		items, err := listable.List(context.Background(), "123456789012.eu-west-2", false)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Error("expected List to return enriched items")
+		}
+
+		searchable, ok := adapter.(discovery.SearchableAdapter)
+
+		if !ok {
+			t.Fatal("expected adapter to be searchable")
+		}
+
+		items, err = searchable.Search(context.Background(), "123456789012.eu-west-2", "i-0123456789abcdef0", false)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(items) != 1 || items[0].GetHealth() != sdp.Health_HEALTH_WARNING {
+			t.Error("expected Search to return enriched items")
+		}
+	})
+
+	t.Run("streaming", func(t *testing.T) {
+		adapter := NewFindingsAdapter(testFindingsStreamingAdapter{}, counter)
+
+		streaming, ok := adapter.(discovery.StreamingAdapter)
+
+		if !ok {
+			t.Fatal("expected adapter to be streaming")
+		}
+
+		items := make([]*sdp.Item, 0)
+		stream := discovery.NewQueryResultStream(
+			func(item *sdp.Item) {
+				items = append(items, item)
+			},
+			func(err error) {
+				t.Error(err)
+			},
+		)
+
+		streaming.ListStream(context.Background(), "123456789012.eu-west-2", false, stream)
+		streaming.SearchStream(context.Background(), "123456789012.eu-west-2", "i-0123456789abcdef0", false, stream)
+		stream.Close()
+
+		if len(items) != 2 {
+			t.Fatalf("expected 2 items, got %v", len(items))
+		}
+
+		for _, item := range items {
+			if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+				t.Errorf("expected streamed items to be enriched, got health %v", item.GetHealth())
+			}
+		}
+	})
+}
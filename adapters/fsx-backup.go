@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func fsxBackupOutputMapper(_ context.Context, _ *fsx.Client, scope string, _ *fsx.DescribeBackupsInput, output *fsx.DescribeBackupsOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, backup := range output.Backups {
+		// The backup includes a full copy of the file system and volume
+		// description at the time of the backup. We only need the IDs of these
+		// since they are linked
+		attrs, err := adapterhelpers.ToAttributesWithExclude(backup, "tags", "FileSystem", "Volume")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if backup.BackupId == nil {
+			return nil, errors.New("fsx-backup has nil id")
+		}
+
+		item := sdp.Item{
+			Type:            "fsx-backup",
+			UniqueAttribute: "BackupId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Health:          fsxBackupLifecycleToHealth(backup.Lifecycle),
+			Tags:            fsxTagsToMap(backup.Tags),
+		}
+
+		if backup.FileSystem != nil && backup.FileSystem.FileSystemId != nil {
+			if err = item.Attributes.Set("FileSystemId", *backup.FileSystem.FileSystemId); err != nil {
+				return nil, err
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-file-system",
+					Method: sdp.QueryMethod_GET,
+					Query:  *backup.FileSystem.FileSystemId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the file system can remove automatic backups
+					In: true,
+					// The backup can't affect the file system
+					Out: false,
+				},
+			})
+		}
+
+		if backup.Volume != nil && backup.Volume.VolumeId != nil {
+			if err = item.Attributes.Set("VolumeId", *backup.Volume.VolumeId); err != nil {
+				return nil, err
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-volume",
+					Method: sdp.QueryMethod_GET,
+					Query:  *backup.Volume.VolumeId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the volume can remove automatic backups
+					In: true,
+					// The backup can't affect the volume
+					Out: false,
+				},
+			})
+		}
+
+		if backup.KmsKeyId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*backup.KmsKeyId, scope))
+		}
+
+		if backup.SourceBackupId != nil {
+			// Copied backups keep a reference to the backup they were copied
+			// from, which may be in another region
+			sourceScope := scope
+
+			if backup.SourceBackupRegion != nil {
+				accountID, _, _ := adapterhelpers.ParseScope(scope)
+				sourceScope = adapterhelpers.FormatScope(accountID, *backup.SourceBackupRegion)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-backup",
+					Method: sdp.QueryMethod_GET,
+					Query:  *backup.SourceBackupId,
+					Scope:  sourceScope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The copy is independent of the source once it's complete
+					In:  false,
+					Out: false,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewFSxBackupAdapter(client *fsx.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeBackupsInput, *fsx.DescribeBackupsOutput, *fsx.Client, *fsx.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeBackupsInput, *fsx.DescribeBackupsOutput, *fsx.Client, *fsx.Options]{
+		ItemType:        "fsx-backup",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: fsxBackupAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *fsx.Client, input *fsx.DescribeBackupsInput) (*fsx.DescribeBackupsOutput, error) {
+			return client.DescribeBackups(ctx, input)
+		},
+		PaginatorBuilder: func(client *fsx.Client, params *fsx.DescribeBackupsInput) adapterhelpers.Paginator[*fsx.DescribeBackupsOutput, *fsx.Options] {
+			return fsx.NewDescribeBackupsPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*fsx.DescribeBackupsInput, error) {
+			return &fsx.DescribeBackupsInput{
+				BackupIds: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*fsx.DescribeBackupsInput, error) {
+			return &fsx.DescribeBackupsInput{}, nil
+		},
+		// Search by file system or volume ID
+		InputMapperSearch: func(ctx context.Context, client *fsx.Client, scope, query string) (*fsx.DescribeBackupsInput, error) {
+			filterName := types.FilterNameFileSystemId
+
+			if strings.HasPrefix(query, "fsvol-") {
+				filterName = types.FilterNameVolumeId
+			}
+
+			return &fsx.DescribeBackupsInput{
+				Filters: []types.Filter{
+					{
+						Name:   filterName,
+						Values: []string{query},
+					},
+				},
+			}, nil
+		},
+		OutputMapper: fsxBackupOutputMapper,
+	}
+}
+
+var fsxBackupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "fsx-backup",
+	DescriptiveName: "FSx Backup",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an FSx backup by ID",
+		ListDescription:   "List all FSx backups",
+		SearchDescription: "Search for FSx backups by file system ID or volume ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_fsx_backup.id"},
+	},
+	PotentialLinks: []string{"fsx-file-system", "fsx-volume", "fsx-backup", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFsxBackupOutputMapper(t *testing.T) {
+	output := &fsx.DescribeBackupsOutput{
+		Backups: []types.Backup{
+			{
+				BackupId:     adapterhelpers.PtrString("backup-0123456789abcdef0"),
+				CreationTime: adapterhelpers.PtrTime(time.Now()),
+				FileSystem: &types.FileSystem{
+					FileSystemId:   adapterhelpers.PtrString("fs-0123456789abcdef0"),
+					FileSystemType: types.FileSystemTypeOntap,
+				},
+				Volume: &types.Volume{
+					VolumeId: adapterhelpers.PtrString("fsvol-0123456789abcdef0"),
+				},
+				KmsKeyId:           adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				Lifecycle:          types.BackupLifecycleFailed,
+				ResourceARN:        adapterhelpers.PtrString("arn:aws:fsx:eu-west-2:123456789012:backup/backup-0123456789abcdef0"),
+				ResourceType:       types.ResourceTypeVolume,
+				SourceBackupId:     adapterhelpers.PtrString("backup-0fedcba9876543210"),
+				SourceBackupRegion: adapterhelpers.PtrString("us-east-1"),
+				Type:               types.BackupTypeUserInitiated,
+			},
+		},
+	}
+
+	items, err := fsxBackupOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "fsx-file-system",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-volume",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fsvol-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-backup",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "backup-0fedcba9876543210",
+			ExpectedScope:  "123456789012.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewFSxBackupAdapter(t *testing.T) {
+	client, account, region := fsxGetAutoConfig(t)
+
+	adapter := NewFSxBackupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,271 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func fsxFileSystemOutputMapper(_ context.Context, _ *fsx.Client, scope string, _ *fsx.DescribeFileSystemsInput, output *fsx.DescribeFileSystemsOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, fs := range output.FileSystems {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(fs, "tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if fs.FileSystemId == nil {
+			return nil, errors.New("fsx-file-system has nil id")
+		}
+
+		item := sdp.Item{
+			Type:            "fsx-file-system",
+			UniqueAttribute: "FileSystemId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Health:          fsxFileSystemLifecycleToHealth(fs.Lifecycle),
+			Tags:            fsxTagsToMap(fs.Tags),
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "fsx-volume",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *fs.FileSystemId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Volumes are tightly coupled to their file system
+						In:  true,
+						Out: true,
+					},
+				},
+				{
+					Query: &sdp.Query{
+						Type:   "fsx-backup",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *fs.FileSystemId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Backups can't affect the file system
+						In: false,
+						// Deleting the file system can remove automatic backups
+						Out: true,
+					},
+				},
+			},
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, fsxSubnetLinks(fs.SubnetIds, scope)...)
+
+		// Security groups aren't returned by FSx, they are attached to these
+		// network interfaces and can be found through them
+		for _, eniID := range fs.NetworkInterfaceIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-network-interface",
+					Method: sdp.QueryMethod_GET,
+					Query:  eniID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Tightly coupled
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if fs.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *fs.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the VPC will affect us
+					In: true,
+					// We can't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		if fs.KmsKeyId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*fs.KmsKeyId, scope))
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(fs.DNSName, nil)...)
+
+		if fs.LustreConfiguration != nil && fs.LustreConfiguration.DataRepositoryConfiguration != nil {
+			repo := fs.LustreConfiguration.DataRepositoryConfiguration
+			buckets := make(map[string]bool)
+
+			for _, path := range []*string{repo.ImportPath, repo.ExportPath} {
+				if path == nil {
+					continue
+				}
+
+				link := s3BucketLink(*path, scope, &sdp.BlastPropagation{
+					// The file system is loaded from the bucket
+					In: true,
+					// Changes are exported back to the bucket
+					Out: true,
+				})
+
+				// The import and export paths are often in the same bucket
+				if link != nil && !buckets[link.GetQuery().GetQuery()] {
+					buckets[link.GetQuery().GetQuery()] = true
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+
+		if ontap := fs.OntapConfiguration; ontap != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-storage-virtual-machine",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *fs.FileSystemId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// SVMs are tightly coupled to their file system
+					In:  true,
+					Out: true,
+				},
+			})
+
+			if ontap.Endpoints != nil {
+				if ontap.Endpoints.Management != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(ontap.Endpoints.Management.DNSName, ontap.Endpoints.Management.IpAddresses)...)
+				}
+
+				if ontap.Endpoints.Intercluster != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(ontap.Endpoints.Intercluster.DNSName, ontap.Endpoints.Intercluster.IpAddresses)...)
+				}
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, fsxRouteTableLinks(ontap.RouteTableIds, scope)...)
+		}
+
+		if zfs := fs.OpenZFSConfiguration; zfs != nil {
+			if zfs.RootVolumeId != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "fsx-volume",
+						Method: sdp.QueryMethod_GET,
+						Query:  *zfs.RootVolumeId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The root volume is tightly coupled
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+
+			if zfs.EndpointIpAddress != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(nil, []string{*zfs.EndpointIpAddress})...)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, fsxRouteTableLinks(zfs.RouteTableIds, scope)...)
+		}
+
+		if windows := fs.WindowsConfiguration; windows != nil {
+			if windows.PreferredFileServerIp != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(nil, []string{*windows.PreferredFileServerIp})...)
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(windows.RemoteAdministrationEndpoint, nil)...)
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+// fsxRouteTableLinks Links to the route tables that FSx manages routes in for
+// Multi-AZ ONTAP and OpenZFS file systems
+func fsxRouteTableLinks(routeTableIDs []string, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0, len(routeTableIDs))
+
+	for _, routeTableID := range routeTableIDs {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-route-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  routeTableID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the routes can make the endpoints unreachable
+				In: true,
+				// FSx adds routes to the table
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+func NewFSxFileSystemAdapter(client *fsx.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeFileSystemsInput, *fsx.DescribeFileSystemsOutput, *fsx.Client, *fsx.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeFileSystemsInput, *fsx.DescribeFileSystemsOutput, *fsx.Client, *fsx.Options]{
+		ItemType:        "fsx-file-system",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: fsxFileSystemAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *fsx.Client, input *fsx.DescribeFileSystemsInput) (*fsx.DescribeFileSystemsOutput, error) {
+			return client.DescribeFileSystems(ctx, input)
+		},
+		PaginatorBuilder: func(client *fsx.Client, params *fsx.DescribeFileSystemsInput) adapterhelpers.Paginator[*fsx.DescribeFileSystemsOutput, *fsx.Options] {
+			return fsx.NewDescribeFileSystemsPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*fsx.DescribeFileSystemsInput, error) {
+			return &fsx.DescribeFileSystemsInput{
+				FileSystemIds: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*fsx.DescribeFileSystemsInput, error) {
+			return &fsx.DescribeFileSystemsInput{}, nil
+		},
+		OutputMapper: fsxFileSystemOutputMapper,
+	}
+}
+
+var fsxFileSystemAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "fsx-file-system",
+	DescriptiveName: "FSx File System",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an FSx file system by ID",
+		ListDescription:   "List all FSx file systems",
+		SearchDescription: "Search for FSx file systems by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_fsx_lustre_file_system.id"},
+		{TerraformQueryMap: "aws_fsx_ontap_file_system.id"},
+		{TerraformQueryMap: "aws_fsx_openzfs_file_system.id"},
+		{TerraformQueryMap: "aws_fsx_windows_file_system.id"},
+	},
+	PotentialLinks: []string{"fsx-volume", "fsx-backup", "fsx-storage-virtual-machine", "ec2-subnet", "ec2-network-interface", "ec2-vpc", "ec2-route-table", "kms-key", "s3-bucket", "dns", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFsxFileSystemOutputMapper(t *testing.T) {
+	output := &fsx.DescribeFileSystemsOutput{
+		FileSystems: []types.FileSystem{
+			{
+				CreationTime:        adapterhelpers.PtrTime(time.Now()),
+				DNSName:             adapterhelpers.PtrString("fs-0123456789abcdef0.fsx.eu-west-2.amazonaws.com"),
+				FileSystemId:        adapterhelpers.PtrString("fs-0123456789abcdef0"),
+				FileSystemType:      types.FileSystemTypeLustre,
+				KmsKeyId:            adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+				Lifecycle:           types.FileSystemLifecycleAvailable,
+				NetworkInterfaceIds: []string{"eni-0123456789abcdef0"},
+				OwnerId:             adapterhelpers.PtrString("123456789012"),
+				ResourceARN:         adapterhelpers.PtrString("arn:aws:fsx:eu-west-2:123456789012:file-system/fs-0123456789abcdef0"),
+				StorageCapacity:     adapterhelpers.PtrInt32(1200),
+				StorageType:         types.StorageTypeSsd,
+				SubnetIds:           []string{"subnet-0123456789abcdef0"},
+				VpcId:               adapterhelpers.PtrString("vpc-0123456789abcdef0"),
+				Tags: []types.Tag{
+					{
+						Key:   adapterhelpers.PtrString("Name"),
+						Value: adapterhelpers.PtrString("scratch"),
+					},
+				},
+				LustreConfiguration: &types.LustreFileSystemConfiguration{
+					DeploymentType: types.LustreDeploymentTypeScratch2,
+					MountName:      adapterhelpers.PtrString("abcdefgh"),
+					DataRepositoryConfiguration: &types.DataRepositoryConfiguration{
+						ImportPath: adapterhelpers.PtrString("s3://lustre-data/import"),
+						ExportPath: adapterhelpers.PtrString("s3://lustre-data/export"),
+						Lifecycle:  types.DataRepositoryLifecycleAvailable,
+					},
+				},
+			},
+		},
+	}
+
+	items, err := fsxFileSystemOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "fsx-volume",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-backup",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eni-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "fs-0123456789abcdef0.fsx.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "lustre-data",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewFSxFileSystemAdapter(t *testing.T) {
+	client, account, region := fsxGetAutoConfig(t)
+
+	adapter := NewFSxFileSystemAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,146 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func fsxStorageVirtualMachineOutputMapper(_ context.Context, _ *fsx.Client, scope string, _ *fsx.DescribeStorageVirtualMachinesInput, output *fsx.DescribeStorageVirtualMachinesOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, svm := range output.StorageVirtualMachines {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(svm, "tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if svm.StorageVirtualMachineId == nil {
+			return nil, errors.New("fsx-storage-virtual-machine has nil id")
+		}
+
+		item := sdp.Item{
+			Type:            "fsx-storage-virtual-machine",
+			UniqueAttribute: "StorageVirtualMachineId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Health:          fsxStorageVirtualMachineLifecycleToHealth(svm.Lifecycle),
+			Tags:            fsxTagsToMap(svm.Tags),
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "fsx-volume",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *svm.StorageVirtualMachineId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The SVM serves these volumes
+						In:  true,
+						Out: true,
+					},
+				},
+			},
+		}
+
+		if svm.FileSystemId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-file-system",
+					Method: sdp.QueryMethod_GET,
+					Query:  *svm.FileSystemId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// SVMs are tightly coupled to their file system
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if svm.Endpoints != nil {
+			for _, endpoint := range []*types.SvmEndpoint{svm.Endpoints.Iscsi, svm.Endpoints.Management, svm.Endpoints.Nfs, svm.Endpoints.Smb} {
+				if endpoint != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(endpoint.DNSName, endpoint.IpAddresses)...)
+				}
+			}
+		}
+
+		if svm.ActiveDirectoryConfiguration != nil && svm.ActiveDirectoryConfiguration.SelfManagedActiveDirectoryConfiguration != nil {
+			ad := svm.ActiveDirectoryConfiguration.SelfManagedActiveDirectoryConfiguration
+
+			if ad.DomainName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, fsxEndpointLinks(ad.DomainName, ad.DnsIps)...)
+			}
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewFSxStorageVirtualMachineAdapter(client *fsx.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeStorageVirtualMachinesInput, *fsx.DescribeStorageVirtualMachinesOutput, *fsx.Client, *fsx.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeStorageVirtualMachinesInput, *fsx.DescribeStorageVirtualMachinesOutput, *fsx.Client, *fsx.Options]{
+		ItemType:        "fsx-storage-virtual-machine",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: fsxStorageVirtualMachineAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *fsx.Client, input *fsx.DescribeStorageVirtualMachinesInput) (*fsx.DescribeStorageVirtualMachinesOutput, error) {
+			return client.DescribeStorageVirtualMachines(ctx, input)
+		},
+		PaginatorBuilder: func(client *fsx.Client, params *fsx.DescribeStorageVirtualMachinesInput) adapterhelpers.Paginator[*fsx.DescribeStorageVirtualMachinesOutput, *fsx.Options] {
+			return fsx.NewDescribeStorageVirtualMachinesPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*fsx.DescribeStorageVirtualMachinesInput, error) {
+			return &fsx.DescribeStorageVirtualMachinesInput{
+				StorageVirtualMachineIds: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*fsx.DescribeStorageVirtualMachinesInput, error) {
+			return &fsx.DescribeStorageVirtualMachinesInput{}, nil
+		},
+		// Search by file system ID
+		InputMapperSearch: func(ctx context.Context, client *fsx.Client, scope, query string) (*fsx.DescribeStorageVirtualMachinesInput, error) {
+			return &fsx.DescribeStorageVirtualMachinesInput{
+				Filters: []types.StorageVirtualMachineFilter{
+					{
+						Name:   types.StorageVirtualMachineFilterNameFileSystemId,
+						Values: []string{query},
+					},
+				},
+			}, nil
+		},
+		OutputMapper: fsxStorageVirtualMachineOutputMapper,
+	}
+}
+
+var fsxStorageVirtualMachineAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "fsx-storage-virtual-machine",
+	DescriptiveName: "FSx Storage Virtual Machine",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an FSx for ONTAP storage virtual machine by ID",
+		ListDescription:   "List all FSx for ONTAP storage virtual machines",
+		SearchDescription: "Search for storage virtual machines by file system ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_fsx_ontap_storage_virtual_machine.id"},
+	},
+	PotentialLinks: []string{"fsx-file-system", "fsx-volume", "dns", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
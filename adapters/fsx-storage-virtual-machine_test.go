@@ -0,0 +1,95 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFsxStorageVirtualMachineOutputMapper(t *testing.T) {
+	output := &fsx.DescribeStorageVirtualMachinesOutput{
+		StorageVirtualMachines: []types.StorageVirtualMachine{
+			{
+				CreationTime:            adapterhelpers.PtrTime(time.Now()),
+				FileSystemId:            adapterhelpers.PtrString("fs-0123456789abcdef0"),
+				Lifecycle:               types.StorageVirtualMachineLifecycleCreated,
+				Name:                    adapterhelpers.PtrString("svm1"),
+				ResourceARN:             adapterhelpers.PtrString("arn:aws:fsx:eu-west-2:123456789012:storage-virtual-machine/fs-0123456789abcdef0/svm-0123456789abcdef0"),
+				StorageVirtualMachineId: adapterhelpers.PtrString("svm-0123456789abcdef0"),
+				Subtype:                 types.StorageVirtualMachineSubtypeDefault,
+				Endpoints: &types.SvmEndpoints{
+					Nfs: &types.SvmEndpoint{
+						DNSName:     adapterhelpers.PtrString("svm-0123456789abcdef0.fs-0123456789abcdef0.fsx.eu-west-2.amazonaws.com"),
+						IpAddresses: []string{"10.0.1.10"},
+					},
+				},
+			},
+		},
+	}
+
+	items, err := fsxStorageVirtualMachineOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "fsx-volume",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "svm-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-file-system",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "svm-0123456789abcdef0.fs-0123456789abcdef0.fsx.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.10",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewFSxStorageVirtualMachineAdapter(t *testing.T) {
+	client, account, region := fsxGetAutoConfig(t)
+
+	adapter := NewFSxStorageVirtualMachineAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
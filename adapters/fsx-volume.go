@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func fsxVolumeOutputMapper(_ context.Context, _ *fsx.Client, scope string, _ *fsx.DescribeVolumesInput, output *fsx.DescribeVolumesOutput) ([]*sdp.Item, error) {
+	if output == nil {
+		return nil, errors.New("nil output from AWS")
+	}
+
+	items := make([]*sdp.Item, 0)
+
+	for _, volume := range output.Volumes {
+		attrs, err := adapterhelpers.ToAttributesWithExclude(volume, "tags")
+
+		if err != nil {
+			return nil, err
+		}
+
+		if volume.VolumeId == nil {
+			return nil, errors.New("fsx-volume has nil id")
+		}
+
+		item := sdp.Item{
+			Type:            "fsx-volume",
+			UniqueAttribute: "VolumeId",
+			Scope:           scope,
+			Attributes:      attrs,
+			Health:          fsxVolumeLifecycleToHealth(volume.Lifecycle),
+			Tags:            fsxTagsToMap(volume.Tags),
+			LinkedItemQueries: []*sdp.LinkedItemQuery{
+				{
+					Query: &sdp.Query{
+						Type:   "fsx-backup",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *volume.VolumeId,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Backups can't affect the volume
+						In: false,
+						// Deleting the volume can remove automatic backups
+						Out: true,
+					},
+				},
+			},
+		}
+
+		if volume.FileSystemId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-file-system",
+					Method: sdp.QueryMethod_GET,
+					Query:  *volume.FileSystemId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Volumes are tightly coupled to their file system
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if ontap := volume.OntapConfiguration; ontap != nil && ontap.StorageVirtualMachineId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-storage-virtual-machine",
+					Method: sdp.QueryMethod_GET,
+					Query:  *ontap.StorageVirtualMachineId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The volume is served by the SVM
+					In: true,
+					// Changes to the volume affect what the SVM serves
+					Out: true,
+				},
+			})
+		}
+
+		if zfs := volume.OpenZFSConfiguration; zfs != nil && zfs.ParentVolumeId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "fsx-volume",
+					Method: sdp.QueryMethod_GET,
+					Query:  *zfs.ParentVolumeId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Child volumes inherit from their parent
+					In: true,
+					// The child can't affect its parent
+					Out: false,
+				},
+			})
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewFSxVolumeAdapter(client *fsx.Client, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeVolumesInput, *fsx.DescribeVolumesOutput, *fsx.Client, *fsx.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*fsx.DescribeVolumesInput, *fsx.DescribeVolumesOutput, *fsx.Client, *fsx.Options]{
+		ItemType:        "fsx-volume",
+		Region:          region,
+		Client:          client,
+		AccountID:       accountID,
+		AdapterMetadata: fsxVolumeAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client *fsx.Client, input *fsx.DescribeVolumesInput) (*fsx.DescribeVolumesOutput, error) {
+			return client.DescribeVolumes(ctx, input)
+		},
+		PaginatorBuilder: func(client *fsx.Client, params *fsx.DescribeVolumesInput) adapterhelpers.Paginator[*fsx.DescribeVolumesOutput, *fsx.Options] {
+			return fsx.NewDescribeVolumesPaginator(client, params)
+		},
+		InputMapperGet: func(scope, query string) (*fsx.DescribeVolumesInput, error) {
+			return &fsx.DescribeVolumesInput{
+				VolumeIds: []string{query},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*fsx.DescribeVolumesInput, error) {
+			return &fsx.DescribeVolumesInput{}, nil
+		},
+		// Search by file system or storage virtual machine ID
+		InputMapperSearch: func(ctx context.Context, client *fsx.Client, scope, query string) (*fsx.DescribeVolumesInput, error) {
+			filterName := types.VolumeFilterNameFileSystemId
+
+			if strings.HasPrefix(query, "svm-") {
+				filterName = types.VolumeFilterNameStorageVirtualMachineId
+			}
+
+			return &fsx.DescribeVolumesInput{
+				Filters: []types.VolumeFilter{
+					{
+						Name:   filterName,
+						Values: []string{query},
+					},
+				},
+			}, nil
+		},
+		OutputMapper: fsxVolumeOutputMapper,
+	}
+}
+
+var fsxVolumeAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "fsx-volume",
+	DescriptiveName: "FSx Volume",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an FSx volume by ID",
+		ListDescription:   "List all FSx volumes",
+		SearchDescription: "Search for FSx volumes by file system ID or storage virtual machine ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_fsx_ontap_volume.id"},
+		{TerraformQueryMap: "aws_fsx_openzfs_volume.id"},
+	},
+	PotentialLinks: []string{"fsx-file-system", "fsx-storage-virtual-machine", "fsx-volume", "fsx-backup"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFsxVolumeOutputMapper(t *testing.T) {
+	output := &fsx.DescribeVolumesOutput{
+		Volumes: []types.Volume{
+			{
+				CreationTime: adapterhelpers.PtrTime(time.Now()),
+				FileSystemId: adapterhelpers.PtrString("fs-0123456789abcdef0"),
+				Lifecycle:    types.VolumeLifecycleMisconfigured,
+				Name:         adapterhelpers.PtrString("vol1"),
+				ResourceARN:  adapterhelpers.PtrString("arn:aws:fsx:eu-west-2:123456789012:volume/fs-0123456789abcdef0/fsvol-0123456789abcdef0"),
+				VolumeId:     adapterhelpers.PtrString("fsvol-0123456789abcdef0"),
+				VolumeType:   types.VolumeTypeOntap,
+				OntapConfiguration: &types.OntapVolumeConfiguration{
+					JunctionPath:            adapterhelpers.PtrString("/vol1"),
+					SizeInMegabytes:         adapterhelpers.PtrInt32(1024),
+					StorageVirtualMachineId: adapterhelpers.PtrString("svm-0123456789abcdef0"),
+				},
+			},
+		},
+	}
+
+	items, err := fsxVolumeOutputMapper(context.Background(), nil, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, item := range items {
+		if err := item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	if len(items) != 1 {
+		t.Fatalf("expected 1 item, got %v", len(items))
+	}
+
+	item := items[0]
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "fsx-backup",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "fsvol-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-file-system",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "fsx-storage-virtual-machine",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "svm-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewFSxVolumeAdapter(t *testing.T) {
+	client, account, region := fsxGetAutoConfig(t)
+
+	adapter := NewFSxVolumeAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,162 @@
+package adapters
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/fsx/types"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+// fsxFileSystemLifecycleToHealth Converts the lifecycle of a file system to a
+// health state
+func fsxFileSystemLifecycleToHealth(lifecycle types.FileSystemLifecycle) *sdp.Health {
+	switch lifecycle {
+	case types.FileSystemLifecycleAvailable:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.FileSystemLifecycleCreating,
+		types.FileSystemLifecycleUpdating,
+		types.FileSystemLifecycleDeleting:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.FileSystemLifecycleMisconfigured:
+		// The file system is still serving data, but FSx can't manage it
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.FileSystemLifecycleFailed,
+		types.FileSystemLifecycleMisconfiguredUnavailable:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// fsxVolumeLifecycleToHealth Converts the lifecycle of a volume to a health
+// state
+func fsxVolumeLifecycleToHealth(lifecycle types.VolumeLifecycle) *sdp.Health {
+	switch lifecycle {
+	case types.VolumeLifecycleAvailable, types.VolumeLifecycleCreated:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.VolumeLifecycleCreating,
+		types.VolumeLifecyclePending,
+		types.VolumeLifecycleDeleting:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.VolumeLifecycleMisconfigured:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.VolumeLifecycleFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// fsxStorageVirtualMachineLifecycleToHealth Converts the lifecycle of a storage
+// virtual machine to a health state
+func fsxStorageVirtualMachineLifecycleToHealth(lifecycle types.StorageVirtualMachineLifecycle) *sdp.Health {
+	switch lifecycle {
+	case types.StorageVirtualMachineLifecycleCreated:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.StorageVirtualMachineLifecycleCreating,
+		types.StorageVirtualMachineLifecyclePending,
+		types.StorageVirtualMachineLifecycleDeleting:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.StorageVirtualMachineLifecycleMisconfigured:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.StorageVirtualMachineLifecycleFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// fsxBackupLifecycleToHealth Converts the lifecycle of a backup to a health
+// state
+func fsxBackupLifecycleToHealth(lifecycle types.BackupLifecycle) *sdp.Health {
+	switch lifecycle {
+	case types.BackupLifecycleAvailable:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.BackupLifecycleCreating,
+		types.BackupLifecyclePending,
+		types.BackupLifecycleTransferring,
+		types.BackupLifecycleCopying:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.BackupLifecycleDeleted:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.BackupLifecycleFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// fsxSubnetLinks Links to the subnets that a resource is deployed into
+func fsxSubnetLinks(subnetIDs []string, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0, len(subnetIDs))
+
+	for _, subnetID := range subnetIDs {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnetID,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the subnet could affect the file system
+				In: true,
+				// The file system can't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	return links
+}
+
+// fsxEndpointLinks Links the DNS name and IP addresses of an FSx endpoint
+func fsxEndpointLinks(dnsName *string, ipAddresses []string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if dnsName != nil {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *dnsName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// DNS is always linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, ip := range ipAddresses {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ip",
+				Method: sdp.QueryMethod_GET,
+				Query:  ip,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// IPs are always bidirectional
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// Converts a slice of FSx tags to a map
+func fsxTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
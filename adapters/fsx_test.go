@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/fsx"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func fsxGetAutoConfig(t *testing.T) (*fsx.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := fsx.NewFromConfig(config)
+
+	return client, account, region
+}
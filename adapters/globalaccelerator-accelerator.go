@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func globalAcceleratorAcceleratorGetFunc(ctx context.Context, client GlobalAcceleratorClient, scope, query string) (*types.Accelerator, error) {
+	out, err := client.DescribeAccelerator(ctx, &globalaccelerator.DescribeAcceleratorInput{
+		AcceleratorArn: adapterhelpers.PtrString(globalAcceleratorAcceleratorARN(scope, query)),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Accelerator == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "accelerator was nil",
+		}
+	}
+
+	return out.Accelerator, nil
+}
+
+func globalAcceleratorAcceleratorItemMapper(_ *string, scope string, awsItem *types.Accelerator) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-accelerator",
+		UniqueAttribute: "AcceleratorArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.AcceleratorStatusDeployed:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.AcceleratorStatusInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	for _, ipSet := range awsItem.IpSets {
+		for _, ip := range ipSet.IpAddresses {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  ip,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	for _, dnsName := range []*string{awsItem.DnsName, awsItem.DualStackDnsName} {
+		if dnsName != nil && *dnsName != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *dnsName,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS is always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.AcceleratorArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-listener",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.AcceleratorArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Listeners can't exist without the accelerator, and
+				// changing them changes what the accelerator does
+				In: true,
+				// Deleting or disabling the accelerator stops all of its
+				// listeners
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlobalAcceleratorAcceleratorAdapter(client GlobalAcceleratorClient, accountID string) *adapterhelpers.GetListAdapterV2[*globalaccelerator.ListAcceleratorsInput, *globalaccelerator.ListAcceleratorsOutput, *types.Accelerator, GlobalAcceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.GetListAdapterV2[*globalaccelerator.ListAcceleratorsInput, *globalaccelerator.ListAcceleratorsOutput, *types.Accelerator, GlobalAcceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-accelerator",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Global Accelerator isn't tied to a region
+		AdapterMetadata: globalAcceleratorAcceleratorAdapterMetadata,
+		GetFunc:         globalAcceleratorAcceleratorGetFunc,
+		InputMapperList: func(scope string) (*globalaccelerator.ListAcceleratorsInput, error) {
+			return &globalaccelerator.ListAcceleratorsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlobalAcceleratorClient, input *globalaccelerator.ListAcceleratorsInput) adapterhelpers.Paginator[*globalaccelerator.ListAcceleratorsOutput, *globalaccelerator.Options] {
+			return globalaccelerator.NewListAcceleratorsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *globalaccelerator.ListAcceleratorsOutput, client GlobalAcceleratorClient) ([]*types.Accelerator, error) {
+			accelerators := make([]*types.Accelerator, 0, len(output.Accelerators))
+
+			for i := range output.Accelerators {
+				accelerators = append(accelerators, &output.Accelerators[i])
+			}
+
+			return accelerators, nil
+		},
+		ItemMapper: globalAcceleratorAcceleratorItemMapper,
+		ListTagsFunc: func(ctx context.Context, accelerator *types.Accelerator, client GlobalAcceleratorClient) (map[string]string, error) {
+			if accelerator.AcceleratorArn == nil {
+				return nil, nil
+			}
+
+			return globalAcceleratorListTags(ctx, client, *accelerator.AcceleratorArn)
+		},
+	}
+}
+
+var globalAcceleratorAcceleratorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-accelerator",
+	DescriptiveName: "Global Accelerator",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an accelerator by ARN or ID",
+		ListDescription:   "List all accelerators",
+		SearchDescription: "Search for accelerators by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_globalaccelerator_accelerator.id"},
+	},
+	PotentialLinks: []string{"ip", "dns", "globalaccelerator-listener"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,108 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlobalAcceleratorAcceleratorItemMapper(t *testing.T) {
+	accelerator := &types.Accelerator{
+		AcceleratorArn:   adapterhelpers.PtrString("arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"),
+		Name:             adapterhelpers.PtrString("my-accelerator"),
+		CreatedTime:      adapterhelpers.PtrTime(time.Now()),
+		LastModifiedTime: adapterhelpers.PtrTime(time.Now()),
+		DnsName:          adapterhelpers.PtrString("a1234567890abcdef.awsglobalaccelerator.com"),
+		DualStackDnsName: adapterhelpers.PtrString("a1234567890abcdef.dualstack.awsglobalaccelerator.com"),
+		Enabled:          adapterhelpers.PtrBool(true),
+		IpAddressType:    types.IpAddressTypeDualStack,
+		IpSets: []types.IpSet{
+			{
+				IpAddressFamily: types.IpAddressFamilyIPv4,
+				IpAddresses: []string{
+					"192.0.2.250",
+					"198.51.100.52",
+				},
+			},
+			{
+				IpAddressFamily: types.IpAddressFamilyIPv6,
+				IpAddresses: []string{
+					"2001:db8::1",
+				},
+			},
+		},
+		Status: types.AcceleratorStatusDeployed,
+	}
+
+	item, err := globalAcceleratorAcceleratorItemMapper(nil, "123456789012", accelerator)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.0.2.250",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "198.51.100.52",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "2001:db8::1",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1234567890abcdef.awsglobalaccelerator.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "a1234567890abcdef.dualstack.awsglobalaccelerator.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "globalaccelerator-listener",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewGlobalAcceleratorAcceleratorAdapter(t *testing.T) {
+	client, account, _ := globalAcceleratorGetAutoConfig(t)
+
+	adapter := NewGlobalAcceleratorAcceleratorAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,224 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// globalAcceleratorEndpointGroupHealth Works out the health of an endpoint
+// group from the health of its endpoints. If some endpoints are unhealthy the
+// accelerator can still route traffic to the rest, if they all are then the
+// group is effectively down
+func globalAcceleratorEndpointGroupHealth(endpoints []types.EndpointDescription) *sdp.Health {
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	var unhealthy, initial int
+
+	for _, endpoint := range endpoints {
+		switch endpoint.HealthState {
+		case types.HealthStateUnhealthy:
+			unhealthy++
+		case types.HealthStateInitial:
+			initial++
+		}
+	}
+
+	switch {
+	case unhealthy == len(endpoints):
+		return sdp.Health_HEALTH_ERROR.Enum()
+	case unhealthy > 0:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case initial > 0:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	default:
+		return sdp.Health_HEALTH_OK.Enum()
+	}
+}
+
+// globalAcceleratorEndpointLink Links to the resource behind an endpoint. The
+// endpoint ID is the ARN of an ALB or NLB, the allocation ID of an Elastic IP
+// or the ID of an EC2 instance, all of which live in the endpoint group's
+// region
+func globalAcceleratorEndpointLink(endpointID string, accountID string, region string) *sdp.LinkedItemQuery {
+	bp := &sdp.BlastPropagation{
+		// If the endpoint is unhealthy or deleted the accelerator can't
+		// route traffic to it
+		In: true,
+		// Changing the endpoint group changes how much traffic the endpoint
+		// receives
+		Out: true,
+	}
+
+	if a, err := adapterhelpers.ParseARN(endpointID); err == nil {
+		if a.Service != "elasticloadbalancing" {
+			return nil
+		}
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "elbv2-load-balancer",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  endpointID,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: bp,
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(endpointID, "eipalloc-"):
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-address",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  endpointID,
+				Scope:  adapterhelpers.FormatScope(accountID, region),
+			},
+			BlastPropagation: bp,
+		}
+	case strings.HasPrefix(endpointID, "i-"):
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  endpointID,
+				Scope:  adapterhelpers.FormatScope(accountID, region),
+			},
+			BlastPropagation: bp,
+		}
+	}
+
+	return nil
+}
+
+func globalAcceleratorEndpointGroupGetFunc(ctx context.Context, client GlobalAcceleratorClient, scope string, input *globalaccelerator.DescribeEndpointGroupInput) (*sdp.Item, error) {
+	out, err := client.DescribeEndpointGroup(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.EndpointGroup == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "endpoint group was nil",
+		}
+	}
+
+	endpointGroup := out.EndpointGroup
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(endpointGroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-endpoint-group",
+		UniqueAttribute: "EndpointGroupArn",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          globalAcceleratorEndpointGroupHealth(endpointGroup.EndpointDescriptions),
+	}
+
+	if endpointGroup.EndpointGroupArn != nil {
+		if listenerARN := globalAcceleratorParentARN(*endpointGroup.EndpointGroupArn, "/endpoint-group/"); listenerARN != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "globalaccelerator-listener",
+					Method: sdp.QueryMethod_GET,
+					Query:  listenerARN,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The listener decides which traffic reaches the group
+					In: true,
+					// Unhealthy endpoint groups affect the listener's traffic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// Global Accelerator isn't regional so the scope is just the account ID,
+	// the endpoints themselves are in the endpoint group's region
+	accountID := scope
+
+	var region string
+	if endpointGroup.EndpointGroupRegion != nil {
+		region = *endpointGroup.EndpointGroupRegion
+	}
+
+	for _, endpoint := range endpointGroup.EndpointDescriptions {
+		if endpoint.EndpointId == nil {
+			continue
+		}
+
+		if link := globalAcceleratorEndpointLink(*endpoint.EndpointId, accountID, region); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewGlobalAcceleratorEndpointGroupAdapter(client GlobalAcceleratorClient, accountID string) *adapterhelpers.AlwaysGetAdapter[*globalaccelerator.ListEndpointGroupsInput, *globalaccelerator.ListEndpointGroupsOutput, *globalaccelerator.DescribeEndpointGroupInput, *globalaccelerator.DescribeEndpointGroupOutput, GlobalAcceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*globalaccelerator.ListEndpointGroupsInput, *globalaccelerator.ListEndpointGroupsOutput, *globalaccelerator.DescribeEndpointGroupInput, *globalaccelerator.DescribeEndpointGroupOutput, GlobalAcceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-endpoint-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Global Accelerator isn't tied to a region
+		AdapterMetadata: globalAcceleratorEndpointGroupAdapterMetadata,
+		// Endpoint groups can only be listed per listener
+		DisableList: true,
+		SearchInputMapper: func(scope, query string) (*globalaccelerator.ListEndpointGroupsInput, error) {
+			return &globalaccelerator.ListEndpointGroupsInput{
+				ListenerArn: &query,
+			}, nil
+		},
+		GetInputMapper: func(scope, query string) *globalaccelerator.DescribeEndpointGroupInput {
+			return &globalaccelerator.DescribeEndpointGroupInput{
+				EndpointGroupArn: &query,
+			}
+		},
+		ListFuncPaginatorBuilder: func(client GlobalAcceleratorClient, input *globalaccelerator.ListEndpointGroupsInput) adapterhelpers.Paginator[*globalaccelerator.ListEndpointGroupsOutput, *globalaccelerator.Options] {
+			return globalaccelerator.NewListEndpointGroupsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *globalaccelerator.ListEndpointGroupsOutput, _ *globalaccelerator.ListEndpointGroupsInput) ([]*globalaccelerator.DescribeEndpointGroupInput, error) {
+			inputs := make([]*globalaccelerator.DescribeEndpointGroupInput, 0, len(output.EndpointGroups))
+
+			for i := range output.EndpointGroups {
+				inputs = append(inputs, &globalaccelerator.DescribeEndpointGroupInput{
+					EndpointGroupArn: output.EndpointGroups[i].EndpointGroupArn,
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: globalAcceleratorEndpointGroupGetFunc,
+	}
+}
+
+var globalAcceleratorEndpointGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-endpoint-group",
+	DescriptiveName: "Global Accelerator Endpoint Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an endpoint group by ARN",
+		SearchDescription: "Search for endpoint groups by listener ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_globalaccelerator_endpoint_group.id"},
+	},
+	PotentialLinks: []string{"globalaccelerator-listener", "elbv2-load-balancer", "ec2-address", "ec2-instance"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
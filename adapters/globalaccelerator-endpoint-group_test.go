@@ -0,0 +1,165 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlobalAcceleratorEndpointGroupGetFunc(t *testing.T) {
+	client := GlobalAcceleratorTestClient{
+		DescribeEndpointGroupOutput: &globalaccelerator.DescribeEndpointGroupOutput{
+			EndpointGroup: &types.EndpointGroup{
+				EndpointGroupArn:           adapterhelpers.PtrString("arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/098765zyxwvu"),
+				EndpointGroupRegion:        adapterhelpers.PtrString("eu-west-2"),
+				HealthCheckIntervalSeconds: adapterhelpers.PtrInt32(30),
+				HealthCheckPath:            adapterhelpers.PtrString("/"),
+				HealthCheckPort:            adapterhelpers.PtrInt32(80),
+				HealthCheckProtocol:        types.HealthCheckProtocolTcp,
+				ThresholdCount:             adapterhelpers.PtrInt32(3),
+				TrafficDialPercentage:      adapterhelpers.PtrFloat32(100),
+				EndpointDescriptions: []types.EndpointDescription{
+					{
+						EndpointId:  adapterhelpers.PtrString("arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef"),
+						HealthState: types.HealthStateHealthy,
+						Weight:      adapterhelpers.PtrInt32(128),
+					},
+					{
+						EndpointId:   adapterhelpers.PtrString("eipalloc-0123456789abcdef0"),
+						HealthState:  types.HealthStateUnhealthy,
+						HealthReason: adapterhelpers.PtrString("Health checks failed"),
+						Weight:       adapterhelpers.PtrInt32(128),
+					},
+					{
+						EndpointId:  adapterhelpers.PtrString("i-0123456789abcdef0"),
+						HealthState: types.HealthStateHealthy,
+						Weight:      adapterhelpers.PtrInt32(128),
+					},
+				},
+			},
+		},
+	}
+
+	item, err := globalAcceleratorEndpointGroupGetFunc(context.Background(), client, "123456789012", &globalaccelerator.DescribeEndpointGroupInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "globalaccelerator-listener",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "elbv2-load-balancer",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/my-alb/1234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-address",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "eipalloc-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlobalAcceleratorEndpointGroupHealth(t *testing.T) {
+	tests := []struct {
+		Name     string
+		States   []types.HealthState
+		Expected *sdp.Health
+	}{
+		{
+			Name:     "no endpoints",
+			States:   []types.HealthState{},
+			Expected: nil,
+		},
+		{
+			Name:     "all healthy",
+			States:   []types.HealthState{types.HealthStateHealthy, types.HealthStateHealthy},
+			Expected: sdp.Health_HEALTH_OK.Enum(),
+		},
+		{
+			Name:     "initial",
+			States:   []types.HealthState{types.HealthStateHealthy, types.HealthStateInitial},
+			Expected: sdp.Health_HEALTH_PENDING.Enum(),
+		},
+		{
+			Name:     "some unhealthy",
+			States:   []types.HealthState{types.HealthStateHealthy, types.HealthStateUnhealthy},
+			Expected: sdp.Health_HEALTH_WARNING.Enum(),
+		},
+		{
+			Name:     "all unhealthy",
+			States:   []types.HealthState{types.HealthStateUnhealthy, types.HealthStateUnhealthy},
+			Expected: sdp.Health_HEALTH_ERROR.Enum(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			endpoints := make([]types.EndpointDescription, 0, len(test.States))
+
+			for _, state := range test.States {
+				endpoints = append(endpoints, types.EndpointDescription{
+					HealthState: state,
+				})
+			}
+
+			health := globalAcceleratorEndpointGroupHealth(endpoints)
+
+			if test.Expected == nil {
+				if health != nil {
+					t.Errorf("expected no health, got %v", health)
+				}
+
+				return
+			}
+
+			if health == nil || *health != *test.Expected {
+				t.Errorf("expected %v, got %v", test.Expected, health)
+			}
+		})
+	}
+}
+
+func TestNewGlobalAcceleratorEndpointGroupAdapter(t *testing.T) {
+	client, account, _ := globalAcceleratorGetAutoConfig(t)
+
+	adapter := NewGlobalAcceleratorEndpointGroupAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:           adapter,
+		Timeout:           10 * time.Second,
+		SkipNotFoundCheck: true,
+	}
+
+	test.Run(t)
+}
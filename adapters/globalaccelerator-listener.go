@@ -0,0 +1,131 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func globalAcceleratorListenerGetFunc(ctx context.Context, client GlobalAcceleratorClient, scope string, input *globalaccelerator.DescribeListenerInput) (*sdp.Item, error) {
+	out, err := client.DescribeListener(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Listener == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "listener was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(out.Listener)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "globalaccelerator-listener",
+		UniqueAttribute: "ListenerArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if out.Listener.ListenerArn != nil {
+		// The listener ARN is nested under the accelerator ARN e.g.
+		// arn:aws:globalaccelerator::123456789012:accelerator/{id}/listener/{id}
+		if acceleratorARN := globalAcceleratorParentARN(*out.Listener.ListenerArn, "/listener/"); acceleratorARN != "" {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "globalaccelerator-accelerator",
+					Method: sdp.QueryMethod_GET,
+					Query:  acceleratorARN,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Disabling or deleting the accelerator stops the listener
+					In: true,
+					// Changing the listener changes what traffic the
+					// accelerator accepts
+					Out: true,
+				},
+			})
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "globalaccelerator-endpoint-group",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *out.Listener.ListenerArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Endpoint groups are where the listener sends its traffic
+				In: true,
+				// Changing the listener's ports or protocol affects the
+				// endpoint groups
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlobalAcceleratorListenerAdapter(client GlobalAcceleratorClient, accountID string) *adapterhelpers.AlwaysGetAdapter[*globalaccelerator.ListListenersInput, *globalaccelerator.ListListenersOutput, *globalaccelerator.DescribeListenerInput, *globalaccelerator.DescribeListenerOutput, GlobalAcceleratorClient, *globalaccelerator.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*globalaccelerator.ListListenersInput, *globalaccelerator.ListListenersOutput, *globalaccelerator.DescribeListenerInput, *globalaccelerator.DescribeListenerOutput, GlobalAcceleratorClient, *globalaccelerator.Options]{
+		ItemType:        "globalaccelerator-listener",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Global Accelerator isn't tied to a region
+		AdapterMetadata: globalAcceleratorListenerAdapterMetadata,
+		// Listeners can only be listed per accelerator
+		DisableList: true,
+		SearchInputMapper: func(scope, query string) (*globalaccelerator.ListListenersInput, error) {
+			return &globalaccelerator.ListListenersInput{
+				AcceleratorArn: adapterhelpers.PtrString(globalAcceleratorAcceleratorARN(scope, query)),
+			}, nil
+		},
+		GetInputMapper: func(scope, query string) *globalaccelerator.DescribeListenerInput {
+			return &globalaccelerator.DescribeListenerInput{
+				ListenerArn: &query,
+			}
+		},
+		ListFuncPaginatorBuilder: func(client GlobalAcceleratorClient, input *globalaccelerator.ListListenersInput) adapterhelpers.Paginator[*globalaccelerator.ListListenersOutput, *globalaccelerator.Options] {
+			return globalaccelerator.NewListListenersPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *globalaccelerator.ListListenersOutput, _ *globalaccelerator.ListListenersInput) ([]*globalaccelerator.DescribeListenerInput, error) {
+			inputs := make([]*globalaccelerator.DescribeListenerInput, 0, len(output.Listeners))
+
+			for i := range output.Listeners {
+				inputs = append(inputs, &globalaccelerator.DescribeListenerInput{
+					ListenerArn: output.Listeners[i].ListenerArn,
+				})
+			}
+
+			return inputs, nil
+		},
+		GetFunc: globalAcceleratorListenerGetFunc,
+	}
+}
+
+var globalAcceleratorListenerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "globalaccelerator-listener",
+	DescriptiveName: "Global Accelerator Listener",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a listener by ARN",
+		SearchDescription: "Search for listeners by accelerator ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_globalaccelerator_listener.id"},
+	},
+	PotentialLinks: []string{"globalaccelerator-accelerator", "globalaccelerator-endpoint-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlobalAcceleratorListenerGetFunc(t *testing.T) {
+	client := GlobalAcceleratorTestClient{
+		DescribeListenerOutput: &globalaccelerator.DescribeListenerOutput{
+			Listener: &types.Listener{
+				ListenerArn:    adapterhelpers.PtrString("arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz"),
+				ClientAffinity: types.ClientAffinityNone,
+				Protocol:       types.ProtocolTcp,
+				PortRanges: []types.PortRange{
+					{
+						FromPort: adapterhelpers.PtrInt32(80),
+						ToPort:   adapterhelpers.PtrInt32(80),
+					},
+				},
+			},
+		},
+	}
+
+	item, err := globalAcceleratorListenerGetFunc(context.Background(), client, "123456789012", &globalaccelerator.DescribeListenerInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "globalaccelerator-accelerator",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "globalaccelerator-endpoint-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh/listener/0123vxyz",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewGlobalAcceleratorListenerAdapter(t *testing.T) {
+	client, account, _ := globalAcceleratorGetAutoConfig(t)
+
+	adapter := NewGlobalAcceleratorListenerAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:           adapter,
+		Timeout:           10 * time.Second,
+		SkipNotFoundCheck: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,73 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type GlobalAcceleratorClient interface {
+	DescribeAccelerator(ctx context.Context, params *globalaccelerator.DescribeAcceleratorInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeAcceleratorOutput, error)
+	DescribeListener(ctx context.Context, params *globalaccelerator.DescribeListenerInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeListenerOutput, error)
+	DescribeEndpointGroup(ctx context.Context, params *globalaccelerator.DescribeEndpointGroupInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeEndpointGroupOutput, error)
+	ListTagsForResource(ctx context.Context, params *globalaccelerator.ListTagsForResourceInput, optFns ...func(*globalaccelerator.Options)) (*globalaccelerator.ListTagsForResourceOutput, error)
+
+	globalaccelerator.ListAcceleratorsAPIClient
+	globalaccelerator.ListListenersAPIClient
+	globalaccelerator.ListEndpointGroupsAPIClient
+}
+
+// globalAcceleratorAcceleratorARN Returns the ARN of an accelerator. Queries
+// can either be the full ARN or just the ID at the end of it e.g.
+// arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh
+func globalAcceleratorAcceleratorARN(scope, query string) string {
+	if _, err := adapterhelpers.ParseARN(query); err == nil {
+		return query
+	}
+
+	// Global Accelerator isn't regional so the scope is just the account ID
+	return fmt.Sprintf("arn:aws:globalaccelerator::%v:accelerator/%v", scope, query)
+}
+
+// globalAcceleratorParentARN Returns the ARN of the parent of a listener or
+// endpoint group. These ARNs are nested so the parent ARN is everything
+// before the given child segment e.g. "/listener/" or "/endpoint-group/"
+func globalAcceleratorParentARN(arn string, childSegment string) string {
+	if i := strings.Index(arn, childSegment); i > 0 {
+		return arn[:i]
+	}
+
+	return ""
+}
+
+// globalAcceleratorListTags Gets the tags for a Global Accelerator resource.
+// Only accelerators can be tagged
+func globalAcceleratorListTags(ctx context.Context, client GlobalAcceleratorClient, arn string) (map[string]string, error) {
+	out, err := client.ListTagsForResource(ctx, &globalaccelerator.ListTagsForResourceInput{
+		ResourceArn: &arn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return globalAcceleratorTagsToMap(out.Tags), nil
+}
+
+// Converts a slice of Global Accelerator tags to a map
+func globalAcceleratorTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
@@ -0,0 +1,102 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type GlobalAcceleratorTestClient struct {
+	DescribeAcceleratorOutput   *globalaccelerator.DescribeAcceleratorOutput
+	DescribeListenerOutput      *globalaccelerator.DescribeListenerOutput
+	DescribeEndpointGroupOutput *globalaccelerator.DescribeEndpointGroupOutput
+	ListTagsForResourceOutput   *globalaccelerator.ListTagsForResourceOutput
+	ListAcceleratorsOutput      *globalaccelerator.ListAcceleratorsOutput
+	ListListenersOutput         *globalaccelerator.ListListenersOutput
+	ListEndpointGroupsOutput    *globalaccelerator.ListEndpointGroupsOutput
+}
+
+func (t GlobalAcceleratorTestClient) DescribeAccelerator(context.Context, *globalaccelerator.DescribeAcceleratorInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeAcceleratorOutput, error) {
+	return t.DescribeAcceleratorOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) DescribeListener(context.Context, *globalaccelerator.DescribeListenerInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeListenerOutput, error) {
+	return t.DescribeListenerOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) DescribeEndpointGroup(context.Context, *globalaccelerator.DescribeEndpointGroupInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.DescribeEndpointGroupOutput, error) {
+	return t.DescribeEndpointGroupOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) ListTagsForResource(context.Context, *globalaccelerator.ListTagsForResourceInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) ListAccelerators(context.Context, *globalaccelerator.ListAcceleratorsInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.ListAcceleratorsOutput, error) {
+	return t.ListAcceleratorsOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) ListListeners(context.Context, *globalaccelerator.ListListenersInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.ListListenersOutput, error) {
+	return t.ListListenersOutput, nil
+}
+
+func (t GlobalAcceleratorTestClient) ListEndpointGroups(context.Context, *globalaccelerator.ListEndpointGroupsInput, ...func(*globalaccelerator.Options)) (*globalaccelerator.ListEndpointGroupsOutput, error) {
+	return t.ListEndpointGroupsOutput, nil
+}
+
+func globalAcceleratorGetAutoConfig(t *testing.T) (*globalaccelerator.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := globalaccelerator.NewFromConfig(config, func(o *globalaccelerator.Options) {
+		// The Global Accelerator API is only available in us-west-2
+		o.Region = "us-west-2"
+	})
+
+	return client, account, region
+}
+
+func TestGlobalAcceleratorAcceleratorARN(t *testing.T) {
+	expected := "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd-abcd-1234-abcd-1234abcdefgh"
+
+	tests := []string{
+		"1234abcd-abcd-1234-abcd-1234abcdefgh",
+		expected,
+	}
+
+	for _, query := range tests {
+		if arn := globalAcceleratorAcceleratorARN("123456789012", query); arn != expected {
+			t.Errorf("expected %v from %v, got %v", expected, query, arn)
+		}
+	}
+}
+
+func TestGlobalAcceleratorParentARN(t *testing.T) {
+	tests := []struct {
+		ARN          string
+		ChildSegment string
+		Expected     string
+	}{
+		{
+			ARN:          "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz",
+			ChildSegment: "/listener/",
+			Expected:     "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd",
+		},
+		{
+			ARN:          "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz/endpoint-group/098765zyxwvu",
+			ChildSegment: "/endpoint-group/",
+			Expected:     "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd/listener/0123vxyz",
+		},
+		{
+			ARN:          "arn:aws:globalaccelerator::123456789012:accelerator/1234abcd",
+			ChildSegment: "/listener/",
+			Expected:     "",
+		},
+	}
+
+	for _, test := range tests {
+		if parent := globalAcceleratorParentARN(test.ARN, test.ChildSegment); parent != test.Expected {
+			t.Errorf("expected %v from %v, got %v", test.Expected, test.ARN, parent)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package adapters
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueConnectionGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Connection, error) {
+	out, err := client.GetConnection(ctx, &glue.GetConnectionInput{
+		Name: &query,
+		// Never return credentials
+		HidePassword: true,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Connection == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "connection was nil",
+		}
+	}
+
+	return out.Connection, nil
+}
+
+func glueConnectionItemMapper(_ *string, scope string, awsItem *types.Connection) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-connection",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.ConnectionStatusReady:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ConnectionStatusInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ConnectionStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if pcr := awsItem.PhysicalConnectionRequirements; pcr != nil {
+		if pcr.SubnetId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  *pcr.SubnetId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Glue creates ENIs in the subnet to reach the data store
+					In: true,
+					// The connection can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range pcr.SecurityGroupIdList {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  sg,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the security group could stop Glue reaching
+					// the data store
+					In: true,
+					// The connection can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if url, ok := awsItem.ConnectionProperties[string(types.ConnectionPropertyKeyJdbcConnectionUrl)]; ok {
+		if host, ok := glueJDBCHost(url); ok {
+			if net.ParseIP(host) != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ip",
+						Method: sdp.QueryMethod_GET,
+						Query:  host,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// IPs are always linked
+						In:  true,
+						Out: true,
+					},
+				})
+			} else {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dns",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  host,
+						Scope:  "global",
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// DNS is always linked
+						In:  true,
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if servers, ok := awsItem.ConnectionProperties[string(types.ConnectionPropertyKeyKafkaBootstrapServers)]; ok {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kafkaBrokerDNSLinks(&servers)...)
+	}
+
+	return &item, nil
+}
+
+func NewGlueConnectionAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*glue.GetConnectionsInput, *glue.GetConnectionsOutput, *types.Connection, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapterV2[*glue.GetConnectionsInput, *glue.GetConnectionsOutput, *types.Connection, GlueClient, *glue.Options]{
+		ItemType:        "glue-connection",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueConnectionAdapterMetadata,
+		GetFunc:         glueConnectionGetFunc,
+		InputMapperList: func(scope string) (*glue.GetConnectionsInput, error) {
+			return &glue.GetConnectionsInput{
+				// Never return credentials
+				HidePassword: true,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlueClient, input *glue.GetConnectionsInput) adapterhelpers.Paginator[*glue.GetConnectionsOutput, *glue.Options] {
+			return glue.NewGetConnectionsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *glue.GetConnectionsOutput, client GlueClient) ([]*types.Connection, error) {
+			connections := make([]*types.Connection, 0, len(output.ConnectionList))
+
+			for i := range output.ConnectionList {
+				connections = append(connections, &output.ConnectionList[i])
+			}
+
+			return connections, nil
+		},
+		ItemMapper: glueConnectionItemMapper,
+		ListTagsFunc: func(ctx context.Context, connection *types.Connection, client GlueClient) (map[string]string, error) {
+			if connection.Name == nil {
+				return nil, nil
+			}
+
+			return glueListTags(ctx, client, accountID, region, "connection", *connection.Name)
+		},
+	}
+}
+
+var glueConnectionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-connection",
+	DescriptiveName: "Glue Connection",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Glue connection by name",
+		ListDescription:   "List all Glue connections",
+		SearchDescription: "Search for Glue connections by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_connection.name"},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group", "dns", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueConnectionItemMapper(t *testing.T) {
+	connection := &types.Connection{
+		Name:           adapterhelpers.PtrString("sales-postgres"),
+		ConnectionType: types.ConnectionTypeJdbc,
+		Status:         types.ConnectionStatusReady,
+		CreationTime:   adapterhelpers.PtrTime(time.Now()),
+		ConnectionProperties: map[string]string{
+			"JDBC_CONNECTION_URL": "jdbc:postgresql://sales.cluster-abc123.eu-west-2.rds.amazonaws.com:5432/sales",
+			"USERNAME":            "glue",
+		},
+		PhysicalConnectionRequirements: &types.PhysicalConnectionRequirements{
+			AvailabilityZone:    adapterhelpers.PtrString("eu-west-2a"),
+			SubnetId:            adapterhelpers.PtrString("subnet-0a1b2c3d"),
+			SecurityGroupIdList: []string{"sg-0123456789abcdef0"},
+		},
+	}
+
+	item, err := glueConnectionItemMapper(nil, "123456789012.eu-west-2", connection)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sales.cluster-abc123.eu-west-2.rds.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGlueKafkaConnectionItemMapper(t *testing.T) {
+	connection := &types.Connection{
+		Name:           adapterhelpers.PtrString("events-kafka"),
+		ConnectionType: types.ConnectionTypeKafka,
+		ConnectionProperties: map[string]string{
+			"KAFKA_BOOTSTRAP_SERVERS": "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094,b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094",
+		},
+	}
+
+	item, err := glueConnectionItemMapper(nil, "123456789012.eu-west-2", connection)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewGlueConnectionAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueConnectionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,294 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueCrawlerGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Crawler, error) {
+	out, err := client.GetCrawler(ctx, &glue.GetCrawlerInput{
+		Name: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Crawler == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "crawler was nil",
+		}
+	}
+
+	return out.Crawler, nil
+}
+
+// glueCrawlerHealth Works out the health of a crawler based on its current
+// state and the outcome of the last crawl
+func glueCrawlerHealth(crawler *types.Crawler) *sdp.Health {
+	if crawler.State == types.CrawlerStateRunning || crawler.State == types.CrawlerStateStopping {
+		return sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if crawler.LastCrawl == nil {
+		// The crawler has never run
+		return nil
+	}
+
+	switch crawler.LastCrawl.Status {
+	case types.LastCrawlStatusSucceeded:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.LastCrawlStatusCancelled:
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.LastCrawlStatusFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+func glueCrawlerItemMapper(_ *string, scope string, awsItem *types.Crawler) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-crawler",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          glueCrawlerHealth(awsItem),
+	}
+
+	if awsItem.Role != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.Role, scope))
+	}
+
+	if awsItem.DatabaseName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-database",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.DatabaseName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The crawler can't write tables if the database changes
+				In: true,
+				// The crawler creates and updates tables in the database
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.LastCrawl != nil && awsItem.LastCrawl.LogGroup != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "logs-log-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.LastCrawl.LogGroup,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The crawler writes to the log group
+				In:  false,
+				Out: true,
+			},
+		})
+	}
+
+	if targets := awsItem.Targets; targets != nil {
+		buckets := make(map[string]bool)
+		connections := make(map[string]bool)
+
+		addS3 := func(uri string) {
+			link := s3BucketLink(uri, scope, &sdp.BlastPropagation{
+				// Changes to the bucket affect the data that is crawled
+				In: true,
+				// The crawler only reads from the bucket
+				Out: false,
+			})
+
+			if link != nil && !buckets[link.GetQuery().GetQuery()] {
+				buckets[link.GetQuery().GetQuery()] = true
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		addConnection := func(name *string) {
+			if name != nil && *name != "" && !connections[*name] {
+				connections[*name] = true
+				item.LinkedItemQueries = append(item.LinkedItemQueries, glueConnectionLink(*name, scope))
+			}
+		}
+
+		for _, target := range targets.S3Targets {
+			if target.Path != nil {
+				addS3(*target.Path)
+			}
+
+			addConnection(target.ConnectionName)
+
+			for _, queueARN := range []*string{target.EventQueueArn, target.DlqEventQueueArn} {
+				if queueARN == nil {
+					continue
+				}
+
+				if a, err := adapterhelpers.ParseARN(*queueARN); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "sqs-queue",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *queueARN,
+							Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The crawler reads S3 events from the queue
+							In: true,
+							// The crawler consumes messages from the queue
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+
+		for _, target := range targets.JdbcTargets {
+			addConnection(target.ConnectionName)
+		}
+
+		for _, target := range targets.MongoDBTargets {
+			addConnection(target.ConnectionName)
+		}
+
+		for _, target := range targets.DeltaTargets {
+			for _, path := range target.DeltaTables {
+				addS3(path)
+			}
+
+			addConnection(target.ConnectionName)
+		}
+
+		for _, target := range targets.HudiTargets {
+			for _, path := range target.Paths {
+				addS3(path)
+			}
+
+			addConnection(target.ConnectionName)
+		}
+
+		for _, target := range targets.IcebergTargets {
+			for _, path := range target.Paths {
+				addS3(path)
+			}
+
+			addConnection(target.ConnectionName)
+		}
+
+		for _, target := range targets.CatalogTargets {
+			addConnection(target.ConnectionName)
+
+			if target.DatabaseName == nil {
+				continue
+			}
+
+			for _, table := range target.Tables {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "glue-table",
+						Method: sdp.QueryMethod_GET,
+						Query:  *target.DatabaseName + "/" + table,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The crawler reads the table's location
+						In: true,
+						// The crawler updates the table's schema
+						Out: true,
+					},
+				})
+			}
+		}
+
+		for _, target := range targets.DynamoDBTargets {
+			if target.Path != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "dynamodb-table",
+						Method: sdp.QueryMethod_GET,
+						Query:  *target.Path,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the table affect what is crawled
+						In: true,
+						// Crawling consumes read capacity
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewGlueCrawlerAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*glue.GetCrawlersInput, *glue.GetCrawlersOutput, *types.Crawler, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapterV2[*glue.GetCrawlersInput, *glue.GetCrawlersOutput, *types.Crawler, GlueClient, *glue.Options]{
+		ItemType:        "glue-crawler",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueCrawlerAdapterMetadata,
+		GetFunc:         glueCrawlerGetFunc,
+		InputMapperList: func(scope string) (*glue.GetCrawlersInput, error) {
+			return &glue.GetCrawlersInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlueClient, input *glue.GetCrawlersInput) adapterhelpers.Paginator[*glue.GetCrawlersOutput, *glue.Options] {
+			return glue.NewGetCrawlersPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *glue.GetCrawlersOutput, client GlueClient) ([]*types.Crawler, error) {
+			crawlers := make([]*types.Crawler, 0, len(output.Crawlers))
+
+			for i := range output.Crawlers {
+				crawlers = append(crawlers, &output.Crawlers[i])
+			}
+
+			return crawlers, nil
+		},
+		ItemMapper: glueCrawlerItemMapper,
+		ListTagsFunc: func(ctx context.Context, crawler *types.Crawler, client GlueClient) (map[string]string, error) {
+			if crawler.Name == nil {
+				return nil, nil
+			}
+
+			return glueListTags(ctx, client, accountID, region, "crawler", *crawler.Name)
+		},
+	}
+}
+
+var glueCrawlerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-crawler",
+	DescriptiveName: "Glue Crawler",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Glue crawler by name",
+		ListDescription:   "List all Glue crawlers",
+		SearchDescription: "Search for Glue crawlers by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_crawler.name"},
+	},
+	PotentialLinks: []string{"iam-role", "glue-database", "glue-table", "glue-connection", "s3-bucket", "sqs-queue", "dynamodb-table", "logs-log-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueCrawlerItemMapper(t *testing.T) {
+	crawler := &types.Crawler{
+		Name:         adapterhelpers.PtrString("sales-crawler"),
+		Role:         adapterhelpers.PtrString("service-role/AWSGlueServiceRole-sales"),
+		DatabaseName: adapterhelpers.PtrString("sales"),
+		State:        types.CrawlerStateReady,
+		CreationTime: adapterhelpers.PtrTime(time.Now()),
+		LastCrawl: &types.LastCrawlInfo{
+			Status:       types.LastCrawlStatusFailed,
+			ErrorMessage: adapterhelpers.PtrString("Access Denied"),
+			LogGroup:     adapterhelpers.PtrString("/aws-glue/crawlers"),
+			LogStream:    adapterhelpers.PtrString("sales-crawler"),
+		},
+		Targets: &types.CrawlerTargets{
+			S3Targets: []types.S3Target{
+				{
+					Path:          adapterhelpers.PtrString("s3://sales-data-lake/raw/orders/"),
+					EventQueueArn: adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:sales-data-lake-events"),
+				},
+				{
+					// The same bucket shouldn't be linked twice
+					Path: adapterhelpers.PtrString("s3://sales-data-lake/raw/customers/"),
+				},
+			},
+			JdbcTargets: []types.JdbcTarget{
+				{
+					ConnectionName: adapterhelpers.PtrString("sales-postgres"),
+					Path:           adapterhelpers.PtrString("sales/public/%"),
+				},
+			},
+			CatalogTargets: []types.CatalogTarget{
+				{
+					DatabaseName: adapterhelpers.PtrString("sales"),
+					Tables:       []string{"orders"},
+				},
+			},
+			DynamoDBTargets: []types.DynamoDBTarget{
+				{
+					Path: adapterhelpers.PtrString("customers"),
+				},
+			},
+		},
+	}
+
+	item, err := glueCrawlerItemMapper(nil, "123456789012.eu-west-2", crawler)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "AWSGlueServiceRole-sales",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "glue-database",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws-glue/crawlers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-data-lake",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:sales-data-lake-events",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "glue-connection",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-postgres",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "glue-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales/orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dynamodb-table",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "customers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestGlueCrawlerHealth(t *testing.T) {
+	running := &types.Crawler{
+		State: types.CrawlerStateRunning,
+		LastCrawl: &types.LastCrawlInfo{
+			Status: types.LastCrawlStatusFailed,
+		},
+	}
+
+	if health := glueCrawlerHealth(running); health == nil || *health != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected running crawler to be PENDING, got %v", health)
+	}
+
+	if health := glueCrawlerHealth(&types.Crawler{State: types.CrawlerStateReady}); health != nil {
+		t.Errorf("expected crawler that has never run to have no health, got %v", health)
+	}
+}
+
+func TestNewGlueCrawlerAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueCrawlerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
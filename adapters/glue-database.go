@@ -0,0 +1,158 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueDatabaseGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Database, error) {
+	out, err := client.GetDatabase(ctx, &glue.GetDatabaseInput{
+		Name: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Database == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "database was nil",
+		}
+	}
+
+	return out.Database, nil
+}
+
+func glueDatabaseItemMapper(_ *string, scope string, awsItem *types.Database) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-database",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-table",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Tables can't affect the database
+				In: false,
+				// Deleting the database deletes its tables
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.LocationUri != nil {
+		link := s3BucketLink(*awsItem.LocationUri, scope, &sdp.BlastPropagation{
+			// Changes to the bucket affect the data in the database
+			In: true,
+			// The database can't affect the bucket
+			Out: false,
+		})
+
+		if link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	// Resource links point to a database that has been shared from another
+	// account's catalog
+	if target := awsItem.TargetDatabase; target != nil && target.DatabaseName != nil {
+		accountID, region, _ := adapterhelpers.ParseScope(scope)
+
+		if target.CatalogId != nil {
+			accountID = *target.CatalogId
+		}
+
+		if target.Region != nil {
+			region = *target.Region
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-database",
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.DatabaseName,
+				Scope:  adapterhelpers.FormatScope(accountID, region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The link is useless without the shared database
+				In: true,
+				// The link can't affect the shared database
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlueDatabaseAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*glue.GetDatabasesInput, *glue.GetDatabasesOutput, *types.Database, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapterV2[*glue.GetDatabasesInput, *glue.GetDatabasesOutput, *types.Database, GlueClient, *glue.Options]{
+		ItemType:        "glue-database",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueDatabaseAdapterMetadata,
+		GetFunc:         glueDatabaseGetFunc,
+		InputMapperList: func(scope string) (*glue.GetDatabasesInput, error) {
+			return &glue.GetDatabasesInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlueClient, input *glue.GetDatabasesInput) adapterhelpers.Paginator[*glue.GetDatabasesOutput, *glue.Options] {
+			return glue.NewGetDatabasesPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *glue.GetDatabasesOutput, client GlueClient) ([]*types.Database, error) {
+			databases := make([]*types.Database, 0, len(output.DatabaseList))
+
+			for i := range output.DatabaseList {
+				databases = append(databases, &output.DatabaseList[i])
+			}
+
+			return databases, nil
+		},
+		ItemMapper: glueDatabaseItemMapper,
+		ListTagsFunc: func(ctx context.Context, database *types.Database, client GlueClient) (map[string]string, error) {
+			if database.Name == nil {
+				return nil, nil
+			}
+
+			return glueListTags(ctx, client, accountID, region, "database", *database.Name)
+		},
+	}
+}
+
+var glueDatabaseAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-database",
+	DescriptiveName: "Glue Database",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Glue Data Catalog database by name",
+		ListDescription:   "List all Glue Data Catalog databases",
+		SearchDescription: "Search for Glue databases by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_catalog_database.name"},
+	},
+	PotentialLinks: []string{"glue-table", "glue-database", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
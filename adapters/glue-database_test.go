@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueDatabaseItemMapper(t *testing.T) {
+	database := &types.Database{
+		Name:        adapterhelpers.PtrString("sales"),
+		CatalogId:   adapterhelpers.PtrString("123456789012"),
+		CreateTime:  adapterhelpers.PtrTime(time.Now()),
+		Description: adapterhelpers.PtrString("Sales data lake"),
+		LocationUri: adapterhelpers.PtrString("s3://sales-data-lake/warehouse/"),
+		TargetDatabase: &types.DatabaseIdentifier{
+			CatalogId:    adapterhelpers.PtrString("210987654321"),
+			DatabaseName: adapterhelpers.PtrString("shared_sales"),
+			Region:       adapterhelpers.PtrString("us-east-1"),
+		},
+	}
+
+	item, err := glueDatabaseItemMapper(nil, "123456789012.eu-west-2", database)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "glue-table",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sales",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-data-lake",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "glue-database",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "shared_sales",
+			ExpectedScope:  "210987654321.us-east-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewGlueDatabaseAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueDatabaseAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
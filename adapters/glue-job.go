@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueJobGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Job, error) {
+	out, err := client.GetJob(ctx, &glue.GetJobInput{
+		JobName: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Job == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "job was nil",
+		}
+	}
+
+	return out.Job, nil
+}
+
+func glueJobItemMapper(_ *string, scope string, awsItem *types.Job) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-job",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Role != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.Role, scope))
+	}
+
+	if awsItem.Connections != nil {
+		for _, connection := range awsItem.Connections.Connections {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, glueConnectionLink(connection, scope))
+		}
+	}
+
+	// The script, temp dir, extra libraries etc. are all S3 URIs. The script
+	// location is the most important so it goes first, then any arguments
+	// that reference S3 such as --TempDir or --extra-py-files (which is comma
+	// separated)
+	uris := make([]string, 0)
+
+	if awsItem.Command != nil && awsItem.Command.ScriptLocation != nil {
+		uris = append(uris, *awsItem.Command.ScriptLocation)
+	}
+
+	argumentNames := make([]string, 0, len(awsItem.DefaultArguments))
+
+	for name := range awsItem.DefaultArguments {
+		argumentNames = append(argumentNames, name)
+	}
+
+	// Sort so that links are in a stable order
+	sort.Strings(argumentNames)
+
+	for _, name := range argumentNames {
+		uris = append(uris, strings.Split(awsItem.DefaultArguments[name], ",")...)
+	}
+
+	buckets := make(map[string]bool)
+
+	for _, uri := range uris {
+		link := s3BucketLink(strings.TrimSpace(uri), scope, &sdp.BlastPropagation{
+			// The job can't run if its script or libraries are removed
+			In: true,
+			// The job definition can't affect the bucket
+			Out: false,
+		})
+
+		if link != nil && !buckets[link.GetQuery().GetQuery()] {
+			buckets[link.GetQuery().GetQuery()] = true
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewGlueJobAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*glue.GetJobsInput, *glue.GetJobsOutput, *types.Job, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapterV2[*glue.GetJobsInput, *glue.GetJobsOutput, *types.Job, GlueClient, *glue.Options]{
+		ItemType:        "glue-job",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueJobAdapterMetadata,
+		GetFunc:         glueJobGetFunc,
+		InputMapperList: func(scope string) (*glue.GetJobsInput, error) {
+			return &glue.GetJobsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlueClient, input *glue.GetJobsInput) adapterhelpers.Paginator[*glue.GetJobsOutput, *glue.Options] {
+			return glue.NewGetJobsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *glue.GetJobsOutput, client GlueClient) ([]*types.Job, error) {
+			jobs := make([]*types.Job, 0, len(output.Jobs))
+
+			for i := range output.Jobs {
+				jobs = append(jobs, &output.Jobs[i])
+			}
+
+			return jobs, nil
+		},
+		ItemMapper: glueJobItemMapper,
+		ListTagsFunc: func(ctx context.Context, job *types.Job, client GlueClient) (map[string]string, error) {
+			if job.Name == nil {
+				return nil, nil
+			}
+
+			return glueListTags(ctx, client, accountID, region, "job", *job.Name)
+		},
+	}
+}
+
+var glueJobAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-job",
+	DescriptiveName: "Glue Job",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Glue job by name",
+		ListDescription:   "List all Glue jobs",
+		SearchDescription: "Search for Glue jobs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_job.name"},
+	},
+	PotentialLinks: []string{"iam-role", "glue-connection", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
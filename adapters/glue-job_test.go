@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueJobItemMapper(t *testing.T) {
+	job := &types.Job{
+		Name:        adapterhelpers.PtrString("orders-etl"),
+		Role:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/orders-etl"),
+		GlueVersion: adapterhelpers.PtrString("4.0"),
+		CreatedOn:   adapterhelpers.PtrTime(time.Now()),
+		Command: &types.JobCommand{
+			Name:           adapterhelpers.PtrString("glueetl"),
+			ScriptLocation: adapterhelpers.PtrString("s3://glue-scripts/orders-etl.py"),
+		},
+		Connections: &types.ConnectionsList{
+			Connections: []string{"sales-postgres"},
+		},
+		DefaultArguments: map[string]string{
+			"--TempDir":        "s3://glue-temp/orders-etl/",
+			"--extra-py-files": "s3://glue-scripts/libs/common.zip, s3://shared-libs/utils.zip",
+			"--job-language":   "python",
+		},
+	}
+
+	item, err := glueJobItemMapper(nil, "123456789012.eu-west-2", job)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/orders-etl",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "glue-connection",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-postgres",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "glue-scripts",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "glue-temp",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "shared-libs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewGlueJobAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueJobAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,193 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueTableGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Table, error) {
+	// The uniqueAttributeValue for this is a custom field:
+	// {databaseName}/{tableName}
+	databaseName, tableName, ok := strings.Cut(query, "/")
+
+	if !ok {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {databaseName}/{tableName}",
+		}
+	}
+
+	out, err := client.GetTable(ctx, &glue.GetTableInput{
+		DatabaseName: &databaseName,
+		Name:         &tableName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Table == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "table was nil",
+		}
+	}
+
+	return out.Table, nil
+}
+
+// glueTableSearchFunc Lists all tables in a database
+func glueTableSearchFunc(ctx context.Context, client GlueClient, scope, query string) ([]*types.Table, error) {
+	tables := make([]*types.Table, 0)
+
+	paginator := glue.NewGetTablesPaginator(client, &glue.GetTablesInput{
+		DatabaseName: &query,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.TableList {
+			tables = append(tables, &out.TableList[i])
+		}
+	}
+
+	return tables, nil
+}
+
+func glueTableItemMapper(_, scope string, awsItem *types.Table) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.DatabaseName == nil || awsItem.Name == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "glue-table is missing a database or table name",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {databaseName}/{tableName}
+	if err = attributes.Set("UniqueName", *awsItem.DatabaseName+"/"+*awsItem.Name); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-table",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "glue-database",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.DatabaseName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the database deletes the table
+					In: true,
+					// The table can't affect the database
+					Out: false,
+				},
+			},
+		},
+	}
+
+	if sd := awsItem.StorageDescriptor; sd != nil {
+		buckets := make(map[string]bool)
+
+		locations := sd.AdditionalLocations
+
+		if sd.Location != nil {
+			locations = append([]string{*sd.Location}, locations...)
+		}
+
+		for _, location := range locations {
+			link := s3BucketLink(location, scope, &sdp.BlastPropagation{
+				// Changes to the bucket affect the data in the table
+				In: true,
+				// The table can't affect the bucket
+				Out: false,
+			})
+
+			if link != nil && !buckets[link.GetQuery().GetQuery()] {
+				buckets[link.GetQuery().GetQuery()] = true
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	// Resource links point to a table that has been shared from another
+	// account's catalog
+	if target := awsItem.TargetTable; target != nil && target.DatabaseName != nil && target.Name != nil {
+		accountID, region, _ := adapterhelpers.ParseScope(scope)
+
+		if target.CatalogId != nil {
+			accountID = *target.CatalogId
+		}
+
+		if target.Region != nil {
+			region = *target.Region
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "glue-table",
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.DatabaseName + "/" + *target.Name,
+				Scope:  adapterhelpers.FormatScope(accountID, region),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The link is useless without the shared table
+				In: true,
+				// The link can't affect the shared table
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewGlueTableAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.Table, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapter[*types.Table, GlueClient, *glue.Options]{
+		ItemType:        "glue-table",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueTableAdapterMetadata,
+		// Tables can only be listed per database
+		DisableList: true,
+		GetFunc:     glueTableGetFunc,
+		SearchFunc:  glueTableSearchFunc,
+		ItemMapper:  glueTableItemMapper,
+	}
+}
+
+var glueTableAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-table",
+	DescriptiveName: "Glue Table",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Glue table by {databaseName}/{tableName}",
+		SearchDescription: "Search for Glue tables by database name",
+	},
+	PotentialLinks: []string{"glue-database", "glue-table", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueTableItemMapper(t *testing.T) {
+	table := &types.Table{
+		Name:         adapterhelpers.PtrString("orders"),
+		DatabaseName: adapterhelpers.PtrString("sales"),
+		CatalogId:    adapterhelpers.PtrString("123456789012"),
+		CreateTime:   adapterhelpers.PtrTime(time.Now()),
+		TableType:    adapterhelpers.PtrString("EXTERNAL_TABLE"),
+		StorageDescriptor: &types.StorageDescriptor{
+			Location: adapterhelpers.PtrString("s3://sales-data-lake/warehouse/orders/"),
+			AdditionalLocations: []string{
+				"s3://sales-data-lake/archive/orders/",
+				"s3://sales-archive/orders/",
+			},
+			Columns: []types.Column{
+				{
+					Name: adapterhelpers.PtrString("order_id"),
+					Type: adapterhelpers.PtrString("string"),
+				},
+			},
+		},
+	}
+
+	item, err := glueTableItemMapper("", "123456789012.eu-west-2", table)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "sales/orders" {
+		t.Errorf("expected unique attribute value to be sales/orders, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "glue-database",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-data-lake",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-archive",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewGlueTableAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueTableAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,199 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func glueTriggerGetFunc(ctx context.Context, client GlueClient, scope, query string) (*types.Trigger, error) {
+	out, err := client.GetTrigger(ctx, &glue.GetTriggerInput{
+		Name: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Trigger == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "trigger was nil",
+		}
+	}
+
+	return out.Trigger, nil
+}
+
+// glueTriggerStateToHealth Converts the state of a trigger to a health state
+func glueTriggerStateToHealth(state types.TriggerState) *sdp.Health {
+	switch state {
+	case types.TriggerStateActivated, types.TriggerStateCreated:
+		// On-demand triggers stay in the CREATED state
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.TriggerStateCreating,
+		types.TriggerStateActivating,
+		types.TriggerStateDeactivating,
+		types.TriggerStateDeleting,
+		types.TriggerStateUpdating:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.TriggerStateDeactivated:
+		// The trigger won't fire until it is activated again
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	return nil
+}
+
+func glueTriggerItemMapper(_ *string, scope string, awsItem *types.Trigger) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "glue-trigger",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          glueTriggerStateToHealth(awsItem.State),
+	}
+
+	// Actions are the jobs and crawlers that the trigger starts
+	for _, action := range awsItem.Actions {
+		if action.JobName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "glue-job",
+					Method: sdp.QueryMethod_GET,
+					Query:  *action.JobName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The job doesn't affect the trigger
+					In: false,
+					// The trigger starts the job
+					Out: true,
+				},
+			})
+		}
+
+		if action.CrawlerName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "glue-crawler",
+					Method: sdp.QueryMethod_GET,
+					Query:  *action.CrawlerName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The crawler doesn't affect the trigger
+					In: false,
+					// The trigger starts the crawler
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// Conditional triggers fire based on the outcome of other jobs and
+	// crawlers
+	if awsItem.Predicate != nil {
+		for _, condition := range awsItem.Predicate.Conditions {
+			if condition.JobName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "glue-job",
+						Method: sdp.QueryMethod_GET,
+						Query:  *condition.JobName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The outcome of the job determines whether the
+						// trigger fires
+						In: true,
+						// The trigger can't affect the job it watches
+						Out: false,
+					},
+				})
+			}
+
+			if condition.CrawlerName != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "glue-crawler",
+						Method: sdp.QueryMethod_GET,
+						Query:  *condition.CrawlerName,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The outcome of the crawl determines whether the
+						// trigger fires
+						In: true,
+						// The trigger can't affect the crawler it watches
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewGlueTriggerAdapter(client GlueClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*glue.GetTriggersInput, *glue.GetTriggersOutput, *types.Trigger, GlueClient, *glue.Options] {
+	return &adapterhelpers.GetListAdapterV2[*glue.GetTriggersInput, *glue.GetTriggersOutput, *types.Trigger, GlueClient, *glue.Options]{
+		ItemType:        "glue-trigger",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: glueTriggerAdapterMetadata,
+		GetFunc:         glueTriggerGetFunc,
+		InputMapperList: func(scope string) (*glue.GetTriggersInput, error) {
+			return &glue.GetTriggersInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client GlueClient, input *glue.GetTriggersInput) adapterhelpers.Paginator[*glue.GetTriggersOutput, *glue.Options] {
+			return glue.NewGetTriggersPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *glue.GetTriggersOutput, client GlueClient) ([]*types.Trigger, error) {
+			triggers := make([]*types.Trigger, 0, len(output.Triggers))
+
+			for i := range output.Triggers {
+				triggers = append(triggers, &output.Triggers[i])
+			}
+
+			return triggers, nil
+		},
+		ItemMapper: glueTriggerItemMapper,
+		ListTagsFunc: func(ctx context.Context, trigger *types.Trigger, client GlueClient) (map[string]string, error) {
+			if trigger.Name == nil {
+				return nil, nil
+			}
+
+			return glueListTags(ctx, client, accountID, region, "trigger", *trigger.Name)
+		},
+	}
+}
+
+var glueTriggerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "glue-trigger",
+	DescriptiveName: "Glue Trigger",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Glue trigger by name",
+		ListDescription:   "List all Glue triggers",
+		SearchDescription: "Search for Glue triggers by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_glue_trigger.name"},
+	},
+	PotentialLinks: []string{"glue-job", "glue-crawler"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
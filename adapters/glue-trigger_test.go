@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestGlueTriggerItemMapper(t *testing.T) {
+	trigger := &types.Trigger{
+		Name:  adapterhelpers.PtrString("after-crawl"),
+		Type:  types.TriggerTypeConditional,
+		State: types.TriggerStateActivated,
+		Actions: []types.Action{
+			{
+				JobName: adapterhelpers.PtrString("orders-etl"),
+			},
+		},
+		Predicate: &types.Predicate{
+			Logical: types.LogicalAnd,
+			Conditions: []types.Condition{
+				{
+					CrawlerName:     adapterhelpers.PtrString("sales-crawler"),
+					CrawlState:      types.CrawlStateSucceeded,
+					LogicalOperator: types.LogicalOperatorEquals,
+				},
+			},
+		},
+	}
+
+	item, err := glueTriggerItemMapper(nil, "123456789012.eu-west-2", trigger)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "glue-job",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "orders-etl",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "glue-crawler",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sales-crawler",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewGlueTriggerAdapter(t *testing.T) {
+	client, account, region := glueGetAutoConfig(t)
+
+	adapter := NewGlueTriggerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
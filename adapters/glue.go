@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type GlueClient interface {
+	GetConnection(ctx context.Context, params *glue.GetConnectionInput, optFns ...func(*glue.Options)) (*glue.GetConnectionOutput, error)
+	GetCrawler(ctx context.Context, params *glue.GetCrawlerInput, optFns ...func(*glue.Options)) (*glue.GetCrawlerOutput, error)
+	GetDatabase(ctx context.Context, params *glue.GetDatabaseInput, optFns ...func(*glue.Options)) (*glue.GetDatabaseOutput, error)
+	GetJob(ctx context.Context, params *glue.GetJobInput, optFns ...func(*glue.Options)) (*glue.GetJobOutput, error)
+	GetTable(ctx context.Context, params *glue.GetTableInput, optFns ...func(*glue.Options)) (*glue.GetTableOutput, error)
+	GetTags(ctx context.Context, params *glue.GetTagsInput, optFns ...func(*glue.Options)) (*glue.GetTagsOutput, error)
+	GetTrigger(ctx context.Context, params *glue.GetTriggerInput, optFns ...func(*glue.Options)) (*glue.GetTriggerOutput, error)
+
+	glue.GetConnectionsAPIClient
+	glue.GetCrawlersAPIClient
+	glue.GetDatabasesAPIClient
+	glue.GetJobsAPIClient
+	glue.GetTablesAPIClient
+	glue.GetTriggersAPIClient
+}
+
+// glueListTags Gets the tags for a Glue resource. Glue doesn't return ARNs so
+// we need to build them from the account, region, resource type e.g. "job" and
+// the name
+func glueListTags(ctx context.Context, client GlueClient, accountID, region, resourceType, name string) (map[string]string, error) {
+	a := arn.ARN{
+		Partition: adapterhelpers.PartitionFromRegion(region),
+		Service:   "glue",
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resourceType + "/" + name,
+	}
+
+	out, err := client.GetTags(ctx, &glue.GetTagsInput{
+		ResourceArn: adapterhelpers.PtrString(a.String()),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// glueConnectionLink Links to a connection that a crawler or job uses
+func glueConnectionLink(name string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "glue-connection",
+			Method: sdp.QueryMethod_GET,
+			Query:  name,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changes to the connection affect how the data store is reached
+			In: true,
+			// The crawler or job can't affect the connection
+			Out: false,
+		},
+	}
+}
+
+// glueJDBCHost Extracts the host from a JDBC connection URL e.g.
+// jdbc:postgresql://db.example.com:5432/sales or
+// jdbc:sqlserver://db.example.com:1433;databaseName=sales
+func glueJDBCHost(url string) (string, bool) {
+	_, rest, ok := strings.Cut(url, "//")
+
+	if !ok {
+		return "", false
+	}
+
+	// Oracle URLs look like jdbc:oracle:thin://@host:1521/ORCL
+	rest = strings.TrimPrefix(rest, "@")
+
+	if i := strings.IndexAny(rest, "/;?"); i >= 0 {
+		rest = rest[:i]
+	}
+
+	host, _, err := net.SplitHostPort(rest)
+	if err != nil {
+		host = rest
+	}
+
+	return host, host != ""
+}
@@ -0,0 +1,37 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/glue"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func glueGetAutoConfig(t *testing.T) (*glue.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := glue.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestGlueJDBCHost(t *testing.T) {
+	tests := []struct {
+		URL  string
+		Host string
+		OK   bool
+	}{
+		{URL: "jdbc:postgresql://sales.cluster-abc123.eu-west-2.rds.amazonaws.com:5432/sales", Host: "sales.cluster-abc123.eu-west-2.rds.amazonaws.com", OK: true},
+		{URL: "jdbc:sqlserver://10.0.1.20:1433;databaseName=sales", Host: "10.0.1.20", OK: true},
+		{URL: "jdbc:oracle:thin://@oracle.example.com:1521/ORCL", Host: "oracle.example.com", OK: true},
+		{URL: "jdbc:mysql://mysql.example.com/sales", Host: "mysql.example.com", OK: true},
+		{URL: "not a url", OK: false},
+	}
+
+	for _, test := range tests {
+		host, ok := glueJDBCHost(test.URL)
+
+		if ok != test.OK || host != test.Host {
+			t.Errorf("expected %v (%v) from %v, got %v (%v)", test.Host, test.OK, test.URL, host, ok)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// GuardDutyDetectorDetails A detector along with its ID, since GetDetector
+// doesn't return it
+type GuardDutyDetectorDetails struct {
+	DetectorId string
+	Detector   *guardduty.GetDetectorOutput
+}
+
+func guarddutyDetectorGetFunc(ctx context.Context, client GuardDutyClient, scope, query string) (*GuardDutyDetectorDetails, error) {
+	out, err := client.GetDetector(ctx, &guardduty.GetDetectorInput{
+		DetectorId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuardDutyDetectorDetails{
+		DetectorId: query,
+		Detector:   out,
+	}, nil
+}
+
+func guarddutyDetectorListFunc(ctx context.Context, client GuardDutyClient, scope string) ([]*GuardDutyDetectorDetails, error) {
+	paginator := guardduty.NewListDetectorsPaginator(client, &guardduty.ListDetectorsInput{})
+
+	detectors := make([]*GuardDutyDetectorDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detectorID := range out.DetectorIds {
+			detector, err := guarddutyDetectorGetFunc(ctx, client, scope, detectorID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			detectors = append(detectors, detector)
+		}
+	}
+
+	return detectors, nil
+}
+
+func guarddutyDetectorItemMapper(_, scope string, awsItem *GuardDutyDetectorDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*guardduty.GetDetectorOutput
+		DetectorId string
+	}{
+		GetDetectorOutput: awsItem.Detector,
+		DetectorId:        awsItem.DetectorId,
+	}, "tags", "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "guardduty-detector",
+		UniqueAttribute: "DetectorId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Detector.Tags,
+	}
+
+	switch awsItem.Detector.Status {
+	case types.DetectorStatusEnabled:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DetectorStatusDisabled:
+		// A disabled detector isn't generating findings
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	if awsItem.Detector.ServiceRole != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.Detector.ServiceRole, scope))
+	}
+
+	return &item, nil
+}
+
+func NewGuardDutyDetectorAdapter(client GuardDutyClient, accountID string, region string) *adapterhelpers.GetListAdapter[*GuardDutyDetectorDetails, GuardDutyClient, *guardduty.Options] {
+	return &adapterhelpers.GetListAdapter[*GuardDutyDetectorDetails, GuardDutyClient, *guardduty.Options]{
+		ItemType:        "guardduty-detector",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: guarddutyDetectorAdapterMetadata,
+		GetFunc:         guarddutyDetectorGetFunc,
+		ListFunc:        guarddutyDetectorListFunc,
+		ItemMapper:      guarddutyDetectorItemMapper,
+	}
+}
+
+var guarddutyDetectorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "guardduty-detector",
+	DescriptiveName: "GuardDuty Detector",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a GuardDuty detector by ID",
+		ListDescription:   "List all GuardDuty detectors",
+		SearchDescription: "Search for GuardDuty detectors by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_guardduty_detector.id"},
+	},
+	PotentialLinks: []string{"iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
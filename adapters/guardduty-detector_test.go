@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+	"github.com/aws/aws-sdk-go-v2/service/guardduty/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testGuardDutyDetector = &guardduty.GetDetectorOutput{
+	CreatedAt:                  adapterhelpers.PtrString("2024-03-01T09:30:00.000Z"),
+	FindingPublishingFrequency: types.FindingPublishingFrequencySixHours,
+	ServiceRole:                adapterhelpers.PtrString("arn:aws:iam::123456789012:role/aws-service-role/guardduty.amazonaws.com/AWSServiceRoleForAmazonGuardDuty"),
+	Status:                     types.DetectorStatusEnabled,
+	UpdatedAt:                  adapterhelpers.PtrString("2024-06-12T14:05:00.000Z"),
+	Features: []types.DetectorFeatureConfigurationResult{
+		{
+			Name:   types.DetectorFeatureResultS3DataEvents,
+			Status: types.FeatureStatusEnabled,
+		},
+	},
+	Tags: map[string]string{
+		"team": "security",
+	},
+}
+
+func TestGuardDutyDetectorItemMapper(t *testing.T) {
+	item, err := guarddutyDetectorItemMapper("", "123456789012.eu-west-2", &GuardDutyDetectorDetails{
+		DetectorId: "12abc34d567e8fa901bc2d34e56789f0",
+		Detector:   testGuardDutyDetector,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "security" {
+		t.Errorf("expected tag team=security, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/aws-service-role/guardduty.amazonaws.com/AWSServiceRoleForAmazonGuardDuty",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestGuardDutyDetectorListFunc(t *testing.T) {
+	client := GuardDutyTestClient{
+		GetDetectorOutput: testGuardDutyDetector,
+		ListDetectorsOutput: &guardduty.ListDetectorsOutput{
+			DetectorIds: []string{"12abc34d567e8fa901bc2d34e56789f0"},
+		},
+	}
+
+	detectors, err := guarddutyDetectorListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(detectors) != 1 {
+		t.Fatalf("expected 1 detector, got %v", len(detectors))
+	}
+
+	if detectors[0].DetectorId != "12abc34d567e8fa901bc2d34e56789f0" {
+		t.Errorf("expected detector ID to be set, got %v", detectors[0].DetectorId)
+	}
+}
@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+)
+
+type GuardDutyClient interface {
+	GetDetector(ctx context.Context, params *guardduty.GetDetectorInput, optFns ...func(*guardduty.Options)) (*guardduty.GetDetectorOutput, error)
+
+	guardduty.ListDetectorsAPIClient
+}
@@ -0,0 +1,20 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/guardduty"
+)
+
+type GuardDutyTestClient struct {
+	GetDetectorOutput   *guardduty.GetDetectorOutput
+	ListDetectorsOutput *guardduty.ListDetectorsOutput
+}
+
+func (t GuardDutyTestClient) GetDetector(context.Context, *guardduty.GetDetectorInput, ...func(*guardduty.Options)) (*guardduty.GetDetectorOutput, error) {
+	return t.GetDetectorOutput, nil
+}
+
+func (t GuardDutyTestClient) ListDetectors(context.Context, *guardduty.ListDetectorsInput, ...func(*guardduty.Options)) (*guardduty.ListDetectorsOutput, error) {
+	return t.ListDetectorsOutput, nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"net/url"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/micahhausler/aws-iam-policy/policy"
@@ -222,3 +223,35 @@ func ParsePolicyDocument(encoded string) (*policy.Policy, error) {
 
 	return &policyDocument, nil
 }
+
+// iamRoleLink Links to an IAM role that a resource uses or assumes. Some
+// services accept a role name, which can include a path e.g.
+// service-role/MyRole, and others an ARN
+func iamRoleLink(role string, scope string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   "iam-role",
+		Method: sdp.QueryMethod_GET,
+	}
+
+	if a, err := adapterhelpers.ParseARN(role); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Query = role
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	} else {
+		// Roles are global so are in the account scope
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+		query.Query = role[strings.LastIndex(role, "/")+1:]
+		query.Scope = adapterhelpers.FormatScope(accountID, "")
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the role's policies changes what the resource can
+			// access
+			In: true,
+			// The resource can't affect the role
+			Out: false,
+		},
+	}
+}
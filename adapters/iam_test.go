@@ -7,6 +7,7 @@ import (
 
 	"github.com/micahhausler/aws-iam-policy/policy"
 	"github.com/overmindtech/aws-source/tracing"
+	"github.com/overmindtech/sdp-go"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
 )
 
@@ -96,3 +97,39 @@ func TestLinksFromPolicy(t *testing.T) {
 	})
 
 }
+
+func TestIAMRoleLink(t *testing.T) {
+	tests := []struct {
+		Role   string
+		Method sdp.QueryMethod
+		Query  string
+		Scope  string
+	}{
+		{
+			Role:   "arn:aws:iam::123456789012:role/service-role/my-service-role",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  "arn:aws:iam::123456789012:role/service-role/my-service-role",
+			Scope:  "123456789012",
+		},
+		{
+			Role:   "my-service-role",
+			Method: sdp.QueryMethod_GET,
+			Query:  "my-service-role",
+			Scope:  "123456789012",
+		},
+		{
+			Role:   "service-role/my-service-role",
+			Method: sdp.QueryMethod_GET,
+			Query:  "my-service-role",
+			Scope:  "123456789012",
+		},
+	}
+
+	for _, test := range tests {
+		query := iamRoleLink(test.Role, "123456789012.eu-west-2").GetQuery()
+
+		if query.GetMethod() != test.Method || query.GetQuery() != test.Query || query.GetScope() != test.Scope {
+			t.Errorf("unexpected link for %v: %v %v %v", test.Role, query.GetMethod(), query.GetQuery(), query.GetScope())
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/inspector2"
+)
+
+type Inspector2TestClient struct {
+	BatchGetAccountStatusOutput *inspector2.BatchGetAccountStatusOutput
+	BatchGetAccountStatusError  error
+	ListFindingsOutput          *inspector2.ListFindingsOutput
+	ListFindingsError           error
+}
+
+func (t Inspector2TestClient) BatchGetAccountStatus(context.Context, *inspector2.BatchGetAccountStatusInput, ...func(*inspector2.Options)) (*inspector2.BatchGetAccountStatusOutput, error) {
+	return t.BatchGetAccountStatusOutput, t.BatchGetAccountStatusError
+}
+
+func (t Inspector2TestClient) ListFindings(context.Context, *inspector2.ListFindingsInput, ...func(*inspector2.Options)) (*inspector2.ListFindingsOutput, error) {
+	return t.ListFindingsOutput, t.ListFindingsError
+}
@@ -0,0 +1,314 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// KafkaClusterDetails A cluster along with its bootstrap brokers, since these
+// are returned by a separate API call
+type KafkaClusterDetails struct {
+	Cluster          *types.Cluster
+	BootstrapBrokers *kafka.GetBootstrapBrokersOutput
+}
+
+func kafkaClusterGetFunc(ctx context.Context, client KafkaClient, scope, query string) (*KafkaClusterDetails, error) {
+	clusterARN, err := kafkaARNFromQuery(scope, "kafka", "cluster", query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeClusterV2(ctx, &kafka.DescribeClusterV2Input{
+		ClusterArn: &clusterARN,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return enrichKafkaCluster(ctx, client, out.ClusterInfo), nil
+}
+
+// enrichKafkaCluster Fetches the bootstrap brokers for a cluster. These aren't
+// available while the cluster is being created, so failing to get them isn't
+// fatal
+func enrichKafkaCluster(ctx context.Context, client KafkaClient, cluster *types.Cluster) *KafkaClusterDetails {
+	details := KafkaClusterDetails{
+		Cluster: cluster,
+	}
+
+	if cluster.ClusterArn != nil {
+		brokers, err := client.GetBootstrapBrokers(ctx, &kafka.GetBootstrapBrokersInput{
+			ClusterArn: cluster.ClusterArn,
+		})
+
+		if err == nil {
+			details.BootstrapBrokers = brokers
+		}
+	}
+
+	return &details
+}
+
+// kafkaClusterStateToHealth Converts the state of a cluster to a health state
+func kafkaClusterStateToHealth(state types.ClusterState) *sdp.Health {
+	switch state {
+	case types.ClusterStateActive:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.ClusterStateCreating,
+		types.ClusterStateUpdating,
+		types.ClusterStateDeleting,
+		types.ClusterStateMaintenance,
+		types.ClusterStateRebootingBroker:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.ClusterStateHealing:
+		// MSK is replacing an unhealthy broker
+		return sdp.Health_HEALTH_WARNING.Enum()
+	case types.ClusterStateFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+func kafkaClusterItemMapper(_ *string, scope string, awsItem *KafkaClusterDetails) (*sdp.Item, error) {
+	cluster := awsItem.Cluster
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(cluster, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kafka-cluster",
+		UniqueAttribute: "ClusterArn",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          kafkaClusterStateToHealth(cluster.State),
+		Tags:            cluster.Tags,
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	var subnets, securityGroups []string
+
+	if cluster.Serverless != nil {
+		for _, vpcConfig := range cluster.Serverless.VpcConfigs {
+			subnets = append(subnets, vpcConfig.SubnetIds...)
+			securityGroups = append(securityGroups, vpcConfig.SecurityGroupIds...)
+		}
+	}
+
+	if provisioned := cluster.Provisioned; provisioned != nil {
+		if provisioned.BrokerNodeGroupInfo != nil {
+			subnets = append(subnets, provisioned.BrokerNodeGroupInfo.ClientSubnets...)
+			securityGroups = append(securityGroups, provisioned.BrokerNodeGroupInfo.SecurityGroups...)
+		}
+
+		if provisioned.EncryptionInfo != nil && provisioned.EncryptionInfo.EncryptionAtRest != nil && provisioned.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyId != nil {
+			keyID := *provisioned.EncryptionInfo.EncryptionAtRest.DataVolumeKMSKeyId
+
+			// Encrypts the data on the brokers' storage volumes
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(keyID, scope))
+		}
+
+		if provisioned.CurrentBrokerSoftwareInfo != nil && provisioned.CurrentBrokerSoftwareInfo.ConfigurationArn != nil {
+			configARN := *provisioned.CurrentBrokerSoftwareInfo.ConfigurationArn
+
+			if a, err := adapterhelpers.ParseARN(configARN); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "kafka-configuration",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  configARN,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the configuration are applied to the
+						// brokers
+						In: true,
+						// The cluster can't affect the configuration
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if provisioned.LoggingInfo != nil && provisioned.LoggingInfo.BrokerLogs != nil {
+			logs := provisioned.LoggingInfo.BrokerLogs
+
+			if logs.CloudWatchLogs != nil && logs.CloudWatchLogs.LogGroup != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "logs-log-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  *logs.CloudWatchLogs.LogGroup,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The brokers write to the log group
+						In:  false,
+						Out: true,
+					},
+				})
+			}
+
+			if logs.Firehose != nil && logs.Firehose.DeliveryStream != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "firehose-delivery-stream",
+						Method: sdp.QueryMethod_GET,
+						Query:  *logs.Firehose.DeliveryStream,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The brokers write to the delivery stream
+						In:  false,
+						Out: true,
+					},
+				})
+			}
+
+			if logs.S3 != nil && logs.S3.Bucket != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-bucket",
+						Method: sdp.QueryMethod_GET,
+						Query:  *logs.S3.Bucket,
+						Scope:  adapterhelpers.FormatScope(accountID, ""),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The brokers write to the bucket
+						In:  false,
+						Out: true,
+					},
+				})
+			}
+		}
+
+		// Zookeeper is only used by older Kafka versions
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kafkaBrokerDNSLinks(provisioned.ZookeeperConnectString, provisioned.ZookeeperConnectStringTls)...)
+	}
+
+	for _, subnet := range subnets {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnet,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the subnet could affect the brokers
+				In: true,
+				// The brokers can't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, sg := range securityGroups {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  sg,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the security group control access to the brokers
+				In: true,
+				// The cluster can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	if b := awsItem.BootstrapBrokers; b != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kafkaBrokerDNSLinks(
+			b.BootstrapBrokerString,
+			b.BootstrapBrokerStringTls,
+			b.BootstrapBrokerStringSaslScram,
+			b.BootstrapBrokerStringSaslIam,
+			b.BootstrapBrokerStringPublicTls,
+			b.BootstrapBrokerStringPublicSaslScram,
+			b.BootstrapBrokerStringPublicSaslIam,
+			b.BootstrapBrokerStringVpcConnectivityTls,
+			b.BootstrapBrokerStringVpcConnectivitySaslScram,
+			b.BootstrapBrokerStringVpcConnectivitySaslIam,
+		)...)
+	}
+
+	if cluster.ClusterArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-event-source-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *cluster.ClusterArn,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the mapping change how topics are consumed
+				In: true,
+				// Changes to the cluster affect the functions that consume
+				// from it
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewKafkaClusterAdapter(client KafkaClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*kafka.ListClustersV2Input, *kafka.ListClustersV2Output, *KafkaClusterDetails, KafkaClient, *kafka.Options] {
+	return &adapterhelpers.GetListAdapterV2[*kafka.ListClustersV2Input, *kafka.ListClustersV2Output, *KafkaClusterDetails, KafkaClient, *kafka.Options]{
+		ItemType:        "kafka-cluster",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kafkaClusterAdapterMetadata,
+		GetFunc:         kafkaClusterGetFunc,
+		InputMapperList: func(scope string) (*kafka.ListClustersV2Input, error) {
+			// This returns both provisioned and serverless clusters
+			return &kafka.ListClustersV2Input{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client KafkaClient, input *kafka.ListClustersV2Input) adapterhelpers.Paginator[*kafka.ListClustersV2Output, *kafka.Options] {
+			return kafka.NewListClustersV2Paginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *kafka.ListClustersV2Output, client KafkaClient) ([]*KafkaClusterDetails, error) {
+			clusters := make([]*KafkaClusterDetails, 0, len(output.ClusterInfoList))
+
+			for i := range output.ClusterInfoList {
+				clusters = append(clusters, enrichKafkaCluster(ctx, client, &output.ClusterInfoList[i]))
+			}
+
+			return clusters, nil
+		},
+		ItemMapper: kafkaClusterItemMapper,
+	}
+}
+
+var kafkaClusterAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kafka-cluster",
+	DescriptiveName: "MSK Cluster",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a provisioned or serverless MSK cluster by ARN",
+		ListDescription:   "List all provisioned and serverless MSK clusters",
+		SearchDescription: "Search for MSK clusters by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_msk_cluster.arn"},
+		{TerraformQueryMap: "aws_msk_serverless_cluster.arn"},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group", "kms-key", "kafka-configuration", "logs-log-group", "firehose-delivery-stream", "s3-bucket", "dns", "lambda-event-source-mapping"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_DATABASE,
+})
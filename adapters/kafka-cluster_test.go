@@ -0,0 +1,222 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestKafkaClusterItemMapper(t *testing.T) {
+	details := &KafkaClusterDetails{
+		Cluster: &types.Cluster{
+			ClusterArn:  adapterhelpers.PtrString("arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3"),
+			ClusterName: adapterhelpers.PtrString("events"),
+			ClusterType: types.ClusterTypeProvisioned,
+			State:       types.ClusterStateActive,
+			Tags: map[string]string{
+				"team": "platform",
+			},
+			Provisioned: &types.Provisioned{
+				NumberOfBrokerNodes: adapterhelpers.PtrInt32(2),
+				BrokerNodeGroupInfo: &types.BrokerNodeGroupInfo{
+					InstanceType:   adapterhelpers.PtrString("kafka.m5.large"),
+					ClientSubnets:  []string{"subnet-0a1b2c3d", "subnet-4e5f6a7b"},
+					SecurityGroups: []string{"sg-0123456789abcdef0"},
+				},
+				CurrentBrokerSoftwareInfo: &types.BrokerSoftwareInfo{
+					ConfigurationArn:      adapterhelpers.PtrString("arn:aws:kafka:eu-west-2:123456789012:configuration/events-config/8d7c6b5a-4f3e-2d1c-0b9a-8f7e6d5c4b3a-2"),
+					ConfigurationRevision: adapterhelpers.PtrInt64(1),
+					KafkaVersion:          adapterhelpers.PtrString("3.6.0"),
+				},
+				EncryptionInfo: &types.EncryptionInfo{
+					EncryptionAtRest: &types.EncryptionAtRest{
+						DataVolumeKMSKeyId: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+					},
+				},
+				LoggingInfo: &types.LoggingInfo{
+					BrokerLogs: &types.BrokerLogs{
+						CloudWatchLogs: &types.CloudWatchLogs{
+							Enabled:  adapterhelpers.PtrBool(true),
+							LogGroup: adapterhelpers.PtrString("/msk/events"),
+						},
+						Firehose: &types.Firehose{
+							Enabled:        adapterhelpers.PtrBool(true),
+							DeliveryStream: adapterhelpers.PtrString("msk-events"),
+						},
+						S3: &types.S3{
+							Enabled: adapterhelpers.PtrBool(true),
+							Bucket:  adapterhelpers.PtrString("msk-broker-logs"),
+						},
+					},
+				},
+				ZookeeperConnectString: adapterhelpers.PtrString("z-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:2181"),
+			},
+		},
+		BootstrapBrokers: &kafka.GetBootstrapBrokersOutput{
+			BootstrapBrokerStringTls: adapterhelpers.PtrString("b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094,b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094"),
+		},
+	}
+
+	item, err := kafkaClusterItemMapper(nil, "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected team tag, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kafka-configuration",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kafka:eu-west-2:123456789012:configuration/events-config/8d7c6b5a-4f3e-2d1c-0b9a-8f7e6d5c4b3a-2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/msk/events",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "msk-events",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "msk-broker-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "z-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "lambda-event-source-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestKafkaServerlessClusterItemMapper(t *testing.T) {
+	details := &KafkaClusterDetails{
+		Cluster: &types.Cluster{
+			ClusterArn:  adapterhelpers.PtrString("arn:aws:kafka:eu-west-2:123456789012:cluster/serverless-events/7a6b5c4d-3e2f-1a0b-9c8d-7e6f5a4b3c2d-s1"),
+			ClusterName: adapterhelpers.PtrString("serverless-events"),
+			ClusterType: types.ClusterTypeServerless,
+			State:       types.ClusterStateHealing,
+			Serverless: &types.Serverless{
+				VpcConfigs: []types.VpcConfig{
+					{
+						SubnetIds:        []string{"subnet-0a1b2c3d"},
+						SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+					},
+				},
+			},
+		},
+	}
+
+	item, err := kafkaClusterItemMapper(nil, "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewKafkaClusterAdapter(t *testing.T) {
+	client, account, region := kafkaGetAutoConfig(t)
+
+	adapter := NewKafkaClusterAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func kafkaConfigurationGetFunc(ctx context.Context, client KafkaClient, scope, query string) (*types.Configuration, error) {
+	configurationARN, err := kafkaARNFromQuery(scope, "kafka", "configuration", query)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := client.DescribeConfiguration(ctx, &kafka.DescribeConfigurationInput{
+		Arn: &configurationARN,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The output has the same fields as the configuration that is returned
+	// when listing, so convert it to keep things consistent
+	return &types.Configuration{
+		Arn:            out.Arn,
+		CreationTime:   out.CreationTime,
+		Description:    out.Description,
+		KafkaVersions:  out.KafkaVersions,
+		LatestRevision: out.LatestRevision,
+		Name:           out.Name,
+		State:          out.State,
+	}, nil
+}
+
+func kafkaConfigurationItemMapper(_ *string, scope string, awsItem *types.Configuration) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kafka-configuration",
+		UniqueAttribute: "Arn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.State {
+	case types.ConfigurationStateActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ConfigurationStateDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ConfigurationStateDeleteFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return &item, nil
+}
+
+func NewKafkaConfigurationAdapter(client KafkaClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*kafka.ListConfigurationsInput, *kafka.ListConfigurationsOutput, *types.Configuration, KafkaClient, *kafka.Options] {
+	return &adapterhelpers.GetListAdapterV2[*kafka.ListConfigurationsInput, *kafka.ListConfigurationsOutput, *types.Configuration, KafkaClient, *kafka.Options]{
+		ItemType:        "kafka-configuration",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kafkaConfigurationAdapterMetadata,
+		GetFunc:         kafkaConfigurationGetFunc,
+		InputMapperList: func(scope string) (*kafka.ListConfigurationsInput, error) {
+			return &kafka.ListConfigurationsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client KafkaClient, input *kafka.ListConfigurationsInput) adapterhelpers.Paginator[*kafka.ListConfigurationsOutput, *kafka.Options] {
+			return kafka.NewListConfigurationsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *kafka.ListConfigurationsOutput, client KafkaClient) ([]*types.Configuration, error) {
+			configurations := make([]*types.Configuration, 0, len(output.Configurations))
+
+			for i := range output.Configurations {
+				configurations = append(configurations, &output.Configurations[i])
+			}
+
+			return configurations, nil
+		},
+		ItemMapper: kafkaConfigurationItemMapper,
+		ListTagsFunc: func(ctx context.Context, c *types.Configuration, client KafkaClient) (map[string]string, error) {
+			if c.Arn == nil {
+				return nil, nil
+			}
+
+			out, err := client.ListTagsForResource(ctx, &kafka.ListTagsForResourceInput{
+				ResourceArn: c.Arn,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return out.Tags, nil
+		},
+	}
+}
+
+var kafkaConfigurationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kafka-configuration",
+	DescriptiveName: "MSK Configuration",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an MSK configuration by ARN",
+		ListDescription:   "List all MSK configurations",
+		SearchDescription: "Search for MSK configurations by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_msk_configuration.arn"},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
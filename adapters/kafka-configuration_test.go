@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestKafkaConfigurationItemMapper(t *testing.T) {
+	configuration := &types.Configuration{
+		Arn:           adapterhelpers.PtrString("arn:aws:kafka:eu-west-2:123456789012:configuration/events-config/8d7c6b5a-4f3e-2d1c-0b9a-8f7e6d5c4b3a-2"),
+		Name:          adapterhelpers.PtrString("events-config"),
+		Description:   adapterhelpers.PtrString("Broker settings for the events cluster"),
+		CreationTime:  adapterhelpers.PtrTime(time.Now()),
+		KafkaVersions: []string{"3.6.0"},
+		LatestRevision: &types.ConfigurationRevision{
+			Revision:     adapterhelpers.PtrInt64(2),
+			CreationTime: adapterhelpers.PtrTime(time.Now()),
+		},
+		State: types.ConfigurationStateDeleteFailed,
+	}
+
+	item, err := kafkaConfigurationItemMapper(nil, "123456789012.eu-west-2", configuration)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+}
+
+func TestNewKafkaConfigurationAdapter(t *testing.T) {
+	client, account, region := kafkaGetAutoConfig(t)
+
+	adapter := NewKafkaConfigurationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type KafkaClient interface {
+	DescribeClusterV2(ctx context.Context, params *kafka.DescribeClusterV2Input, optFns ...func(*kafka.Options)) (*kafka.DescribeClusterV2Output, error)
+	DescribeConfiguration(ctx context.Context, params *kafka.DescribeConfigurationInput, optFns ...func(*kafka.Options)) (*kafka.DescribeConfigurationOutput, error)
+	GetBootstrapBrokers(ctx context.Context, params *kafka.GetBootstrapBrokersInput, optFns ...func(*kafka.Options)) (*kafka.GetBootstrapBrokersOutput, error)
+	ListTagsForResource(ctx context.Context, params *kafka.ListTagsForResourceInput, optFns ...func(*kafka.Options)) (*kafka.ListTagsForResourceOutput, error)
+
+	kafka.ListClustersV2APIClient
+	kafka.ListConfigurationsAPIClient
+}
+
+// kafkaARNFromQuery MSK and MSK Connect APIs only accept ARNs, but searching by
+// ARN will call Get with just the resource ID e.g. "my-cluster/abcd1234-..." so
+// we need to build the full ARN again from the scope
+func kafkaARNFromQuery(scope, service, resourceType, query string) (string, error) {
+	if strings.HasPrefix(query, "arn:") {
+		return query, nil
+	}
+
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+	if err != nil {
+		return "", err
+	}
+
+	a := arn.ARN{
+		Partition: adapterhelpers.PartitionFromRegion(region),
+		Service:   service,
+		Region:    region,
+		AccountID: accountID,
+		Resource:  resourceType + "/" + query,
+	}
+
+	return a.String(), nil
+}
+
+// kafkaBrokerDNSLinks Converts Kafka broker connection strings, which are a
+// comma-separated list of host:port pairs, into DNS links. Hosts that appear
+// in more than one string are only linked once
+func kafkaBrokerDNSLinks(brokerStrings ...*string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	seen := make(map[string]bool)
+
+	for _, brokerString := range brokerStrings {
+		if brokerString == nil {
+			continue
+		}
+
+		for _, broker := range strings.Split(*brokerString, ",") {
+			broker = strings.TrimSpace(broker)
+
+			host, _, err := net.SplitHostPort(broker)
+			if err != nil {
+				// No port, so the whole thing is the host
+				host = broker
+			}
+
+			if host == "" || seen[host] || net.ParseIP(host) != nil {
+				continue
+			}
+
+			seen[host] = true
+
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  host,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS is always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return links
+}
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafka"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func kafkaGetAutoConfig(t *testing.T) (*kafka.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kafka.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestKafkaARNFromQuery(t *testing.T) {
+	tests := []struct {
+		Query    string
+		Expected string
+	}{
+		{
+			Query:    "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+			Expected: "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+		},
+		{
+			Query:    "events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+			Expected: "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+		},
+	}
+
+	for _, test := range tests {
+		actual, err := kafkaARNFromQuery("123456789012.eu-west-2", "kafka", "cluster", test.Query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if actual != test.Expected {
+			t.Errorf("expected %v, got %v", test.Expected, actual)
+		}
+	}
+
+	if _, err := kafkaARNFromQuery("not-a-scope", "kafka", "cluster", "events"); err == nil {
+		t.Error("expected error for invalid scope")
+	}
+}
+
+func TestKafkaBrokerDNSLinks(t *testing.T) {
+	plain := "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9092,b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9092"
+	tls := "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094,b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9094"
+	ips := "10.0.1.15:9092,10.0.2.15:9092"
+
+	links := kafkaBrokerDNSLinks(&plain, &tls, &ips, nil)
+
+	if len(links) != 2 {
+		t.Fatalf("expected 2 links, got %v", len(links))
+	}
+
+	for _, link := range links {
+		if link.GetQuery().GetType() != "dns" {
+			t.Errorf("expected dns link, got %v", link.GetQuery().GetType())
+		}
+	}
+
+	if links[0].GetQuery().GetQuery() != "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com" {
+		t.Errorf("unexpected host %v", links[0].GetQuery().GetQuery())
+	}
+}
@@ -0,0 +1,213 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func kafkaConnectConnectorGetFunc(ctx context.Context, client KafkaConnectClient, scope, query string) (*kafkaconnect.DescribeConnectorOutput, error) {
+	connectorARN, err := kafkaARNFromQuery(scope, "kafkaconnect", "connector", query)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.DescribeConnector(ctx, &kafkaconnect.DescribeConnectorInput{
+		ConnectorArn: &connectorARN,
+	})
+}
+
+func kafkaConnectConnectorItemMapper(_ *string, scope string, awsItem *kafkaconnect.DescribeConnectorOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "ResultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "kafkaconnect-connector",
+		UniqueAttribute: "ConnectorArn",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          kafkaConnectConnectorStateToHealth(awsItem.ConnectorState),
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	if awsItem.KafkaCluster != nil && awsItem.KafkaCluster.ApacheKafkaCluster != nil {
+		cluster := awsItem.KafkaCluster.ApacheKafkaCluster
+
+		// The cluster might not be MSK, so the best we can do is link to the
+		// brokers by DNS name
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kafkaBrokerDNSLinks(cluster.BootstrapServers)...)
+
+		if cluster.Vpc != nil {
+			for _, subnet := range cluster.Vpc.Subnets {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-subnet",
+						Method: sdp.QueryMethod_GET,
+						Query:  subnet,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the subnet could affect the workers
+						In: true,
+						// The connector can't affect the subnet
+						Out: false,
+					},
+				})
+			}
+
+			for _, sg := range cluster.Vpc.SecurityGroups {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-security-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  sg,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the security group could stop the workers
+						// reaching the cluster
+						In: true,
+						// The connector can't affect the security group
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.ServiceExecutionRoleArn != nil {
+		// Changing the role will affect what the connector can access
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.ServiceExecutionRoleArn, scope))
+	}
+
+	if awsItem.LogDelivery != nil && awsItem.LogDelivery.WorkerLogDelivery != nil {
+		logs := awsItem.LogDelivery.WorkerLogDelivery
+
+		if logs.CloudWatchLogs != nil && logs.CloudWatchLogs.Enabled && logs.CloudWatchLogs.LogGroup != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "logs-log-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logs.CloudWatchLogs.LogGroup,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The workers write to the log group
+					In:  false,
+					Out: true,
+				},
+			})
+		}
+
+		if logs.Firehose != nil && logs.Firehose.Enabled && logs.Firehose.DeliveryStream != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "firehose-delivery-stream",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logs.Firehose.DeliveryStream,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The workers write to the delivery stream
+					In:  false,
+					Out: true,
+				},
+			})
+		}
+
+		if logs.S3 != nil && logs.S3.Enabled && logs.S3.Bucket != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  *logs.S3.Bucket,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The workers write to the bucket
+					In:  false,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewKafkaConnectConnectorAdapter(client KafkaConnectClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*kafkaconnect.ListConnectorsInput, *kafkaconnect.ListConnectorsOutput, *kafkaconnect.DescribeConnectorOutput, KafkaConnectClient, *kafkaconnect.Options] {
+	return &adapterhelpers.GetListAdapterV2[*kafkaconnect.ListConnectorsInput, *kafkaconnect.ListConnectorsOutput, *kafkaconnect.DescribeConnectorOutput, KafkaConnectClient, *kafkaconnect.Options]{
+		ItemType:        "kafkaconnect-connector",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: kafkaConnectConnectorAdapterMetadata,
+		GetFunc:         kafkaConnectConnectorGetFunc,
+		InputMapperList: func(scope string) (*kafkaconnect.ListConnectorsInput, error) {
+			return &kafkaconnect.ListConnectorsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client KafkaConnectClient, input *kafkaconnect.ListConnectorsInput) adapterhelpers.Paginator[*kafkaconnect.ListConnectorsOutput, *kafkaconnect.Options] {
+			return kafkaconnect.NewListConnectorsPaginator(client, input)
+		},
+		// The summary doesn't include the log delivery or execution role, so
+		// describe each connector
+		ListExtractor: func(ctx context.Context, output *kafkaconnect.ListConnectorsOutput, client KafkaConnectClient) ([]*kafkaconnect.DescribeConnectorOutput, error) {
+			connectors := make([]*kafkaconnect.DescribeConnectorOutput, 0, len(output.Connectors))
+
+			for _, summary := range output.Connectors {
+				connector, err := client.DescribeConnector(ctx, &kafkaconnect.DescribeConnectorInput{
+					ConnectorArn: summary.ConnectorArn,
+				})
+
+				if err != nil {
+					return nil, err
+				}
+
+				connectors = append(connectors, connector)
+			}
+
+			return connectors, nil
+		},
+		ItemMapper: kafkaConnectConnectorItemMapper,
+		ListTagsFunc: func(ctx context.Context, c *kafkaconnect.DescribeConnectorOutput, client KafkaConnectClient) (map[string]string, error) {
+			if c.ConnectorArn == nil {
+				return nil, nil
+			}
+
+			out, err := client.ListTagsForResource(ctx, &kafkaconnect.ListTagsForResourceInput{
+				ResourceArn: c.ConnectorArn,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return out.Tags, nil
+		},
+	}
+}
+
+var kafkaConnectConnectorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "kafkaconnect-connector",
+	DescriptiveName: "MSK Connect Connector",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an MSK Connect connector by ARN",
+		ListDescription:   "List all MSK Connect connectors",
+		SearchDescription: "Search for MSK Connect connectors by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_mskconnect_connector.arn"},
+	},
+	PotentialLinks: []string{"dns", "ec2-subnet", "ec2-security-group", "iam-role", "logs-log-group", "firehose-delivery-stream", "s3-bucket"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
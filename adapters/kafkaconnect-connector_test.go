@@ -0,0 +1,115 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestKafkaConnectConnectorItemMapper(t *testing.T) {
+	connector := &kafkaconnect.DescribeConnectorOutput{
+		ConnectorArn:   adapterhelpers.PtrString("arn:aws:kafkaconnect:eu-west-2:123456789012:connector/s3-sink/1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d-2"),
+		ConnectorName:  adapterhelpers.PtrString("s3-sink"),
+		ConnectorState: types.ConnectorStateRunning,
+		KafkaCluster: &types.KafkaClusterDescription{
+			ApacheKafkaCluster: &types.ApacheKafkaClusterDescription{
+				BootstrapServers: adapterhelpers.PtrString("b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9098,b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com:9098"),
+				Vpc: &types.VpcDescription{
+					Subnets:        []string{"subnet-0a1b2c3d"},
+					SecurityGroups: []string{"sg-0123456789abcdef0"},
+				},
+			},
+		},
+		ServiceExecutionRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/msk-connect-s3-sink"),
+		LogDelivery: &types.LogDeliveryDescription{
+			WorkerLogDelivery: &types.WorkerLogDeliveryDescription{
+				CloudWatchLogs: &types.CloudWatchLogsLogDeliveryDescription{
+					Enabled:  true,
+					LogGroup: adapterhelpers.PtrString("/msk-connect/s3-sink"),
+				},
+				S3: &types.S3LogDeliveryDescription{
+					// Disabled destinations shouldn't be linked
+					Enabled: false,
+					Bucket:  adapterhelpers.PtrString("old-connector-logs"),
+				},
+			},
+		},
+	}
+
+	item, err := kafkaConnectConnectorItemMapper(nil, "123456789012.eu-west-2", connector)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-1.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "b-2.events.abc123.c3.kafka.eu-west-2.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/msk-connect-s3-sink",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/msk-connect/s3-sink",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewKafkaConnectConnectorAdapter(t *testing.T) {
+	client, account, region := kafkaConnectGetAutoConfig(t)
+
+	adapter := NewKafkaConnectConnectorAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
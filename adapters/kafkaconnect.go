@@ -0,0 +1,31 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect/types"
+	"github.com/overmindtech/sdp-go"
+)
+
+type KafkaConnectClient interface {
+	DescribeConnector(ctx context.Context, params *kafkaconnect.DescribeConnectorInput, optFns ...func(*kafkaconnect.Options)) (*kafkaconnect.DescribeConnectorOutput, error)
+	ListTagsForResource(ctx context.Context, params *kafkaconnect.ListTagsForResourceInput, optFns ...func(*kafkaconnect.Options)) (*kafkaconnect.ListTagsForResourceOutput, error)
+
+	kafkaconnect.ListConnectorsAPIClient
+}
+
+// kafkaConnectConnectorStateToHealth Converts the state of a connector to a
+// health state
+func kafkaConnectConnectorStateToHealth(state types.ConnectorState) *sdp.Health {
+	switch state {
+	case types.ConnectorStateRunning:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.ConnectorStateCreating, types.ConnectorStateUpdating, types.ConnectorStateDeleting:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.ConnectorStateFailed:
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
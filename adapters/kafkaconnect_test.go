@@ -0,0 +1,15 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func kafkaConnectGetAutoConfig(t *testing.T) (*kafkaconnect.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := kafkaconnect.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/kms"
 	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 func kmsTags(ctx context.Context, cli kmsClient, keyID string) (map[string]string, error) {
@@ -33,3 +36,30 @@ func kmsTagsToMap(tags []types.Tag) map[string]string {
 
 	return tagsMap
 }
+
+// kmsKeyLink Links to a KMS key that a resource is encrypted with. Services
+// accept either a key ID, in which case the key is in the same scope, or an ARN
+func kmsKeyLink(keyID string, scope string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   "kms-key",
+		Method: sdp.QueryMethod_GET,
+		Query:  keyID,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(keyID); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing or disabling the key will affect reading and writing
+			// the encrypted data
+			In: true,
+			// The encrypted resource can't affect the key
+			Out: false,
+		},
+	}
+}
@@ -0,0 +1,176 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// aliasGetFunc The input for this function is a GetAliasInput with the
+// FunctionName set to the name of the function, and the Name set to the name
+// of the alias
+func aliasGetFunc(ctx context.Context, client LambdaClient, scope string, input *lambda.GetAliasInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, errors.New("nil input")
+	}
+
+	out, err := client.GetAlias(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(out, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var functionName string
+
+	if input.FunctionName != nil {
+		functionName = *input.FunctionName
+	}
+
+	if out.Name != nil {
+		// Add a unique attribute since the alias name is only unique within
+		// the function
+		attributes.Set("UniqueName", functionName+":"+*out.Name)
+	}
+
+	item := sdp.Item{
+		Type:            "lambda-alias",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_GET,
+					Query:  functionName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the function deletes the alias
+					In: true,
+					// Callers invoke the function through the alias
+					Out: true,
+				},
+			},
+		},
+	}
+
+	versions := make([]string, 0)
+
+	if out.FunctionVersion != nil {
+		versions = append(versions, *out.FunctionVersion)
+	}
+
+	if out.RoutingConfig != nil {
+		for version := range out.RoutingConfig.AdditionalVersionWeights {
+			versions = append(versions, version)
+		}
+	}
+
+	for _, version := range versions {
+		// $LATEST isn't a published version, it's the function itself
+		if version == "$LATEST" {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-version",
+				Method: sdp.QueryMethod_GET,
+				Query:  functionName + ":" + version,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The alias routes traffic to the version so changes to it
+				// affect the alias
+				In: true,
+				// Changing the alias changes which version receives traffic
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewLambdaAliasAdapter(client LambdaClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*lambda.ListAliasesInput, *lambda.ListAliasesOutput, *lambda.GetAliasInput, *lambda.GetAliasOutput, LambdaClient, *lambda.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*lambda.ListAliasesInput, *lambda.ListAliasesOutput, *lambda.GetAliasInput, *lambda.GetAliasOutput, LambdaClient, *lambda.Options]{
+		ItemType:        "lambda-alias",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: lambdaAliasAdapterMetadata,
+		// Aliases can only be listed per function
+		DisableList:      true,
+		AlwaysSearchARNs: true,
+		GetFunc:          aliasGetFunc,
+		GetInputMapper: func(scope, query string) *lambda.GetAliasInput {
+			// The query is in the format {functionName}:{aliasName}
+			functionName, aliasName := lambdaQualifiedName(query)
+
+			if functionName == "" || aliasName == "" {
+				return nil
+			}
+
+			return &lambda.GetAliasInput{
+				FunctionName: &functionName,
+				Name:         &aliasName,
+			}
+		},
+		SearchInputMapper: func(scope, query string) (*lambda.ListAliasesInput, error) {
+			return &lambda.ListAliasesInput{
+				FunctionName: &query,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client LambdaClient, input *lambda.ListAliasesInput) adapterhelpers.Paginator[*lambda.ListAliasesOutput, *lambda.Options] {
+			return lambda.NewListAliasesPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *lambda.ListAliasesOutput, input *lambda.ListAliasesInput) ([]*lambda.GetAliasInput, error) {
+			inputs := make([]*lambda.GetAliasInput, 0, len(output.Aliases))
+
+			var functionName string
+
+			if input.FunctionName != nil {
+				functionName = lambdaNameFromARN(*input.FunctionName)
+			}
+
+			for i := range output.Aliases {
+				inputs = append(inputs, &lambda.GetAliasInput{
+					FunctionName: &functionName,
+					Name:         output.Aliases[i].Name,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var lambdaAliasAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "lambda-alias",
+	DescriptiveName: "Lambda Alias",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get an alias by {functionName}:{aliasName}",
+		SearchDescription: "Search for aliases by function name, or by alias ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_lambda_alias.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"lambda-function", "lambda-version"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestLambdaClient) GetAlias(ctx context.Context, params *lambda.GetAliasInput, optFns ...func(*lambda.Options)) (*lambda.GetAliasOutput, error) {
+	return &lambda.GetAliasOutput{
+		AliasArn:        adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:" + *params.FunctionName + ":" + *params.Name),
+		Description:     adapterhelpers.PtrString("Production traffic"),
+		FunctionVersion: adapterhelpers.PtrString("3"),
+		Name:            params.Name,
+		RevisionId:      adapterhelpers.PtrString("1a2b3c4d-5e6f-7a8b-9c0d-1e2f3a4b5c6d"),
+		RoutingConfig: &types.AliasRoutingConfiguration{
+			AdditionalVersionWeights: map[string]float64{
+				"4": 0.1,
+			},
+		},
+	}, nil
+}
+
+func (t *TestLambdaClient) ListAliases(context.Context, *lambda.ListAliasesInput, ...func(*lambda.Options)) (*lambda.ListAliasesOutput, error) {
+	return &lambda.ListAliasesOutput{
+		Aliases: []types.AliasConfiguration{
+			{
+				Name: adapterhelpers.PtrString("prod"),
+			},
+		},
+	}, nil
+}
+
+func TestAliasGetFunc(t *testing.T) {
+	item, err := aliasGetFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2", &lambda.GetAliasInput{
+		FunctionName: adapterhelpers.PtrString("process-orders"),
+		Name:         adapterhelpers.PtrString("prod"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "process-orders:prod" {
+		t.Errorf("expected unique attribute value to be process-orders:prod, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-version",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders:3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-version",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders:4",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestAliasGetInputMapper(t *testing.T) {
+	adapter := NewLambdaAliasAdapter(&TestLambdaClient{}, "123456789012", "eu-west-2")
+
+	tests := []struct {
+		Query     string
+		ExpectNil bool
+	}{
+		{
+			Query:     "process-orders:prod",
+			ExpectNil: false,
+		},
+		{
+			Query:     "process-orders",
+			ExpectNil: true,
+		},
+		{
+			Query:     ":prod",
+			ExpectNil: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			input := adapter.GetInputMapper("123456789012.eu-west-2", test.Query)
+
+			if input == nil && !test.ExpectNil {
+				t.Error("input was nil unexpectedly")
+			}
+
+			if input != nil && test.ExpectNil {
+				t.Error("input was non-nil when expected to be nil")
+			}
+		})
+	}
+}
+
+func TestNewLambdaAliasAdapter(t *testing.T) {
+	client, account, region := lambdaGetAutoConfig(t)
+
+	adapter := NewLambdaAliasAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
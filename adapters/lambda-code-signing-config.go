@@ -0,0 +1,188 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// LambdaCodeSigningConfigDetails A code signing config along with the
+// functions that use it
+type LambdaCodeSigningConfigDetails struct {
+	*types.CodeSigningConfig
+	FunctionArns []string
+}
+
+// lambdaCodeSigningConfigGetFunc Gets a code signing config by ID. The API only
+// accepts ARNs so we build one from the scope
+func lambdaCodeSigningConfigGetFunc(ctx context.Context, client LambdaClient, scope, query string) (*LambdaCodeSigningConfigDetails, error) {
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	configArn := fmt.Sprintf("arn:%v:lambda:%v:%v:code-signing-config:%v", adapterhelpers.PartitionFromRegion(region), region, accountID, query)
+
+	out, err := client.GetCodeSigningConfig(ctx, &lambda.GetCodeSigningConfigInput{
+		CodeSigningConfigArn: &configArn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.CodeSigningConfig == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "code signing config was nil",
+		}
+	}
+
+	return lambdaCodeSigningConfigDetails(ctx, client, out.CodeSigningConfig)
+}
+
+func lambdaCodeSigningConfigDetails(ctx context.Context, client LambdaClient, config *types.CodeSigningConfig) (*LambdaCodeSigningConfigDetails, error) {
+	details := LambdaCodeSigningConfigDetails{
+		CodeSigningConfig: config,
+	}
+
+	paginator := lambda.NewListFunctionsByCodeSigningConfigPaginator(client, &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: config.CodeSigningConfigArn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.FunctionArns = append(details.FunctionArns, out.FunctionArns...)
+	}
+
+	return &details, nil
+}
+
+func lambdaCodeSigningConfigListFunc(ctx context.Context, client LambdaClient, scope string) ([]*LambdaCodeSigningConfigDetails, error) {
+	paginator := lambda.NewListCodeSigningConfigsPaginator(client, &lambda.ListCodeSigningConfigsInput{})
+
+	configs := make([]*LambdaCodeSigningConfigDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.CodeSigningConfigs {
+			details, err := lambdaCodeSigningConfigDetails(ctx, client, &out.CodeSigningConfigs[i])
+
+			if err != nil {
+				return nil, err
+			}
+
+			configs = append(configs, details)
+		}
+	}
+
+	return configs, nil
+}
+
+func lambdaCodeSigningConfigItemMapper(_, scope string, awsItem *LambdaCodeSigningConfigDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "lambda-code-signing-config",
+		UniqueAttribute: "CodeSigningConfigId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.AllowedPublishers != nil {
+		for _, profileVersionArn := range awsItem.AllowedPublishers.SigningProfileVersionArns {
+			if a, err := adapterhelpers.ParseARN(profileVersionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "signer-signing-profile",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  profileVersionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Code signed by a revoked profile is no longer trusted
+						In: true,
+						// The config can't affect the profile
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	for _, functionArn := range awsItem.FunctionArns {
+		if a, err := adapterhelpers.ParseARN(functionArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  functionArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The function doesn't affect the config
+					In: false,
+					// Changing the allowed publishers or policy can block
+					// deployments to the function
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewLambdaCodeSigningConfigAdapter(client LambdaClient, accountID string, region string) *adapterhelpers.GetListAdapter[*LambdaCodeSigningConfigDetails, LambdaClient, *lambda.Options] {
+	return &adapterhelpers.GetListAdapter[*LambdaCodeSigningConfigDetails, LambdaClient, *lambda.Options]{
+		ItemType:        "lambda-code-signing-config",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: lambdaCodeSigningConfigAdapterMetadata,
+		GetFunc:         lambdaCodeSigningConfigGetFunc,
+		ListFunc:        lambdaCodeSigningConfigListFunc,
+		ItemMapper:      lambdaCodeSigningConfigItemMapper,
+	}
+}
+
+var lambdaCodeSigningConfigAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "lambda-code-signing-config",
+	DescriptiveName: "Lambda Code Signing Config",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a code signing config by ID",
+		ListDescription:   "List all code signing configs",
+		SearchDescription: "Search for code signing configs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_lambda_code_signing_config.arn",
+		},
+	},
+	PotentialLinks: []string{"signer-signing-profile", "lambda-function"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
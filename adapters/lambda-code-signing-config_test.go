@@ -0,0 +1,119 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestLambdaClient) GetCodeSigningConfig(ctx context.Context, params *lambda.GetCodeSigningConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetCodeSigningConfigOutput, error) {
+	return &lambda.GetCodeSigningConfigOutput{
+		CodeSigningConfig: &types.CodeSigningConfig{
+			AllowedPublishers: &types.AllowedPublishers{
+				SigningProfileVersionArns: []string{
+					"arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning/Ab12Cd34Ef",
+				},
+			},
+			CodeSigningConfigArn: params.CodeSigningConfigArn,
+			CodeSigningConfigId:  adapterhelpers.PtrString("csc-0f6b8d2e4a1c3b5d7"),
+			CodeSigningPolicies: &types.CodeSigningPolicies{
+				UntrustedArtifactOnDeployment: types.CodeSigningPolicyEnforce,
+			},
+			Description:  adapterhelpers.PtrString("Only allow code signed for release"),
+			LastModified: adapterhelpers.PtrString("2024-03-12T09:41:22.000+0000"),
+		},
+	}, nil
+}
+
+func (t *TestLambdaClient) ListCodeSigningConfigs(context.Context, *lambda.ListCodeSigningConfigsInput, ...func(*lambda.Options)) (*lambda.ListCodeSigningConfigsOutput, error) {
+	return &lambda.ListCodeSigningConfigsOutput{
+		CodeSigningConfigs: []types.CodeSigningConfig{
+			{
+				CodeSigningConfigArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:code-signing-config:csc-0f6b8d2e4a1c3b5d7"),
+				CodeSigningConfigId:  adapterhelpers.PtrString("csc-0f6b8d2e4a1c3b5d7"),
+			},
+		},
+	}, nil
+}
+
+func (t *TestLambdaClient) ListFunctionsByCodeSigningConfig(context.Context, *lambda.ListFunctionsByCodeSigningConfigInput, ...func(*lambda.Options)) (*lambda.ListFunctionsByCodeSigningConfigOutput, error) {
+	return &lambda.ListFunctionsByCodeSigningConfigOutput{
+		FunctionArns: []string{
+			"arn:aws:lambda:eu-west-2:123456789012:function:process-orders",
+		},
+	}, nil
+}
+
+func TestLambdaCodeSigningConfigGetFunc(t *testing.T) {
+	details, err := lambdaCodeSigningConfigGetFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2", "csc-0f6b8d2e4a1c3b5d7")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *details.CodeSigningConfigArn != "arn:aws:lambda:eu-west-2:123456789012:code-signing-config:csc-0f6b8d2e4a1c3b5d7" {
+		t.Errorf("expected ARN to be built from the scope, got %v", *details.CodeSigningConfigArn)
+	}
+
+	item, err := lambdaCodeSigningConfigItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "signer-signing-profile",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning/Ab12Cd34Ef",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:process-orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestLambdaCodeSigningConfigListFunc(t *testing.T) {
+	configs, err := lambdaCodeSigningConfigListFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(configs) != 1 {
+		t.Fatalf("expected 1 config, got %v", len(configs))
+	}
+
+	if len(configs[0].FunctionArns) != 1 {
+		t.Errorf("expected 1 function, got %v", len(configs[0].FunctionArns))
+	}
+}
+
+func TestNewLambdaCodeSigningConfigAdapter(t *testing.T) {
+	client, account, region := lambdaGetAutoConfig(t)
+
+	adapter := NewLambdaCodeSigningConfigAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,239 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// eventSourceMappingSourceLink Links an event source mapping to the queue or
+// stream that it reads from. This is linked in both directions since a change
+// to the source affects the function that consumes it, and a change to the
+// mapping changes how the source is drained
+func eventSourceMappingSourceLink(eventSourceARN string) *sdp.LinkedItemQuery {
+	a, err := adapterhelpers.ParseARN(eventSourceARN)
+
+	if err != nil {
+		return nil
+	}
+
+	query := &sdp.Query{
+		Method: sdp.QueryMethod_SEARCH,
+		Query:  eventSourceARN,
+		Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+	}
+
+	switch a.Service {
+	case "sqs":
+		query.Type = "sqs-queue"
+	case "kinesis":
+		query.Type = "kinesis-stream"
+	case "kafka":
+		query.Type = "kafka-cluster"
+	case "dynamodb":
+		// The stream links back to its table
+		query.Type = "dynamodb-stream"
+	default:
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changes to the source affect what the function receives
+			In: true,
+			// Changes to the mapping change how the source is consumed
+			Out: true,
+		},
+	}
+}
+
+func eventSourceMappingGetFunc(ctx context.Context, client LambdaClient, scope string, input *lambda.GetEventSourceMappingInput) (*sdp.Item, error) {
+	out, err := client.GetEventSourceMapping(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(out, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "lambda-event-source-mapping",
+		UniqueAttribute: "UUID",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if out.State != nil {
+		switch *out.State {
+		case "Enabled":
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case "Creating", "Enabling", "Disabling", "Updating", "Deleting":
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+	}
+
+	// The last processing result will start with "PROBLEM:" if the mapping
+	// is failing to invoke the function e.g. due to permissions
+	if out.LastProcessingResult != nil && strings.HasPrefix(*out.LastProcessingResult, "PROBLEM") {
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	if out.FunctionArn != nil {
+		if a, err := adapterhelpers.ParseARN(*out.FunctionArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *out.FunctionArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changes to the function affect how events are processed
+					In: true,
+					// The mapping invokes the function
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if out.EventSourceArn != nil {
+		if link := eventSourceMappingSourceLink(*out.EventSourceArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	if out.DestinationConfig != nil && out.DestinationConfig.OnFailure != nil && out.DestinationConfig.OnFailure.Destination != nil {
+		if lir, err := GetEventLinkedItem(*out.DestinationConfig.OnFailure.Destination); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, lir)
+		}
+	}
+
+	for _, config := range out.SourceAccessConfigurations {
+		if config.URI == nil {
+			continue
+		}
+
+		switch config.Type {
+		case types.SourceAccessTypeVpcSubnet:
+			// The URI is in the format subnet:subnet-0123456789abcdef0
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  strings.TrimPrefix(*config.URI, "subnet:"),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The subnet is used to reach the source
+					In: true,
+					// The mapping can't affect the subnet
+					Out: false,
+				},
+			})
+		case types.SourceAccessTypeVpcSecurityGroup:
+			// The URI is in the format security_group:sg-0123456789abcdef0
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  strings.TrimPrefix(*config.URI, "security_group:"),
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group controls access to the source
+					In: true,
+					// The mapping can't affect the security group
+					Out: false,
+				},
+			})
+		default:
+			// The rest of the access types are credentials, which are stored
+			// in Secrets Manager
+			if lir := secretValueFromLinkedItem(config.URI); lir != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, lir)
+			}
+		}
+	}
+
+	if out.KMSKeyArn != nil {
+		// The key encrypts the filter criteria
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*out.KMSKeyArn, scope))
+	}
+
+	return &item, nil
+}
+
+func NewLambdaEventSourceMappingAdapter(client LambdaClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*lambda.ListEventSourceMappingsInput, *lambda.ListEventSourceMappingsOutput, *lambda.GetEventSourceMappingInput, *lambda.GetEventSourceMappingOutput, LambdaClient, *lambda.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*lambda.ListEventSourceMappingsInput, *lambda.ListEventSourceMappingsOutput, *lambda.GetEventSourceMappingInput, *lambda.GetEventSourceMappingOutput, LambdaClient, *lambda.Options]{
+		ItemType:        "lambda-event-source-mapping",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		ListInput:       &lambda.ListEventSourceMappingsInput{},
+		GetFunc:         eventSourceMappingGetFunc,
+		AdapterMetadata: lambdaEventSourceMappingAdapterMetadata,
+		GetInputMapper: func(scope, query string) *lambda.GetEventSourceMappingInput {
+			return &lambda.GetEventSourceMappingInput{
+				UUID: &query,
+			}
+		},
+		// A mapping's own ARN ends in its UUID so can be resolved with a Get
+		SearchARNTypes: []string{"event-source-mapping"},
+		// Mappings can also be searched by the function that they invoke, or
+		// by the ARN of the queue or stream that they read from
+		SearchInputMapper: func(scope, query string) (*lambda.ListEventSourceMappingsInput, error) {
+			if a, err := adapterhelpers.ParseARN(query); err == nil && a.Service != "lambda" {
+				return &lambda.ListEventSourceMappingsInput{
+					EventSourceArn: &query,
+				}, nil
+			}
+
+			return &lambda.ListEventSourceMappingsInput{
+				FunctionName: &query,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client LambdaClient, input *lambda.ListEventSourceMappingsInput) adapterhelpers.Paginator[*lambda.ListEventSourceMappingsOutput, *lambda.Options] {
+			return lambda.NewListEventSourceMappingsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *lambda.ListEventSourceMappingsOutput, _ *lambda.ListEventSourceMappingsInput) ([]*lambda.GetEventSourceMappingInput, error) {
+			inputs := make([]*lambda.GetEventSourceMappingInput, 0, len(output.EventSourceMappings))
+
+			for i := range output.EventSourceMappings {
+				inputs = append(inputs, &lambda.GetEventSourceMappingInput{
+					UUID: output.EventSourceMappings[i].UUID,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var lambdaEventSourceMappingAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "lambda-event-source-mapping",
+	DescriptiveName: "Lambda Event Source Mapping",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an event source mapping by UUID",
+		ListDescription:   "List all event source mappings",
+		SearchDescription: "Search for event source mappings by their own ARN, by the name or ARN of the function that they invoke, or by the ARN of the queue, stream or cluster that they read from",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_lambda_event_source_mapping.uuid"},
+	},
+	PotentialLinks: []string{"lambda-function", "sqs-queue", "kinesis-stream", "kafka-cluster", "dynamodb-stream", "sns-topic", "events-event-bus", "ec2-subnet", "ec2-security-group", "secretsmanager-secret", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,260 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/discovery"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestLambdaClient) GetEventSourceMapping(ctx context.Context, params *lambda.GetEventSourceMappingInput, optFns ...func(*lambda.Options)) (*lambda.GetEventSourceMappingOutput, error) {
+	return &lambda.GetEventSourceMappingOutput{
+		UUID:                  params.UUID,
+		BatchSize:             adapterhelpers.PtrInt32(10),
+		EventSourceArn:        adapterhelpers.PtrString("arn:aws:sqs:eu-west-2:123456789012:orders"),
+		EventSourceMappingArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:event-source-mapping:" + *params.UUID),
+		FunctionArn:           adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:process-orders"),
+		State:                 adapterhelpers.PtrString("Enabled"),
+		StateTransitionReason: adapterhelpers.PtrString("USER_INITIATED"),
+		LastProcessingResult:  adapterhelpers.PtrString("OK"),
+		DestinationConfig: &types.DestinationConfig{
+			OnFailure: &types.OnFailure{
+				Destination: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:orders-failed"),
+			},
+		},
+		KMSKeyArn: adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+		SourceAccessConfigurations: []types.SourceAccessConfiguration{
+			{
+				Type: types.SourceAccessTypeVpcSubnet,
+				URI:  adapterhelpers.PtrString("subnet:subnet-0a1b2c3d"),
+			},
+			{
+				Type: types.SourceAccessTypeVpcSecurityGroup,
+				URI:  adapterhelpers.PtrString("security_group:sg-0123456789abcdef0"),
+			},
+			{
+				Type: types.SourceAccessTypeBasicAuth,
+				URI:  adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-2:123456789012:secret:broker-creds-AbCdEf"),
+			},
+		},
+	}, nil
+}
+
+func (t *TestLambdaClient) ListEventSourceMappings(_ context.Context, params *lambda.ListEventSourceMappingsInput, _ ...func(*lambda.Options)) (*lambda.ListEventSourceMappingsOutput, error) {
+	// Like the real API, reject ARNs that aren't for a function
+	if params.FunctionName != nil && strings.HasPrefix(*params.FunctionName, "arn:") && !strings.Contains(*params.FunctionName, ":function:") {
+		return nil, &types.InvalidParameterValueException{
+			Message: adapterhelpers.PtrString("Invalid function name"),
+		}
+	}
+
+	return &lambda.ListEventSourceMappingsOutput{
+		EventSourceMappings: []types.EventSourceMappingConfiguration{
+			{
+				UUID: adapterhelpers.PtrString("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"),
+			},
+		},
+	}, nil
+}
+
+func TestEventSourceMappingGetFunc(t *testing.T) {
+	item, err := eventSourceMappingGetFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2", &lambda.GetEventSourceMappingInput{
+		UUID: adapterhelpers.PtrString("a1b2c3d4-5678-90ab-cdef-11111EXAMPLE"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:process-orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sqs-queue",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:eu-west-2:123456789012:orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:orders-failed",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-2:123456789012:secret:broker-creds-AbCdEf",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestEventSourceMappingSourceLink(t *testing.T) {
+	tests := []struct {
+		ARN           string
+		ExpectedType  string
+		ExpectedQuery string
+	}{
+		{
+			ARN:           "arn:aws:sqs:eu-west-2:123456789012:orders",
+			ExpectedType:  "sqs-queue",
+			ExpectedQuery: "arn:aws:sqs:eu-west-2:123456789012:orders",
+		},
+		{
+			ARN:           "arn:aws:kinesis:eu-west-2:123456789012:stream/clicks",
+			ExpectedType:  "kinesis-stream",
+			ExpectedQuery: "arn:aws:kinesis:eu-west-2:123456789012:stream/clicks",
+		},
+		{
+			ARN:           "arn:aws:dynamodb:eu-west-2:123456789012:table/orders/stream/2024-01-01T00:00:00.000",
+			ExpectedType:  "dynamodb-stream",
+			ExpectedQuery: "arn:aws:dynamodb:eu-west-2:123456789012:table/orders/stream/2024-01-01T00:00:00.000",
+		},
+		{
+			ARN:           "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+			ExpectedType:  "kafka-cluster",
+			ExpectedQuery: "arn:aws:kafka:eu-west-2:123456789012:cluster/events/2f3c5a1e-9b1d-4c8e-8a2f-1d2e3f4a5b6c-3",
+		},
+		{
+			ARN: "arn:aws:mq:eu-west-2:123456789012:broker:orders:b-1234",
+		},
+		{
+			ARN: "not-an-arn",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.ARN, func(t *testing.T) {
+			link := eventSourceMappingSourceLink(test.ARN)
+
+			if test.ExpectedType == "" {
+				if link != nil {
+					t.Errorf("expected no link, got %v", link)
+				}
+
+				return
+			}
+
+			if link == nil {
+				t.Fatal("expected a link, got nil")
+			}
+
+			if link.GetQuery().GetType() != test.ExpectedType {
+				t.Errorf("expected type %v, got %v", test.ExpectedType, link.GetQuery().GetType())
+			}
+
+			if link.GetQuery().GetQuery() != test.ExpectedQuery {
+				t.Errorf("expected query %v, got %v", test.ExpectedQuery, link.GetQuery().GetQuery())
+			}
+
+			if !link.GetBlastPropagation().GetIn() || !link.GetBlastPropagation().GetOut() {
+				t.Error("expected event source links to propagate in both directions")
+			}
+		})
+	}
+}
+
+func TestEventSourceMappingSearch(t *testing.T) {
+	adapter := NewLambdaEventSourceMappingAdapter(&TestLambdaClient{}, "123456789012", "eu-west-2")
+
+	tests := []struct {
+		Name  string
+		Query string
+	}{
+		{
+			Name:  "mapping ARN",
+			Query: "arn:aws:lambda:eu-west-2:123456789012:event-source-mapping:a1b2c3d4-5678-90ab-cdef-11111EXAMPLE",
+		},
+		{
+			Name:  "function name",
+			Query: "process-orders",
+		},
+		{
+			Name:  "event source ARN",
+			Query: "arn:aws:sqs:eu-west-2:123456789012:orders",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Name, func(t *testing.T) {
+			items := make([]*sdp.Item, 0)
+			errs := make([]error, 0)
+			stream := discovery.NewQueryResultStream(
+				func(item *sdp.Item) {
+					items = append(items, item)
+				},
+				func(err error) {
+					errs = append(errs, err)
+				},
+			)
+
+			adapter.SearchStream(context.Background(), "123456789012.eu-west-2", test.Query, true, stream)
+			stream.Close()
+
+			if len(errs) > 0 {
+				t.Fatal(errs)
+			}
+
+			if len(items) != 1 {
+				t.Fatalf("expected 1 item, got %v", len(items))
+			}
+
+			if uuid := items[0].UniqueAttributeValue(); uuid != "a1b2c3d4-5678-90ab-cdef-11111EXAMPLE" {
+				t.Errorf("expected UUID a1b2c3d4-5678-90ab-cdef-11111EXAMPLE, got %v", uuid)
+			}
+		})
+	}
+}
+
+func TestNewLambdaEventSourceMappingAdapter(t *testing.T) {
+	client, account, region := lambdaGetAutoConfig(t)
+
+	adapter := NewLambdaEventSourceMappingAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,173 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func functionURLGetFunc(ctx context.Context, client LambdaClient, scope string, input *lambda.GetFunctionUrlConfigInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, errors.New("nil input")
+	}
+
+	out, err := client.GetFunctionUrlConfig(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(out, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.FunctionArn != nil {
+		// The URL is unique per function, or per alias if it has been
+		// created for one, so we use {functionName}[:{alias}] to identify it
+		attributes.Set("UniqueName", lambdaNameFromARN(*out.FunctionArn))
+	}
+
+	item := sdp.Item{
+		Type:            "lambda-function-url",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	var functionName string
+
+	if input.FunctionName != nil {
+		functionName, _ = lambdaQualifiedName(lambdaNameFromARN(*input.FunctionName))
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-function",
+				Method: sdp.QueryMethod_GET,
+				Query:  functionName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The URL invokes the function
+				In: true,
+				// Deleting the function deletes the URL
+				Out: true,
+			},
+		})
+	}
+
+	if input.Qualifier != nil && functionName != "" {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-alias",
+				Method: sdp.QueryMethod_GET,
+				Query:  functionName + ":" + *input.Qualifier,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The URL invokes the alias
+				In: true,
+				// Deleting the alias deletes the URL
+				Out: true,
+			},
+		})
+	}
+
+	if out.FunctionUrl != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  *out.FunctionUrl,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// These are tightly linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewLambdaFunctionURLAdapter(client LambdaClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*lambda.ListFunctionUrlConfigsInput, *lambda.ListFunctionUrlConfigsOutput, *lambda.GetFunctionUrlConfigInput, *lambda.GetFunctionUrlConfigOutput, LambdaClient, *lambda.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*lambda.ListFunctionUrlConfigsInput, *lambda.ListFunctionUrlConfigsOutput, *lambda.GetFunctionUrlConfigInput, *lambda.GetFunctionUrlConfigOutput, LambdaClient, *lambda.Options]{
+		ItemType:        "lambda-function-url",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: lambdaFunctionURLAdapterMetadata,
+		// Function URLs can only be listed per function
+		DisableList:      true,
+		AlwaysSearchARNs: true,
+		GetFunc:          functionURLGetFunc,
+		GetInputMapper: func(scope, query string) *lambda.GetFunctionUrlConfigInput {
+			// The query is in the format {functionName}[:{alias}]
+			functionName, qualifier := lambdaQualifiedName(query)
+
+			input := &lambda.GetFunctionUrlConfigInput{
+				FunctionName: &functionName,
+			}
+
+			if qualifier != "" {
+				input.Qualifier = &qualifier
+			}
+
+			return input
+		},
+		SearchInputMapper: func(scope, query string) (*lambda.ListFunctionUrlConfigsInput, error) {
+			return &lambda.ListFunctionUrlConfigsInput{
+				FunctionName: &query,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client LambdaClient, input *lambda.ListFunctionUrlConfigsInput) adapterhelpers.Paginator[*lambda.ListFunctionUrlConfigsOutput, *lambda.Options] {
+			return lambda.NewListFunctionUrlConfigsPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *lambda.ListFunctionUrlConfigsOutput, _ *lambda.ListFunctionUrlConfigsInput) ([]*lambda.GetFunctionUrlConfigInput, error) {
+			inputs := make([]*lambda.GetFunctionUrlConfigInput, 0, len(output.FunctionUrlConfigs))
+
+			for i := range output.FunctionUrlConfigs {
+				if output.FunctionUrlConfigs[i].FunctionArn == nil {
+					continue
+				}
+
+				functionName, qualifier := lambdaQualifiedName(lambdaNameFromARN(*output.FunctionUrlConfigs[i].FunctionArn))
+
+				input := &lambda.GetFunctionUrlConfigInput{
+					FunctionName: &functionName,
+				}
+
+				if qualifier != "" {
+					input.Qualifier = &qualifier
+				}
+
+				inputs = append(inputs, input)
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var lambdaFunctionURLAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "lambda-function-url",
+	DescriptiveName: "Lambda Function URL",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a function URL by {functionName} or {functionName}:{alias}",
+		SearchDescription: "Search for function URLs by function name, or by function ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_lambda_function_url.function_name"},
+	},
+	PotentialLinks: []string{"lambda-function", "lambda-alias", "http"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,86 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestLambdaClient) GetFunctionUrlConfig(ctx context.Context, params *lambda.GetFunctionUrlConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionUrlConfigOutput, error) {
+	functionARN := "arn:aws:lambda:eu-west-2:123456789012:function:" + *params.FunctionName
+
+	if params.Qualifier != nil {
+		functionARN += ":" + *params.Qualifier
+	}
+
+	return &lambda.GetFunctionUrlConfigOutput{
+		AuthType:         types.FunctionUrlAuthTypeAwsIam,
+		FunctionArn:      &functionARN,
+		FunctionUrl:      adapterhelpers.PtrString("https://abcdefghijklmnopqrstuvwxyz012345.lambda-url.eu-west-2.on.aws/"),
+		InvokeMode:       types.InvokeModeBuffered,
+		CreationTime:     adapterhelpers.PtrString("2024-01-01T00:00:00.000+0000"),
+		LastModifiedTime: adapterhelpers.PtrString("2024-01-01T00:00:00.000+0000"),
+	}, nil
+}
+
+func TestFunctionURLGetFunc(t *testing.T) {
+	item, err := functionURLGetFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2", &lambda.GetFunctionUrlConfigInput{
+		FunctionName: adapterhelpers.PtrString("process-orders"),
+		Qualifier:    adapterhelpers.PtrString("prod"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "process-orders:prod" {
+		t.Errorf("expected unique attribute value to be process-orders:prod, got %v", item.UniqueAttributeValue())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-alias",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders:prod",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://abcdefghijklmnopqrstuvwxyz012345.lambda-url.eu-west-2.on.aws/",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewLambdaFunctionURLAdapter(t *testing.T) {
+	client, account, region := lambdaGetAutoConfig(t)
+
+	adapter := NewLambdaFunctionURLAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
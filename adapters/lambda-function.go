@@ -14,13 +14,14 @@ import (
 )
 
 type FunctionDetails struct {
-	Code               *types.FunctionCodeLocation
-	Concurrency        *types.Concurrency
-	Configuration      *types.FunctionConfiguration
-	UrlConfigs         []*types.FunctionUrlConfig
-	EventInvokeConfigs []*types.FunctionEventInvokeConfig
-	Policy             *PolicyDocument
-	Tags               map[string]string
+	Code                 *types.FunctionCodeLocation
+	CodeSigningConfigArn *string
+	Concurrency          *types.Concurrency
+	Configuration        *types.FunctionConfiguration
+	UrlConfigs           []*types.FunctionUrlConfig
+	EventInvokeConfigs   []*types.FunctionEventInvokeConfig
+	Policy               *PolicyDocument
+	Tags                 map[string]string
 }
 
 // FunctionGetFunc Gets the details of a specific lambda function
@@ -84,6 +85,16 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 		}
 	}
 
+	// The code signing config isn't included in the function's configuration.
+	// Most functions don't have one, so errors are ignored
+	signingConfig, err := client.GetFunctionCodeSigningConfig(ctx, &lambda.GetFunctionCodeSigningConfigInput{
+		FunctionName: out.Configuration.FunctionName,
+	})
+
+	if err == nil && signingConfig != nil && signingConfig.CodeSigningConfigArn != nil && *signingConfig.CodeSigningConfigArn != "" {
+		function.CodeSigningConfigArn = signingConfig.CodeSigningConfigArn
+	}
+
 	// Get policies as this is often where triggers are stored
 	policyResponse, err := client.GetPolicy(ctx, &lambda.GetPolicyInput{
 		FunctionName: out.Configuration.FunctionName,
@@ -379,6 +390,25 @@ func functionGetFunc(ctx context.Context, client LambdaClient, scope string, inp
 			}
 		}
 
+		if function.CodeSigningConfigArn != nil {
+			if a, err = adapterhelpers.ParseARN(*function.CodeSigningConfigArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-code-signing-config",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *function.CodeSigningConfigArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The config decides whether new code can be deployed
+						In: true,
+						// Changing the function won't affect the config
+						Out: false,
+					},
+				})
+			}
+		}
+
 		if function.Configuration.VpcConfig != nil {
 			for _, id := range function.Configuration.VpcConfig.SecurityGroupIds {
 				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
@@ -656,6 +686,6 @@ var lambdaFunctionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_lambda_function_event_invoke_config.id"},
 		{TerraformQueryMap: "aws_lambda_function_url.function_arn"},
 	},
-	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group"},
+	PotentialLinks: []string{"iam-role", "s3-bucket", "sns-topic", "sqs-queue", "lambda-function", "events-event-bus", "elbv2-target-group", "vpc-lattice-target-group", "logs-log-group", "lambda-code-signing-config", "signer-signing-job", "signer-signing-profile"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
@@ -170,6 +170,13 @@ func (t TestLambdaClient) GetPolicy(ctx context.Context, params *lambda.GetPolic
 	}, nil
 }
 
+func (t *TestLambdaClient) GetFunctionCodeSigningConfig(ctx context.Context, params *lambda.GetFunctionCodeSigningConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionCodeSigningConfigOutput, error) {
+	return &lambda.GetFunctionCodeSigningConfigOutput{
+		CodeSigningConfigArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:052392120703:code-signing-config:csc-0f6b8d2e4a1c3b5d7"),
+		FunctionName:         params.FunctionName,
+	}, nil
+}
+
 func TestFunctionGetFunc(t *testing.T) {
 	item, err := functionGetFunc(context.Background(), &TestLambdaClient{}, "foo", &lambda.GetFunctionInput{})
 
@@ -260,6 +267,12 @@ func TestFunctionGetFunc(t *testing.T) {
 			ExpectedQuery:  "arn:aws:service:region:account:type/id",
 			ExpectedScope:  "account.region",
 		},
+		{
+			ExpectedType:   "lambda-code-signing-config",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:052392120703:code-signing-config:csc-0f6b8d2e4a1c3b5d7",
+			ExpectedScope:  "052392120703.eu-west-2",
+		},
 		{
 			ExpectedType:   "ec2-security-group",
 			ExpectedMethod: sdp.QueryMethod_GET,
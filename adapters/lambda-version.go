@@ -0,0 +1,214 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// versionGetFunc The input for this function is a
+// GetFunctionConfigurationInput with the FunctionName set to the name of the
+// function, and the Qualifier set to the version number
+func versionGetFunc(ctx context.Context, client LambdaClient, scope string, input *lambda.GetFunctionConfigurationInput) (*sdp.Item, error) {
+	if input == nil {
+		return nil, errors.New("nil input")
+	}
+
+	out, err := client.GetFunctionConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(out, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	var functionName string
+
+	if out.FunctionName != nil {
+		functionName = *out.FunctionName
+	}
+
+	if out.Version != nil {
+		// Add a unique attribute since the version number is only unique
+		// within the function
+		attributes.Set("UniqueName", functionName+":"+*out.Version)
+	}
+
+	item := sdp.Item{
+		Type:            "lambda-version",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "lambda-function",
+					Method: sdp.QueryMethod_GET,
+					Query:  functionName,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Deleting the function deletes all of its versions
+					In: true,
+					// Versions are immutable so can't affect the function
+					Out: false,
+				},
+			},
+		},
+	}
+
+	switch out.State {
+	case types.StatePending:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.StateActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StateFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if out.Role != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*out.Role, scope))
+	}
+
+	for _, layer := range out.Layers {
+		if layer.Arn != nil {
+			if a, err := adapterhelpers.ParseARN(*layer.Arn); err == nil {
+				// Strip the leading "layer:"
+				name := strings.TrimPrefix(a.Resource, "layer:")
+
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-layer-version",
+						Method: sdp.QueryMethod_GET,
+						Query:  name,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The layer's code runs as part of the version
+						In: true,
+						// The version can't affect the layer
+						Out: false,
+					},
+				})
+			}
+		}
+	}
+
+	if out.KMSKeyArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*out.KMSKeyArn, scope))
+	}
+
+	if out.VpcConfig != nil {
+		for _, id := range out.VpcConfig.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  id,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The version runs in the subnet
+					In: true,
+					// The version can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, id := range out.VpcConfig.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  id,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group controls the version's traffic
+					In: true,
+					// The version can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewLambdaVersionAdapter(client LambdaClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*lambda.ListVersionsByFunctionInput, *lambda.ListVersionsByFunctionOutput, *lambda.GetFunctionConfigurationInput, *lambda.GetFunctionConfigurationOutput, LambdaClient, *lambda.Options] {
+	return &adapterhelpers.AlwaysGetAdapter[*lambda.ListVersionsByFunctionInput, *lambda.ListVersionsByFunctionOutput, *lambda.GetFunctionConfigurationInput, *lambda.GetFunctionConfigurationOutput, LambdaClient, *lambda.Options]{
+		ItemType:        "lambda-version",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: lambdaVersionAdapterMetadata,
+		// Versions can only be listed per function
+		DisableList:      true,
+		AlwaysSearchARNs: true,
+		GetFunc:          versionGetFunc,
+		GetInputMapper: func(scope, query string) *lambda.GetFunctionConfigurationInput {
+			// The query is in the format {functionName}:{version}
+			functionName, version := lambdaQualifiedName(query)
+
+			if functionName == "" || version == "" {
+				return nil
+			}
+
+			return &lambda.GetFunctionConfigurationInput{
+				FunctionName: &functionName,
+				Qualifier:    &version,
+			}
+		},
+		SearchInputMapper: func(scope, query string) (*lambda.ListVersionsByFunctionInput, error) {
+			return &lambda.ListVersionsByFunctionInput{
+				FunctionName: &query,
+			}, nil
+		},
+		ListFuncPaginatorBuilder: func(client LambdaClient, input *lambda.ListVersionsByFunctionInput) adapterhelpers.Paginator[*lambda.ListVersionsByFunctionOutput, *lambda.Options] {
+			return lambda.NewListVersionsByFunctionPaginator(client, input)
+		},
+		ListFuncOutputMapper: func(output *lambda.ListVersionsByFunctionOutput, _ *lambda.ListVersionsByFunctionInput) ([]*lambda.GetFunctionConfigurationInput, error) {
+			inputs := make([]*lambda.GetFunctionConfigurationInput, 0, len(output.Versions))
+
+			for i := range output.Versions {
+				// $LATEST is returned alongside the published versions but
+				// is the function itself, so is covered by lambda-function
+				if output.Versions[i].Version == nil || *output.Versions[i].Version == "$LATEST" {
+					continue
+				}
+
+				inputs = append(inputs, &lambda.GetFunctionConfigurationInput{
+					FunctionName: output.Versions[i].FunctionName,
+					Qualifier:    output.Versions[i].Version,
+				})
+			}
+
+			return inputs, nil
+		},
+	}
+}
+
+var lambdaVersionAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "lambda-version",
+	DescriptiveName: "Lambda Version",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a published version by {functionName}:{version}",
+		SearchDescription: "Search for published versions by function name, or by qualified function ARN",
+	},
+	PotentialLinks: []string{"lambda-function", "iam-role", "lambda-layer-version", "kms-key", "ec2-subnet", "ec2-security-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func (t *TestLambdaClient) GetFunctionConfiguration(ctx context.Context, params *lambda.GetFunctionConfigurationInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionConfigurationOutput, error) {
+	return &lambda.GetFunctionConfigurationOutput{
+		FunctionName: params.FunctionName,
+		FunctionArn:  adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:" + *params.FunctionName + ":" + *params.Qualifier),
+		Version:      params.Qualifier,
+		Runtime:      types.RuntimePython312,
+		Handler:      adapterhelpers.PtrString("app.handler"),
+		Role:         adapterhelpers.PtrString("arn:aws:iam::123456789012:role/process-orders"),
+		State:        types.StateActive,
+		KMSKeyArn:    adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e"),
+		Layers: []types.Layer{
+			{
+				Arn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:layer:shared-libs:7"),
+			},
+		},
+		VpcConfig: &types.VpcConfigResponse{
+			SubnetIds:        []string{"subnet-0a1b2c3d"},
+			SecurityGroupIds: []string{"sg-0123456789abcdef0"},
+			VpcId:            adapterhelpers.PtrString("vpc-0a1b2c3d"),
+		},
+	}, nil
+}
+
+func (t *TestLambdaClient) ListVersionsByFunction(context.Context, *lambda.ListVersionsByFunctionInput, ...func(*lambda.Options)) (*lambda.ListVersionsByFunctionOutput, error) {
+	return &lambda.ListVersionsByFunctionOutput{
+		Versions: []types.FunctionConfiguration{
+			{
+				FunctionName: adapterhelpers.PtrString("process-orders"),
+				Version:      adapterhelpers.PtrString("$LATEST"),
+			},
+			{
+				FunctionName: adapterhelpers.PtrString("process-orders"),
+				Version:      adapterhelpers.PtrString("3"),
+			},
+		},
+	}, nil
+}
+
+func TestVersionGetFunc(t *testing.T) {
+	item, err := versionGetFunc(context.Background(), &TestLambdaClient{}, "123456789012.eu-west-2", &lambda.GetFunctionConfigurationInput{
+		FunctionName: adapterhelpers.PtrString("process-orders"),
+		Qualifier:    adapterhelpers.PtrString("3"),
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "process-orders:3" {
+		t.Errorf("expected unique attribute value to be process-orders:3, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "process-orders",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/process-orders",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "lambda-layer-version",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "shared-libs:7",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/0f8b5a37-1e5d-4b5a-9e1b-6b0f3c1f2d3e",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestVersionListFuncOutputMapper(t *testing.T) {
+	adapter := NewLambdaVersionAdapter(&TestLambdaClient{}, "123456789012", "eu-west-2")
+
+	output, _ := (&TestLambdaClient{}).ListVersionsByFunction(context.Background(), &lambda.ListVersionsByFunctionInput{})
+
+	inputs, err := adapter.ListFuncOutputMapper(output, &lambda.ListVersionsByFunctionInput{})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// $LATEST should be skipped since it's the function itself
+	if len(inputs) != 1 {
+		t.Fatalf("expected 1 input, got %v", len(inputs))
+	}
+
+	if *inputs[0].Qualifier != "3" {
+		t.Errorf("expected qualifier to be 3, got %v", *inputs[0].Qualifier)
+	}
+}
+
+func TestNewLambdaVersionAdapter(t *testing.T) {
+	client, account, region := lambdaGetAutoConfig(t)
+
+	adapter := NewLambdaVersionAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
@@ -2,21 +2,55 @@ package adapters
 
 import (
 	"context"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
 )
 
 // LambdaClient Represents the client we need to talk to Lambda, usually this is
 // *lambda.Client
 type LambdaClient interface {
+	GetAlias(ctx context.Context, params *lambda.GetAliasInput, optFns ...func(*lambda.Options)) (*lambda.GetAliasOutput, error)
+	GetCodeSigningConfig(ctx context.Context, params *lambda.GetCodeSigningConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetCodeSigningConfigOutput, error)
+	GetEventSourceMapping(ctx context.Context, params *lambda.GetEventSourceMappingInput, optFns ...func(*lambda.Options)) (*lambda.GetEventSourceMappingOutput, error)
 	GetFunction(ctx context.Context, params *lambda.GetFunctionInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionOutput, error)
+	GetFunctionCodeSigningConfig(ctx context.Context, params *lambda.GetFunctionCodeSigningConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionCodeSigningConfigOutput, error)
+	GetFunctionConfiguration(ctx context.Context, params *lambda.GetFunctionConfigurationInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionConfigurationOutput, error)
+	GetFunctionUrlConfig(ctx context.Context, params *lambda.GetFunctionUrlConfigInput, optFns ...func(*lambda.Options)) (*lambda.GetFunctionUrlConfigOutput, error)
 	GetLayerVersion(ctx context.Context, params *lambda.GetLayerVersionInput, optFns ...func(*lambda.Options)) (*lambda.GetLayerVersionOutput, error)
 	GetPolicy(ctx context.Context, params *lambda.GetPolicyInput, optFns ...func(*lambda.Options)) (*lambda.GetPolicyOutput, error)
 
+	lambda.ListAliasesAPIClient
+	lambda.ListCodeSigningConfigsAPIClient
+	lambda.ListEventSourceMappingsAPIClient
 	lambda.ListFunctionEventInvokeConfigsAPIClient
 	lambda.ListFunctionUrlConfigsAPIClient
 	lambda.ListFunctionsAPIClient
+	lambda.ListFunctionsByCodeSigningConfigAPIClient
 	lambda.ListLayerVersionsAPIClient
+	lambda.ListVersionsByFunctionAPIClient
+}
+
+// lambdaQualifiedName Splits a query in the format {functionName}:{qualifier}
+// into its parts. This is the same format as the end of a qualified function
+// ARN e.g. arn:aws:lambda:eu-west-2:123456789012:function:my-function:prod.
+// Function names can't contain colons so there is no ambiguity
+func lambdaQualifiedName(query string) (string, string) {
+	name, qualifier, _ := strings.Cut(query, ":")
+
+	return name, qualifier
+}
+
+// lambdaNameFromARN Returns the {functionName}[:{qualifier}] part of a
+// function ARN. If the input isn't an ARN it is returned as-is
+func lambdaNameFromARN(functionARN string) string {
+	if a, err := adapterhelpers.ParseARN(functionARN); err == nil {
+		return a.ResourceID()
+	}
+
+	return functionARN
 }
 
 // This is derived from the AWS example:
@@ -182,3 +182,55 @@ func TestParsePolicy(t *testing.T) {
 		t.Errorf("Expected Condition.StringEquals.AWSSourceAccount to be 540044833068, got %s", policy.Statement[5].Condition.StringEquals.AWSSourceAccount)
 	}
 }
+
+func TestLambdaQualifiedName(t *testing.T) {
+	tests := []struct {
+		Query             string
+		ExpectedName      string
+		ExpectedQualifier string
+	}{
+		{
+			Query:             "process-orders",
+			ExpectedName:      "process-orders",
+			ExpectedQualifier: "",
+		},
+		{
+			Query:             "process-orders:prod",
+			ExpectedName:      "process-orders",
+			ExpectedQualifier: "prod",
+		},
+		{
+			Query:             "process-orders:3",
+			ExpectedName:      "process-orders",
+			ExpectedQualifier: "3",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			name, qualifier := lambdaQualifiedName(test.Query)
+
+			if name != test.ExpectedName {
+				t.Errorf("expected name %v, got %v", test.ExpectedName, name)
+			}
+
+			if qualifier != test.ExpectedQualifier {
+				t.Errorf("expected qualifier %v, got %v", test.ExpectedQualifier, qualifier)
+			}
+		})
+	}
+}
+
+func TestLambdaNameFromARN(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:lambda:eu-west-2:123456789012:function:process-orders":      "process-orders",
+		"arn:aws:lambda:eu-west-2:123456789012:function:process-orders:prod": "process-orders:prod",
+		"process-orders": "process-orders",
+	}
+
+	for input, expected := range tests {
+		if actual := lambdaNameFromARN(input); actual != expected {
+			t.Errorf("expected %v for %v, got %v", expected, input, actual)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// OrganizationsAccountDetails An account along with the root or OU that it
+// sits in
+type OrganizationsAccountDetails struct {
+	Account *types.Account
+	Parents []types.Parent
+}
+
+func organizationsAccountGetFunc(ctx context.Context, client OrganizationsClient, _, query string) (*OrganizationsAccountDetails, error) {
+	out, err := client.DescribeAccount(ctx, &organizations.DescribeAccountInput{
+		AccountId: adapterhelpers.PtrString(organizationsIDFromQuery(query)),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Account == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "account was nil",
+		}
+	}
+
+	return enrichOrganizationsAccount(ctx, client, out.Account)
+}
+
+func enrichOrganizationsAccount(ctx context.Context, client OrganizationsClient, account *types.Account) (*OrganizationsAccountDetails, error) {
+	details := OrganizationsAccountDetails{
+		Account: account,
+	}
+
+	if account.Id != nil {
+		parents, err := organizationsListParents(ctx, client, *account.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Parents = parents
+	}
+
+	return &details, nil
+}
+
+func organizationsAccountStatusToHealth(status types.AccountStatus) *sdp.Health {
+	switch status {
+	case types.AccountStatusActive:
+		return sdp.Health_HEALTH_OK.Enum()
+	case types.AccountStatusPendingClosure:
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case types.AccountStatusSuspended:
+		// Suspended accounts can't run anything until they are reinstated
+		return sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	return nil
+}
+
+func organizationsAccountItemMapper(_ *string, scope string, awsItem *OrganizationsAccountDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem.Account)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:              "organizations-account",
+		UniqueAttribute:   "Id",
+		Attributes:        attributes,
+		Scope:             scope,
+		Health:            organizationsAccountStatusToHealth(awsItem.Account.Status),
+		LinkedItemQueries: organizationsParentLinks(awsItem.Parents, scope),
+	}
+
+	return &item, nil
+}
+
+func NewOrganizationsAccountAdapter(client OrganizationsClient, accountID string) *adapterhelpers.GetListAdapterV2[*organizations.ListAccountsInput, *organizations.ListAccountsOutput, *OrganizationsAccountDetails, OrganizationsClient, *organizations.Options] {
+	return &adapterhelpers.GetListAdapterV2[*organizations.ListAccountsInput, *organizations.ListAccountsOutput, *OrganizationsAccountDetails, OrganizationsClient, *organizations.Options]{
+		ItemType:        "organizations-account",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "",            // Organizations isn't tied to a region
+		CacheDuration:   3 * time.Hour, // Organizations has very low rate limits
+		AdapterMetadata: organizationsAccountAdapterMetadata,
+		GetFunc:         organizationsAccountGetFunc,
+		InputMapperList: func(scope string) (*organizations.ListAccountsInput, error) {
+			return &organizations.ListAccountsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client OrganizationsClient, input *organizations.ListAccountsInput) adapterhelpers.Paginator[*organizations.ListAccountsOutput, *organizations.Options] {
+			return organizations.NewListAccountsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *organizations.ListAccountsOutput, client OrganizationsClient) ([]*OrganizationsAccountDetails, error) {
+			accounts := make([]*OrganizationsAccountDetails, 0, len(output.Accounts))
+
+			for i := range output.Accounts {
+				details, err := enrichOrganizationsAccount(ctx, client, &output.Accounts[i])
+
+				if err != nil {
+					return nil, err
+				}
+
+				accounts = append(accounts, details)
+			}
+
+			return accounts, nil
+		},
+		ItemMapper: organizationsAccountItemMapper,
+		ListTagsFunc: func(ctx context.Context, a *OrganizationsAccountDetails, client OrganizationsClient) (map[string]string, error) {
+			if a.Account.Id == nil {
+				return nil, nil
+			}
+
+			return organizationsListTags(ctx, client, *a.Account.Id)
+		},
+	}
+}
+
+var organizationsAccountAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "organizations-account",
+	DescriptiveName: "Organizations Account",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an account in the organization by account ID",
+		ListDescription:   "List all accounts in the organization",
+		SearchDescription: "Search for accounts in the organization by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_organizations_account.id"},
+	},
+	PotentialLinks: []string{"organizations-root", "organizations-organizational-unit"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,69 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestOrganizationsAccountItemMapper(t *testing.T) {
+	details := &OrganizationsAccountDetails{
+		Account: &types.Account{
+			Arn:             adapterhelpers.PtrString("arn:aws:organizations::111111111111:account/o-exampleorgid/222222222222"),
+			Email:           adapterhelpers.PtrString("workloads@example.com"),
+			Id:              adapterhelpers.PtrString("222222222222"),
+			JoinedMethod:    types.AccountJoinedMethodCreated,
+			JoinedTimestamp: adapterhelpers.PtrTime(time.Now()),
+			Name:            adapterhelpers.PtrString("workloads"),
+			Status:          types.AccountStatusSuspended,
+		},
+		Parents: []types.Parent{
+			{
+				Id:   adapterhelpers.PtrString("ou-ab12-cdef3456"),
+				Type: types.ParentTypeOrganizationalUnit,
+			},
+		},
+	}
+
+	item, err := organizationsAccountItemMapper(nil, "111111111111", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "organizations-organizational-unit",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ou-ab12-cdef3456",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewOrganizationsAccountAdapter(t *testing.T) {
+	client, account, _ := organizationsGetAutoConfig(t)
+
+	adapter := NewOrganizationsAccountAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
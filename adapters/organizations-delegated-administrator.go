@@ -0,0 +1,180 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// DelegatedAdministratorDetails A delegated administrator account along with
+// the services that it administers
+type DelegatedAdministratorDetails struct {
+	Administrator *types.DelegatedAdministrator
+	Services      []types.DelegatedService
+}
+
+// listDelegatedAdministrators Lists delegated administrators, optionally only
+// those for a given service principal, along with their delegated services
+func listDelegatedAdministrators(ctx context.Context, client OrganizationsClient, servicePrincipal *string) ([]*DelegatedAdministratorDetails, error) {
+	admins := make([]*DelegatedAdministratorDetails, 0)
+
+	paginator := organizations.NewListDelegatedAdministratorsPaginator(client, &organizations.ListDelegatedAdministratorsInput{
+		ServicePrincipal: servicePrincipal,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.DelegatedAdministrators {
+			details, err := enrichDelegatedAdministrator(ctx, client, &out.DelegatedAdministrators[i])
+
+			if err != nil {
+				return nil, err
+			}
+
+			admins = append(admins, details)
+		}
+	}
+
+	return admins, nil
+}
+
+func enrichDelegatedAdministrator(ctx context.Context, client OrganizationsClient, admin *types.DelegatedAdministrator) (*DelegatedAdministratorDetails, error) {
+	details := DelegatedAdministratorDetails{
+		Administrator: admin,
+	}
+
+	if admin.Id == nil {
+		return &details, nil
+	}
+
+	paginator := organizations.NewListDelegatedServicesForAccountPaginator(client, &organizations.ListDelegatedServicesForAccountInput{
+		AccountId: admin.Id,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Services = append(details.Services, out.DelegatedServices...)
+	}
+
+	return &details, nil
+}
+
+func delegatedAdministratorGetFunc(ctx context.Context, client OrganizationsClient, _, query string) (*DelegatedAdministratorDetails, error) {
+	// There is no API to describe a single delegated administrator so we
+	// have to list them and find the right account
+	paginator := organizations.NewListDelegatedAdministratorsPaginator(client, &organizations.ListDelegatedAdministratorsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.DelegatedAdministrators {
+			if out.DelegatedAdministrators[i].Id != nil && *out.DelegatedAdministrators[i].Id == query {
+				return enrichDelegatedAdministrator(ctx, client, &out.DelegatedAdministrators[i])
+			}
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "account is not a delegated administrator",
+	}
+}
+
+func delegatedAdministratorItemMapper(_, scope string, awsItem *DelegatedAdministratorDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*types.DelegatedAdministrator
+		DelegatedServices []types.DelegatedService
+	}{
+		DelegatedAdministrator: awsItem.Administrator,
+		DelegatedServices:      awsItem.Services,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "organizations-delegated-administrator",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          organizationsAccountStatusToHealth(awsItem.Administrator.Status),
+	}
+
+	if awsItem.Administrator.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "organizations-account",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.Administrator.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Closing or removing the account removes the delegation
+				In: true,
+				// Delegation changes what the account is able to administer
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewOrganizationsDelegatedAdministratorAdapter(client OrganizationsClient, accountID string) *adapterhelpers.GetListAdapter[*DelegatedAdministratorDetails, OrganizationsClient, *organizations.Options] {
+	return &adapterhelpers.GetListAdapter[*DelegatedAdministratorDetails, OrganizationsClient, *organizations.Options]{
+		ItemType:        "organizations-delegated-administrator",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "",            // Organizations isn't tied to a region
+		CacheDuration:   3 * time.Hour, // Organizations has very low rate limits
+		AdapterMetadata: organizationsDelegatedAdministratorAdapterMetadata,
+		GetFunc:         delegatedAdministratorGetFunc,
+		ListFunc: func(ctx context.Context, client OrganizationsClient, scope string) ([]*DelegatedAdministratorDetails, error) {
+			return listDelegatedAdministrators(ctx, client, nil)
+		},
+		SearchFunc: func(ctx context.Context, client OrganizationsClient, scope, query string) ([]*DelegatedAdministratorDetails, error) {
+			return listDelegatedAdministrators(ctx, client, &query)
+		},
+		ItemMapper: delegatedAdministratorItemMapper,
+	}
+}
+
+var organizationsDelegatedAdministratorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "organizations-delegated-administrator",
+	DescriptiveName: "Organizations Delegated Administrator",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a delegated administrator by account ID",
+		ListDescription:   "List all delegated administrators in the organization",
+		SearchDescription: "Search for delegated administrators by service principal e.g. guardduty.amazonaws.com",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_organizations_delegated_administrator.account_id"},
+	},
+	PotentialLinks: []string{"organizations-account"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
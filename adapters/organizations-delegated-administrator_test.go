@@ -0,0 +1,66 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestDelegatedAdministratorItemMapper(t *testing.T) {
+	details := &DelegatedAdministratorDetails{
+		Administrator: &types.DelegatedAdministrator{
+			Arn:                   adapterhelpers.PtrString("arn:aws:organizations::111111111111:account/o-exampleorgid/333333333333"),
+			DelegationEnabledDate: adapterhelpers.PtrTime(time.Now()),
+			Email:                 adapterhelpers.PtrString("security@example.com"),
+			Id:                    adapterhelpers.PtrString("333333333333"),
+			JoinedMethod:          types.AccountJoinedMethodInvited,
+			JoinedTimestamp:       adapterhelpers.PtrTime(time.Now()),
+			Name:                  adapterhelpers.PtrString("security"),
+			Status:                types.AccountStatusActive,
+		},
+		Services: []types.DelegatedService{
+			{
+				DelegationEnabledDate: adapterhelpers.PtrTime(time.Now()),
+				ServicePrincipal:      adapterhelpers.PtrString("guardduty.amazonaws.com"),
+			},
+		},
+	}
+
+	item, err := delegatedAdministratorItemMapper("", "111111111111", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "organizations-account",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "333333333333",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewOrganizationsDelegatedAdministratorAdapter(t *testing.T) {
+	client, account, _ := organizationsGetAutoConfig(t)
+
+	adapter := NewOrganizationsDelegatedAdministratorAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
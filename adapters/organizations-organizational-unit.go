@@ -0,0 +1,196 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// OrganizationalUnitDetails An OU along with where it sits in the organization
+type OrganizationalUnitDetails struct {
+	OrganizationalUnit *types.OrganizationalUnit
+	Parents            []types.Parent
+	Children           []types.Child
+}
+
+func organizationalUnitGetFunc(ctx context.Context, client OrganizationsClient, _, query string) (*OrganizationalUnitDetails, error) {
+	out, err := client.DescribeOrganizationalUnit(ctx, &organizations.DescribeOrganizationalUnitInput{
+		OrganizationalUnitId: adapterhelpers.PtrString(organizationsIDFromQuery(query)),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.OrganizationalUnit == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "organizational unit was nil",
+		}
+	}
+
+	details := OrganizationalUnitDetails{
+		OrganizationalUnit: out.OrganizationalUnit,
+	}
+
+	if out.OrganizationalUnit.Id != nil {
+		details.Parents, err = organizationsListParents(ctx, client, *out.OrganizationalUnit.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Children, err = organizationsListChildren(ctx, client, *out.OrganizationalUnit.Id)
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &details, nil
+}
+
+// walkOrganizationalUnits Recursively finds all of the OUs beneath a given
+// parent. Since we already know the parent of each OU as we walk down the tree
+// we don't need to look it up separately
+func walkOrganizationalUnits(ctx context.Context, client OrganizationsClient, parent types.Parent) ([]*OrganizationalUnitDetails, error) {
+	units := make([]*OrganizationalUnitDetails, 0)
+
+	paginator := organizations.NewListOrganizationalUnitsForParentPaginator(client, &organizations.ListOrganizationalUnitsForParentInput{
+		ParentId: parent.Id,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.OrganizationalUnits {
+			ou := &out.OrganizationalUnits[i]
+
+			if ou.Id == nil {
+				continue
+			}
+
+			children, err := organizationsListChildren(ctx, client, *ou.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			units = append(units, &OrganizationalUnitDetails{
+				OrganizationalUnit: ou,
+				Parents:            []types.Parent{parent},
+				Children:           children,
+			})
+
+			nested, err := walkOrganizationalUnits(ctx, client, types.Parent{
+				Id:   ou.Id,
+				Type: types.ParentTypeOrganizationalUnit,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			units = append(units, nested...)
+		}
+	}
+
+	return units, nil
+}
+
+func organizationalUnitItemMapper(_ *string, scope string, awsItem *OrganizationalUnitDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem.OrganizationalUnit)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "organizations-organizational-unit",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, organizationsParentLinks(awsItem.Parents, scope)...)
+	item.LinkedItemQueries = append(item.LinkedItemQueries, organizationsChildLinks(awsItem.Children, scope)...)
+
+	return &item, nil
+}
+
+func NewOrganizationsOrganizationalUnitAdapter(client OrganizationsClient, accountID string) *adapterhelpers.GetListAdapterV2[*organizations.ListRootsInput, *organizations.ListRootsOutput, *OrganizationalUnitDetails, OrganizationsClient, *organizations.Options] {
+	return &adapterhelpers.GetListAdapterV2[*organizations.ListRootsInput, *organizations.ListRootsOutput, *OrganizationalUnitDetails, OrganizationsClient, *organizations.Options]{
+		ItemType:        "organizations-organizational-unit",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "",            // Organizations isn't tied to a region
+		CacheDuration:   3 * time.Hour, // Organizations has very low rate limits
+		AdapterMetadata: organizationsOrganizationalUnitAdapterMetadata,
+		GetFunc:         organizationalUnitGetFunc,
+		InputMapperList: func(scope string) (*organizations.ListRootsInput, error) {
+			return &organizations.ListRootsInput{}, nil
+		},
+		// There is no API to list all OUs, so we need to start at the roots and
+		// walk down the tree
+		ListFuncPaginatorBuilder: func(client OrganizationsClient, input *organizations.ListRootsInput) adapterhelpers.Paginator[*organizations.ListRootsOutput, *organizations.Options] {
+			return organizations.NewListRootsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *organizations.ListRootsOutput, client OrganizationsClient) ([]*OrganizationalUnitDetails, error) {
+			units := make([]*OrganizationalUnitDetails, 0)
+
+			for _, root := range output.Roots {
+				if root.Id == nil {
+					continue
+				}
+
+				rootUnits, err := walkOrganizationalUnits(ctx, client, types.Parent{
+					Id:   root.Id,
+					Type: types.ParentTypeRoot,
+				})
+
+				if err != nil {
+					return nil, err
+				}
+
+				units = append(units, rootUnits...)
+			}
+
+			return units, nil
+		},
+		ItemMapper: organizationalUnitItemMapper,
+		ListTagsFunc: func(ctx context.Context, ou *OrganizationalUnitDetails, client OrganizationsClient) (map[string]string, error) {
+			if ou.OrganizationalUnit.Id == nil {
+				return nil, nil
+			}
+
+			return organizationsListTags(ctx, client, *ou.OrganizationalUnit.Id)
+		},
+	}
+}
+
+var organizationsOrganizationalUnitAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "organizations-organizational-unit",
+	DescriptiveName: "Organizations Organizational Unit",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an organizational unit by ID",
+		ListDescription:   "List all organizational units in the organization",
+		SearchDescription: "Search for organizational units by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_organizations_organizational_unit.id"},
+	},
+	PotentialLinks: []string{"organizations-root", "organizations-organizational-unit", "organizations-account"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
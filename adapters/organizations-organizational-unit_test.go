@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestOrganizationalUnitItemMapper(t *testing.T) {
+	details := &OrganizationalUnitDetails{
+		OrganizationalUnit: &types.OrganizationalUnit{
+			Arn:  adapterhelpers.PtrString("arn:aws:organizations::111111111111:ou/o-exampleorgid/ou-ab12-cdef3456"),
+			Id:   adapterhelpers.PtrString("ou-ab12-cdef3456"),
+			Name: adapterhelpers.PtrString("Workloads"),
+		},
+		Parents: []types.Parent{
+			{
+				Id:   adapterhelpers.PtrString("r-ab12"),
+				Type: types.ParentTypeRoot,
+			},
+		},
+		Children: []types.Child{
+			{
+				Id:   adapterhelpers.PtrString("222222222222"),
+				Type: types.ChildTypeAccount,
+			},
+			{
+				Id:   adapterhelpers.PtrString("ou-ab12-prod7890"),
+				Type: types.ChildTypeOrganizationalUnit,
+			},
+		},
+	}
+
+	item, err := organizationalUnitItemMapper(nil, "111111111111", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "organizations-root",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "r-ab12",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "organizations-account",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "222222222222",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "organizations-organizational-unit",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ou-ab12-prod7890",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewOrganizationsOrganizationalUnitAdapter(t *testing.T) {
+	client, account, _ := organizationsGetAutoConfig(t)
+
+	adapter := NewOrganizationsOrganizationalUnitAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
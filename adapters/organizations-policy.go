@@ -0,0 +1,224 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// The types of policy that this adapter will list
+var organizationsPolicyTypes = []types.PolicyType{
+	types.PolicyTypeServiceControlPolicy,
+	types.PolicyTypeTagPolicy,
+	types.PolicyTypeBackupPolicy,
+}
+
+// OrganizationsPolicyDetails An organization policy along with the roots, OUs
+// and accounts that it is attached to
+type OrganizationsPolicyDetails struct {
+	Policy   *types.Policy
+	Document *policy.Policy
+	Targets  []types.PolicyTargetSummary
+}
+
+func organizationsPolicyGetFunc(ctx context.Context, client OrganizationsClient, _, query string) (*OrganizationsPolicyDetails, error) {
+	out, err := client.DescribePolicy(ctx, &organizations.DescribePolicyInput{
+		PolicyId: adapterhelpers.PtrString(organizationsIDFromQuery(query)),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Policy == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "policy was nil",
+		}
+	}
+
+	details := OrganizationsPolicyDetails{
+		Policy: out.Policy,
+	}
+
+	if out.Policy.PolicySummary == nil || out.Policy.PolicySummary.Id == nil {
+		return &details, nil
+	}
+
+	// SCPs use the same grammar as IAM policies so we can parse them and link
+	// to the resources they reference. Tag and backup policies have their own
+	// formats so are left as-is
+	if out.Policy.PolicySummary.Type == types.PolicyTypeServiceControlPolicy && out.Policy.Content != nil {
+		// If the document can't be parsed we still want to return the policy
+		details.Document, _ = ParsePolicyDocument(*out.Policy.Content)
+	}
+
+	paginator := organizations.NewListTargetsForPolicyPaginator(client, &organizations.ListTargetsForPolicyInput{
+		PolicyId: out.Policy.PolicySummary.Id,
+	})
+
+	for paginator.HasMorePages() {
+		targets, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Targets = append(details.Targets, targets.Targets...)
+	}
+
+	return &details, nil
+}
+
+func organizationsPolicyListFunc(ctx context.Context, client OrganizationsClient, scope string) ([]*OrganizationsPolicyDetails, error) {
+	policies := make([]*OrganizationsPolicyDetails, 0)
+
+	// ListPolicies requires a filter so we have to call it once per type
+	for _, policyType := range organizationsPolicyTypes {
+		paginator := organizations.NewListPoliciesPaginator(client, &organizations.ListPoliciesInput{
+			Filter: policyType,
+		})
+
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// The summary doesn't include the content, so we need to describe
+			// each policy
+			for _, summary := range out.Policies {
+				if summary.Id == nil {
+					continue
+				}
+
+				details, err := organizationsPolicyGetFunc(ctx, client, scope, *summary.Id)
+
+				if err != nil {
+					return nil, err
+				}
+
+				policies = append(policies, details)
+			}
+		}
+	}
+
+	return policies, nil
+}
+
+func organizationsPolicyItemMapper(_, scope string, awsItem *OrganizationsPolicyDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*types.PolicySummary
+		Content  *string
+		Document *policy.Policy
+	}{
+		PolicySummary: awsItem.Policy.PolicySummary,
+		Document:      awsItem.Document,
+	}
+
+	// Only include the raw content if we weren't able to parse it
+	if awsItem.Document == nil {
+		finalAttributes.Content = awsItem.Policy.Content
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "organizations-policy",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	for _, target := range awsItem.Targets {
+		if target.TargetId == nil {
+			continue
+		}
+
+		var typ string
+		switch target.Type {
+		case types.TargetTypeAccount:
+			typ = "organizations-account"
+		case types.TargetTypeOrganizationalUnit:
+			typ = "organizations-organizational-unit"
+		case types.TargetTypeRoot:
+			typ = "organizations-root"
+		default:
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   typ,
+				Method: sdp.QueryMethod_GET,
+				Query:  *target.TargetId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The target can't affect the policy
+				In: false,
+				// Changing the policy changes what can be done in the target
+				// and everything beneath it
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.Document != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.Document)...)
+	}
+
+	return &item, nil
+}
+
+func NewOrganizationsPolicyAdapter(client OrganizationsClient, accountID string) *adapterhelpers.GetListAdapter[*OrganizationsPolicyDetails, OrganizationsClient, *organizations.Options] {
+	return &adapterhelpers.GetListAdapter[*OrganizationsPolicyDetails, OrganizationsClient, *organizations.Options]{
+		ItemType:        "organizations-policy",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "",            // Organizations isn't tied to a region
+		CacheDuration:   3 * time.Hour, // Organizations has very low rate limits
+		AdapterMetadata: organizationsPolicyAdapterMetadata,
+		GetFunc:         organizationsPolicyGetFunc,
+		ListFunc:        organizationsPolicyListFunc,
+		ItemMapper:      organizationsPolicyItemMapper,
+		ListTagsFunc: func(ctx context.Context, p *OrganizationsPolicyDetails, client OrganizationsClient) (map[string]string, error) {
+			// AWS managed policies can't be tagged
+			if p.Policy.PolicySummary == nil || p.Policy.PolicySummary.Id == nil || p.Policy.PolicySummary.AwsManaged {
+				return nil, nil
+			}
+
+			return organizationsListTags(ctx, client, *p.Policy.PolicySummary.Id)
+		},
+	}
+}
+
+var organizationsPolicyAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "organizations-policy",
+	DescriptiveName: "Organizations Policy",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a service control, tag or backup policy by ID",
+		ListDescription:   "List all service control, tag and backup policies in the organization",
+		SearchDescription: "Search for organization policies by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_organizations_policy.id"},
+		{TerraformQueryMap: "aws_organizations_policy_attachment.policy_id"},
+	},
+	PotentialLinks: []string{"organizations-account", "organizations-organizational-unit", "organizations-root", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
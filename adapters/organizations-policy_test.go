@@ -0,0 +1,116 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestOrganizationsPolicyItemMapper(t *testing.T) {
+	content := `{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Sid": "DenyLeavingOrg",
+            "Effect": "Deny",
+            "Action": "organizations:LeaveOrganization",
+            "Resource": "*"
+        }
+    ]
+}`
+
+	document, err := ParsePolicyDocument(content)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	details := &OrganizationsPolicyDetails{
+		Policy: &types.Policy{
+			Content: adapterhelpers.PtrString(content),
+			PolicySummary: &types.PolicySummary{
+				Arn:         adapterhelpers.PtrString("arn:aws:organizations::111111111111:policy/o-exampleorgid/service_control_policy/p-examplepol1"),
+				AwsManaged:  false,
+				Description: adapterhelpers.PtrString("Stop accounts leaving the organization"),
+				Id:          adapterhelpers.PtrString("p-examplepol1"),
+				Name:        adapterhelpers.PtrString("deny-leave-org"),
+				Type:        types.PolicyTypeServiceControlPolicy,
+			},
+		},
+		Document: document,
+		Targets: []types.PolicyTargetSummary{
+			{
+				Arn:      adapterhelpers.PtrString("arn:aws:organizations::111111111111:root/o-exampleorgid/r-ab12"),
+				Name:     adapterhelpers.PtrString("Root"),
+				TargetId: adapterhelpers.PtrString("r-ab12"),
+				Type:     types.TargetTypeRoot,
+			},
+			{
+				Arn:      adapterhelpers.PtrString("arn:aws:organizations::111111111111:ou/o-exampleorgid/ou-ab12-cdef3456"),
+				Name:     adapterhelpers.PtrString("Workloads"),
+				TargetId: adapterhelpers.PtrString("ou-ab12-cdef3456"),
+				Type:     types.TargetTypeOrganizationalUnit,
+			},
+			{
+				Arn:      adapterhelpers.PtrString("arn:aws:organizations::111111111111:account/o-exampleorgid/222222222222"),
+				Name:     adapterhelpers.PtrString("workloads"),
+				TargetId: adapterhelpers.PtrString("222222222222"),
+				Type:     types.TargetTypeAccount,
+			},
+		},
+	}
+
+	item, err := organizationsPolicyItemMapper("", "111111111111", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if _, err := item.GetAttributes().Get("Document"); err != nil {
+		t.Errorf("expected parsed document to be in attributes: %v", err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "organizations-root",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "r-ab12",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "organizations-organizational-unit",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ou-ab12-cdef3456",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "organizations-account",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "222222222222",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewOrganizationsPolicyAdapter(t *testing.T) {
+	client, account, _ := organizationsGetAutoConfig(t)
+
+	adapter := NewOrganizationsPolicyAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
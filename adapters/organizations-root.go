@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// OrganizationsRootDetails The root of the organization along with the
+// accounts and OUs directly beneath it
+type OrganizationsRootDetails struct {
+	Root     *types.Root
+	Children []types.Child
+}
+
+func organizationsRootGetFunc(ctx context.Context, client OrganizationsClient, _, query string) (*OrganizationsRootDetails, error) {
+	id := organizationsIDFromQuery(query)
+
+	// There is no API to describe a single root, but an organization only
+	// has one so listing them is cheap
+	paginator := organizations.NewListRootsPaginator(client, &organizations.ListRootsInput{})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.Roots {
+			if out.Roots[i].Id != nil && *out.Roots[i].Id == id {
+				return enrichOrganizationsRoot(ctx, client, &out.Roots[i])
+			}
+		}
+	}
+
+	return nil, &sdp.QueryError{
+		ErrorType:   sdp.QueryError_NOTFOUND,
+		ErrorString: "root not found",
+	}
+}
+
+func enrichOrganizationsRoot(ctx context.Context, client OrganizationsClient, root *types.Root) (*OrganizationsRootDetails, error) {
+	details := OrganizationsRootDetails{
+		Root: root,
+	}
+
+	if root.Id != nil {
+		children, err := organizationsListChildren(ctx, client, *root.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Children = children
+	}
+
+	return &details, nil
+}
+
+func organizationsRootItemMapper(_ *string, scope string, awsItem *OrganizationsRootDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem.Root)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:              "organizations-root",
+		UniqueAttribute:   "Id",
+		Attributes:        attributes,
+		Scope:             scope,
+		LinkedItemQueries: organizationsChildLinks(awsItem.Children, scope),
+	}
+
+	return &item, nil
+}
+
+func NewOrganizationsRootAdapter(client OrganizationsClient, accountID string) *adapterhelpers.GetListAdapterV2[*organizations.ListRootsInput, *organizations.ListRootsOutput, *OrganizationsRootDetails, OrganizationsClient, *organizations.Options] {
+	return &adapterhelpers.GetListAdapterV2[*organizations.ListRootsInput, *organizations.ListRootsOutput, *OrganizationsRootDetails, OrganizationsClient, *organizations.Options]{
+		ItemType:        "organizations-root",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "",            // Organizations isn't tied to a region
+		CacheDuration:   3 * time.Hour, // Organizations has very low rate limits
+		AdapterMetadata: organizationsRootAdapterMetadata,
+		GetFunc:         organizationsRootGetFunc,
+		InputMapperList: func(scope string) (*organizations.ListRootsInput, error) {
+			return &organizations.ListRootsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client OrganizationsClient, input *organizations.ListRootsInput) adapterhelpers.Paginator[*organizations.ListRootsOutput, *organizations.Options] {
+			return organizations.NewListRootsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *organizations.ListRootsOutput, client OrganizationsClient) ([]*OrganizationsRootDetails, error) {
+			roots := make([]*OrganizationsRootDetails, 0, len(output.Roots))
+
+			for i := range output.Roots {
+				details, err := enrichOrganizationsRoot(ctx, client, &output.Roots[i])
+
+				if err != nil {
+					return nil, err
+				}
+
+				roots = append(roots, details)
+			}
+
+			return roots, nil
+		},
+		ItemMapper: organizationsRootItemMapper,
+		ListTagsFunc: func(ctx context.Context, r *OrganizationsRootDetails, client OrganizationsClient) (map[string]string, error) {
+			if r.Root.Id == nil {
+				return nil, nil
+			}
+
+			return organizationsListTags(ctx, client, *r.Root.Id)
+		},
+	}
+}
+
+var organizationsRootAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "organizations-root",
+	DescriptiveName: "Organizations Root",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get the root of the organization by ID",
+		ListDescription:   "List the roots of the organization",
+		SearchDescription: "Search for the root of the organization by ARN",
+	},
+	PotentialLinks: []string{"organizations-organizational-unit", "organizations-account"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestOrganizationsRootItemMapper(t *testing.T) {
+	details := &OrganizationsRootDetails{
+		Root: &types.Root{
+			Arn:  adapterhelpers.PtrString("arn:aws:organizations::111111111111:root/o-exampleorgid/r-ab12"),
+			Id:   adapterhelpers.PtrString("r-ab12"),
+			Name: adapterhelpers.PtrString("Root"),
+			PolicyTypes: []types.PolicyTypeSummary{
+				{
+					Status: types.PolicyTypeStatusEnabled,
+					Type:   types.PolicyTypeServiceControlPolicy,
+				},
+			},
+		},
+		Children: []types.Child{
+			{
+				Id:   adapterhelpers.PtrString("111111111111"),
+				Type: types.ChildTypeAccount,
+			},
+			{
+				Id:   adapterhelpers.PtrString("ou-ab12-cdef3456"),
+				Type: types.ChildTypeOrganizationalUnit,
+			},
+		},
+	}
+
+	item, err := organizationsRootItemMapper(nil, "111111111111", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "organizations-account",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "111111111111",
+			ExpectedScope:  "111111111111",
+		},
+		{
+			ExpectedType:   "organizations-organizational-unit",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ou-ab12-cdef3456",
+			ExpectedScope:  "111111111111",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewOrganizationsRootAdapter(t *testing.T) {
+	client, account, _ := organizationsGetAutoConfig(t)
+
+	adapter := NewOrganizationsRootAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,197 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/organizations/types"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+type OrganizationsClient interface {
+	DescribeAccount(ctx context.Context, params *organizations.DescribeAccountInput, optFns ...func(*organizations.Options)) (*organizations.DescribeAccountOutput, error)
+	DescribeOrganizationalUnit(ctx context.Context, params *organizations.DescribeOrganizationalUnitInput, optFns ...func(*organizations.Options)) (*organizations.DescribeOrganizationalUnitOutput, error)
+	DescribePolicy(ctx context.Context, params *organizations.DescribePolicyInput, optFns ...func(*organizations.Options)) (*organizations.DescribePolicyOutput, error)
+
+	organizations.ListAccountsAPIClient
+	organizations.ListChildrenAPIClient
+	organizations.ListDelegatedAdministratorsAPIClient
+	organizations.ListDelegatedServicesForAccountAPIClient
+	organizations.ListOrganizationalUnitsForParentAPIClient
+	organizations.ListParentsAPIClient
+	organizations.ListPoliciesAPIClient
+	organizations.ListRootsAPIClient
+	organizations.ListTagsForResourceAPIClient
+	organizations.ListTargetsForPolicyAPIClient
+}
+
+// organizationsIDFromQuery Returns the ID of an Organizations resource. The ARNs
+// for these resources include the organization ID (and for policies the policy
+// type) before the actual ID e.g.
+// arn:aws:organizations::111111111111:account/o-exampleorgid/222222222222 so
+// we only want the last section
+func organizationsIDFromQuery(query string) string {
+	return query[strings.LastIndex(query, "/")+1:]
+}
+
+// organizationsListParents Lists the parents of an account or OU. In practice
+// there is only ever one parent
+func organizationsListParents(ctx context.Context, client OrganizationsClient, childID string) ([]types.Parent, error) {
+	parents := make([]types.Parent, 0)
+
+	paginator := organizations.NewListParentsPaginator(client, &organizations.ListParentsInput{
+		ChildId: &childID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		parents = append(parents, out.Parents...)
+	}
+
+	return parents, nil
+}
+
+// organizationsListChildren Lists the accounts and OUs directly beneath a root
+// or OU
+func organizationsListChildren(ctx context.Context, client OrganizationsClient, parentID string) ([]types.Child, error) {
+	children := make([]types.Child, 0)
+
+	for _, childType := range []types.ChildType{types.ChildTypeAccount, types.ChildTypeOrganizationalUnit} {
+		paginator := organizations.NewListChildrenPaginator(client, &organizations.ListChildrenInput{
+			ParentId:  &parentID,
+			ChildType: childType,
+		})
+
+		for paginator.HasMorePages() {
+			out, err := paginator.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			children = append(children, out.Children...)
+		}
+	}
+
+	return children, nil
+}
+
+// organizationsParentLinks Links an account or OU to the root or OU that
+// contains it
+func organizationsParentLinks(parents []types.Parent, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, parent := range parents {
+		if parent.Id == nil {
+			continue
+		}
+
+		var typ string
+		switch parent.Type {
+		case types.ParentTypeRoot:
+			typ = "organizations-root"
+		case types.ParentTypeOrganizationalUnit:
+			typ = "organizations-organizational-unit"
+		default:
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   typ,
+				Method: sdp.QueryMethod_GET,
+				Query:  *parent.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Policies attached to the parent are inherited by the child
+				In: true,
+				// The child can't affect the parent
+				Out: false,
+			},
+		})
+	}
+
+	return links
+}
+
+// organizationsChildLinks Links a root or OU to the accounts and OUs beneath it
+func organizationsChildLinks(children []types.Child, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, child := range children {
+		if child.Id == nil {
+			continue
+		}
+
+		var typ string
+		switch child.Type {
+		case types.ChildTypeAccount:
+			typ = "organizations-account"
+		case types.ChildTypeOrganizationalUnit:
+			typ = "organizations-organizational-unit"
+		default:
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   typ,
+				Method: sdp.QueryMethod_GET,
+				Query:  *child.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Children can't affect their parent
+				In: false,
+				// Policies attached here are inherited by all children
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
+
+// organizationsListTags Gets the tags for an account, OU, root or policy
+func organizationsListTags(ctx context.Context, client OrganizationsClient, resourceID string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	paginator := organizations.NewListTagsForResourcePaginator(client, &organizations.ListTagsForResourceInput{
+		ResourceId: &resourceID,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range organizationsTagsToMap(out.Tags) {
+			tags[k] = v
+		}
+	}
+
+	return tags, nil
+}
+
+// Converts a slice of Organizations tags to a map
+func organizationsTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
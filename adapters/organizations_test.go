@@ -0,0 +1,30 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func organizationsGetAutoConfig(t *testing.T) (*organizations.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := organizations.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestOrganizationsIDFromQuery(t *testing.T) {
+	tests := map[string]string{
+		"222222222222":                                        "222222222222",
+		"o-exampleorgid/222222222222":                         "222222222222",
+		"o-exampleorgid/ou-ab12-cdef3456":                     "ou-ab12-cdef3456",
+		"o-exampleorgid/service_control_policy/p-examplepol1": "p-examplepol1",
+	}
+
+	for query, expected := range tests {
+		if id := organizationsIDFromQuery(query); id != expected {
+			t.Errorf("expected %v from %v, got %v", expected, query, id)
+		}
+	}
+}
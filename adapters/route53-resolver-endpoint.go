@@ -0,0 +1,247 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// Route53ResolverEndpointDetails An endpoint along with its IP addresses,
+// since these are returned by a separate API call
+type Route53ResolverEndpointDetails struct {
+	Endpoint    *types.ResolverEndpoint
+	IpAddresses []types.IpAddressResponse
+}
+
+func resolverEndpointGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*Route53ResolverEndpointDetails, error) {
+	out, err := client.GetResolverEndpoint(ctx, &route53resolver.GetResolverEndpointInput{
+		ResolverEndpointId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ResolverEndpoint == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "resolver endpoint was nil",
+		}
+	}
+
+	return enrichResolverEndpoint(ctx, client, out.ResolverEndpoint)
+}
+
+// enrichResolverEndpoint Fetches the IP addresses of an endpoint. These are
+// what on-prem DNS servers send queries to, or where queries are sent from, so
+// an endpoint isn't much use without them
+func enrichResolverEndpoint(ctx context.Context, client Route53ResolverClient, endpoint *types.ResolverEndpoint) (*Route53ResolverEndpointDetails, error) {
+	details := Route53ResolverEndpointDetails{
+		Endpoint: endpoint,
+	}
+
+	if endpoint.Id == nil {
+		return &details, nil
+	}
+
+	paginator := route53resolver.NewListResolverEndpointIpAddressesPaginator(client, &route53resolver.ListResolverEndpointIpAddressesInput{
+		ResolverEndpointId: endpoint.Id,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.IpAddresses = append(details.IpAddresses, out.IpAddresses...)
+	}
+
+	return &details, nil
+}
+
+func resolverEndpointItemMapper(_ *string, scope string, awsItem *Route53ResolverEndpointDetails) (*sdp.Item, error) {
+	endpoint := awsItem.Endpoint
+
+	enrichedEndpoint := struct {
+		*types.ResolverEndpoint
+		IpAddresses []types.IpAddressResponse
+	}{
+		ResolverEndpoint: endpoint,
+		IpAddresses:      awsItem.IpAddresses,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(enrichedEndpoint)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-endpoint",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch endpoint.Status {
+	case types.ResolverEndpointStatusOperational:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ResolverEndpointStatusCreating,
+		types.ResolverEndpointStatusUpdating,
+		types.ResolverEndpointStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ResolverEndpointStatusAutoRecovering:
+		// Resolver is replacing one or more of the ENIs
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.ResolverEndpointStatusActionNeeded:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if endpoint.HostVPCId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *endpoint.HostVPCId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The endpoint lives in the VPC
+				In: true,
+				// The endpoint can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	for _, sg := range endpoint.SecurityGroupIds {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  sg,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the security group can block DNS traffic
+				In: true,
+				// The endpoint can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	seenSubnets := make(map[string]bool)
+
+	for _, ip := range awsItem.IpAddresses {
+		if ip.SubnetId != nil && !seenSubnets[*ip.SubnetId] {
+			seenSubnets[*ip.SubnetId] = true
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  *ip.SubnetId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The endpoint's interfaces are in the subnet
+					In: true,
+					// The endpoint can't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, address := range []*string{ip.Ip, ip.Ipv6} {
+			if address != nil {
+				if link := route53ResolverIPLink(*address); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+
+				// Resolver doesn't return the IDs of the ENIs that it
+				// creates, so find them by the IPs that they hold
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ec2-network-interface",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *address,
+						Scope:  scope,
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The endpoint's traffic flows through the interface
+						In: true,
+						// Deleting the endpoint deletes its interfaces
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverEndpointAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverEndpointsInput, *route53resolver.ListResolverEndpointsOutput, *Route53ResolverEndpointDetails, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverEndpointsInput, *route53resolver.ListResolverEndpointsOutput, *Route53ResolverEndpointDetails, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-endpoint",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: resolverEndpointAdapterMetadata,
+		GetFunc:         resolverEndpointGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListResolverEndpointsInput, error) {
+			return &route53resolver.ListResolverEndpointsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListResolverEndpointsInput) adapterhelpers.Paginator[*route53resolver.ListResolverEndpointsOutput, *route53resolver.Options] {
+			return route53resolver.NewListResolverEndpointsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListResolverEndpointsOutput, client Route53ResolverClient) ([]*Route53ResolverEndpointDetails, error) {
+			endpoints := make([]*Route53ResolverEndpointDetails, 0, len(output.ResolverEndpoints))
+
+			for i := range output.ResolverEndpoints {
+				details, err := enrichResolverEndpoint(ctx, client, &output.ResolverEndpoints[i])
+
+				if err != nil {
+					return nil, err
+				}
+
+				endpoints = append(endpoints, details)
+			}
+
+			return endpoints, nil
+		},
+		ItemMapper: resolverEndpointItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *Route53ResolverEndpointDetails, client Route53ResolverClient) (map[string]string, error) {
+			if details.Endpoint.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *details.Endpoint.Arn)
+		},
+	}
+}
+
+var resolverEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-endpoint",
+	DescriptiveName: "Route 53 Resolver Endpoint",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Route 53 Resolver endpoint by ID",
+		ListDescription:   "List all Route 53 Resolver endpoints",
+		SearchDescription: "Search for Route 53 Resolver endpoints by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_endpoint.id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-security-group", "ec2-subnet", "ec2-network-interface", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,120 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestResolverEndpointItemMapper(t *testing.T) {
+	details := &Route53ResolverEndpointDetails{
+		Endpoint: &types.ResolverEndpoint{
+			Id:               adapterhelpers.PtrString("rslvr-out-0a1b2c3d4e5f6a7b8"),
+			Arn:              adapterhelpers.PtrString("arn:aws:route53resolver:eu-west-2:123456789012:resolver-endpoint/rslvr-out-0a1b2c3d4e5f6a7b8"),
+			Name:             adapterhelpers.PtrString("to-on-prem"),
+			Direction:        types.ResolverEndpointDirectionOutbound,
+			HostVPCId:        adapterhelpers.PtrString("vpc-0a1b2c3d"),
+			IpAddressCount:   adapterhelpers.PtrInt32(2),
+			SecurityGroupIds: []string{"sg-0a1b2c3d"},
+			Status:           types.ResolverEndpointStatusAutoRecovering,
+		},
+		IpAddresses: []types.IpAddressResponse{
+			{
+				IpId:     adapterhelpers.PtrString("rni-0a1b2c3d"),
+				Ip:       adapterhelpers.PtrString("10.0.1.10"),
+				SubnetId: adapterhelpers.PtrString("subnet-0a1b2c3d"),
+				Status:   types.IpAddressStatusAttached,
+			},
+			{
+				IpId:     adapterhelpers.PtrString("rni-4e5f6a7b"),
+				Ip:       adapterhelpers.PtrString("10.0.2.10"),
+				SubnetId: adapterhelpers.PtrString("subnet-4e5f6a7b"),
+				Status:   types.IpAddressStatusAttached,
+			},
+		},
+	}
+
+	item, err := resolverEndpointItemMapper(nil, "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "10.0.1.10",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.2.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "10.0.2.10",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverEndpointAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverEndpointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
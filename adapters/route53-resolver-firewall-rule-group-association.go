@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func firewallRuleGroupAssociationGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*types.FirewallRuleGroupAssociation, error) {
+	out, err := client.GetFirewallRuleGroupAssociation(ctx, &route53resolver.GetFirewallRuleGroupAssociationInput{
+		FirewallRuleGroupAssociationId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.FirewallRuleGroupAssociation == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "firewall rule group association was nil",
+		}
+	}
+
+	return out.FirewallRuleGroupAssociation, nil
+}
+
+func firewallRuleGroupAssociationItemMapper(_ *string, scope string, awsItem *types.FirewallRuleGroupAssociation) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-firewall-rule-group-association",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.FirewallRuleGroupAssociationStatusComplete:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.FirewallRuleGroupAssociationStatusUpdating, types.FirewallRuleGroupAssociationStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	if awsItem.FirewallRuleGroupId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53-resolver-firewall-rule-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.FirewallRuleGroupId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the rules change what is blocked in the VPC
+				In: true,
+				// The association can't affect the rule group
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, route53ResolverVPCLink(*awsItem.VpcId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverFirewallRuleGroupAssociationAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListFirewallRuleGroupAssociationsInput, *route53resolver.ListFirewallRuleGroupAssociationsOutput, *types.FirewallRuleGroupAssociation, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListFirewallRuleGroupAssociationsInput, *route53resolver.ListFirewallRuleGroupAssociationsOutput, *types.FirewallRuleGroupAssociation, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-firewall-rule-group-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: firewallRuleGroupAssociationAdapterMetadata,
+		GetFunc:         firewallRuleGroupAssociationGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListFirewallRuleGroupAssociationsInput, error) {
+			return &route53resolver.ListFirewallRuleGroupAssociationsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListFirewallRuleGroupAssociationsInput) adapterhelpers.Paginator[*route53resolver.ListFirewallRuleGroupAssociationsOutput, *route53resolver.Options] {
+			return route53resolver.NewListFirewallRuleGroupAssociationsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListFirewallRuleGroupAssociationsOutput, client Route53ResolverClient) ([]*types.FirewallRuleGroupAssociation, error) {
+			associations := make([]*types.FirewallRuleGroupAssociation, 0, len(output.FirewallRuleGroupAssociations))
+
+			for i := range output.FirewallRuleGroupAssociations {
+				associations = append(associations, &output.FirewallRuleGroupAssociations[i])
+			}
+
+			return associations, nil
+		},
+		ItemMapper: firewallRuleGroupAssociationItemMapper,
+		ListTagsFunc: func(ctx context.Context, association *types.FirewallRuleGroupAssociation, client Route53ResolverClient) (map[string]string, error) {
+			if association.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *association.Arn)
+		},
+	}
+}
+
+var firewallRuleGroupAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-firewall-rule-group-association",
+	DescriptiveName: "Route 53 Resolver DNS Firewall Rule Group Association",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a DNS Firewall rule group association by ID",
+		ListDescription:   "List all DNS Firewall rule group associations",
+		SearchDescription: "Search for DNS Firewall rule group associations by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_firewall_rule_group_association.id"},
+	},
+	PotentialLinks: []string{"route53-resolver-firewall-rule-group", "ec2-vpc"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
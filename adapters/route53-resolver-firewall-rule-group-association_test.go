@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFirewallRuleGroupAssociationItemMapper(t *testing.T) {
+	association := &types.FirewallRuleGroupAssociation{
+		Id:                  adapterhelpers.PtrString("rslvr-frgassoc-0a1b2c3d4e5f6a7b"),
+		Arn:                 adapterhelpers.PtrString("arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group-association/rslvr-frgassoc-0a1b2c3d4e5f6a7b"),
+		Name:                adapterhelpers.PtrString("block-malware-main-vpc"),
+		FirewallRuleGroupId: adapterhelpers.PtrString("rslvr-frg-0a1b2c3d4e5f6a7b"),
+		VpcId:               adapterhelpers.PtrString("vpc-0a1b2c3d"),
+		Priority:            adapterhelpers.PtrInt32(101),
+		MutationProtection:  types.MutationProtectionStatusEnabled,
+		Status:              types.FirewallRuleGroupAssociationStatusUpdating,
+	}
+
+	item, err := firewallRuleGroupAssociationItemMapper(nil, "123456789012.eu-west-2", association)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53-resolver-firewall-rule-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-frg-0a1b2c3d4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverFirewallRuleGroupAssociationAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverFirewallRuleGroupAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
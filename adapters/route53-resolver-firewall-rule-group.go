@@ -0,0 +1,177 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// Route53ResolverFirewallRuleGroupDetails A DNS Firewall rule group along with
+// its rules, since these are returned by a separate API call
+type Route53ResolverFirewallRuleGroupDetails struct {
+	RuleGroup *types.FirewallRuleGroup
+	Rules     []types.FirewallRule
+}
+
+func firewallRuleGroupGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*Route53ResolverFirewallRuleGroupDetails, error) {
+	out, err := client.GetFirewallRuleGroup(ctx, &route53resolver.GetFirewallRuleGroupInput{
+		FirewallRuleGroupId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.FirewallRuleGroup == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "firewall rule group was nil",
+		}
+	}
+
+	details := Route53ResolverFirewallRuleGroupDetails{
+		RuleGroup: out.FirewallRuleGroup,
+	}
+
+	paginator := route53resolver.NewListFirewallRulesPaginator(client, &route53resolver.ListFirewallRulesInput{
+		FirewallRuleGroupId: &query,
+	})
+
+	for paginator.HasMorePages() {
+		rules, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Rules = append(details.Rules, rules.FirewallRules...)
+	}
+
+	return &details, nil
+}
+
+func firewallRuleGroupItemMapper(_ *string, scope string, awsItem *Route53ResolverFirewallRuleGroupDetails) (*sdp.Item, error) {
+	ruleGroup := awsItem.RuleGroup
+
+	enrichedRuleGroup := struct {
+		*types.FirewallRuleGroup
+		Rules []types.FirewallRule
+	}{
+		FirewallRuleGroup: ruleGroup,
+		Rules:             awsItem.Rules,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(enrichedRuleGroup)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-firewall-rule-group",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch ruleGroup.Status {
+	case types.FirewallRuleGroupStatusComplete:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.FirewallRuleGroupStatusUpdating, types.FirewallRuleGroupStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	seenDomainLists := make(map[string]bool)
+
+	for _, rule := range awsItem.Rules {
+		if rule.FirewallDomainListId == nil || seenDomainLists[*rule.FirewallDomainListId] {
+			continue
+		}
+
+		seenDomainLists[*rule.FirewallDomainListId] = true
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53-resolver-firewall-domain-list",
+				Method: sdp.QueryMethod_GET,
+				Query:  *rule.FirewallDomainListId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changing the domains in the list changes what is blocked
+				In: true,
+				// The rule group can't affect the list
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverFirewallRuleGroupAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListFirewallRuleGroupsInput, *route53resolver.ListFirewallRuleGroupsOutput, *Route53ResolverFirewallRuleGroupDetails, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListFirewallRuleGroupsInput, *route53resolver.ListFirewallRuleGroupsOutput, *Route53ResolverFirewallRuleGroupDetails, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-firewall-rule-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: firewallRuleGroupAdapterMetadata,
+		GetFunc:         firewallRuleGroupGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListFirewallRuleGroupsInput, error) {
+			return &route53resolver.ListFirewallRuleGroupsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListFirewallRuleGroupsInput) adapterhelpers.Paginator[*route53resolver.ListFirewallRuleGroupsOutput, *route53resolver.Options] {
+			return route53resolver.NewListFirewallRuleGroupsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListFirewallRuleGroupsOutput, client Route53ResolverClient) ([]*Route53ResolverFirewallRuleGroupDetails, error) {
+			ruleGroups := make([]*Route53ResolverFirewallRuleGroupDetails, 0, len(output.FirewallRuleGroups))
+
+			// The list API only returns metadata, so we need to get each one
+			for _, metadata := range output.FirewallRuleGroups {
+				if metadata.Id == nil {
+					continue
+				}
+
+				details, err := firewallRuleGroupGetFunc(ctx, client, "", *metadata.Id)
+
+				if err != nil {
+					return nil, err
+				}
+
+				ruleGroups = append(ruleGroups, details)
+			}
+
+			return ruleGroups, nil
+		},
+		ItemMapper: firewallRuleGroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *Route53ResolverFirewallRuleGroupDetails, client Route53ResolverClient) (map[string]string, error) {
+			if details.RuleGroup.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *details.RuleGroup.Arn)
+		},
+	}
+}
+
+var firewallRuleGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-firewall-rule-group",
+	DescriptiveName: "Route 53 Resolver DNS Firewall Rule Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a DNS Firewall rule group by ID",
+		ListDescription:   "List all DNS Firewall rule groups",
+		SearchDescription: "Search for DNS Firewall rule groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_firewall_rule_group.id"},
+	},
+	PotentialLinks: []string{"route53-resolver-firewall-domain-list"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
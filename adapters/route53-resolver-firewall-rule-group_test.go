@@ -0,0 +1,76 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestFirewallRuleGroupItemMapper(t *testing.T) {
+	details := &Route53ResolverFirewallRuleGroupDetails{
+		RuleGroup: &types.FirewallRuleGroup{
+			Id:        adapterhelpers.PtrString("rslvr-frg-0a1b2c3d4e5f6a7b"),
+			Arn:       adapterhelpers.PtrString("arn:aws:route53resolver:eu-west-2:123456789012:firewall-rule-group/rslvr-frg-0a1b2c3d4e5f6a7b"),
+			Name:      adapterhelpers.PtrString("block-malware"),
+			RuleCount: adapterhelpers.PtrInt32(2),
+			Status:    types.FirewallRuleGroupStatusComplete,
+		},
+		Rules: []types.FirewallRule{
+			{
+				Name:                 adapterhelpers.PtrString("block-known-bad"),
+				Action:               types.ActionBlock,
+				BlockResponse:        types.BlockResponseNxdomain,
+				FirewallDomainListId: adapterhelpers.PtrString("rslvr-fdl-0a1b2c3d4e5f6a7b"),
+				Priority:             adapterhelpers.PtrInt32(100),
+			},
+			{
+				Name:                 adapterhelpers.PtrString("alert-known-bad"),
+				Action:               types.ActionAlert,
+				FirewallDomainListId: adapterhelpers.PtrString("rslvr-fdl-0a1b2c3d4e5f6a7b"),
+				Priority:             adapterhelpers.PtrInt32(200),
+			},
+		},
+	}
+
+	item, err := firewallRuleGroupItemMapper(nil, "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53-resolver-firewall-domain-list",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-fdl-0a1b2c3d4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v links, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestNewRoute53ResolverFirewallRuleGroupAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverFirewallRuleGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
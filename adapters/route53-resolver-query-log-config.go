@@ -0,0 +1,214 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// Route53ResolverQueryLogConfigDetails A query logging config along with the
+// VPCs that it is associated with, since these are returned by a separate API
+// call
+type Route53ResolverQueryLogConfigDetails struct {
+	QueryLogConfig *types.ResolverQueryLogConfig
+	Associations   []types.ResolverQueryLogConfigAssociation
+}
+
+func queryLogConfigGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*Route53ResolverQueryLogConfigDetails, error) {
+	out, err := client.GetResolverQueryLogConfig(ctx, &route53resolver.GetResolverQueryLogConfigInput{
+		ResolverQueryLogConfigId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ResolverQueryLogConfig == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query log config was nil",
+		}
+	}
+
+	return enrichQueryLogConfig(ctx, client, out.ResolverQueryLogConfig)
+}
+
+// enrichQueryLogConfig Fetches the VPCs that a query logging config is
+// associated with
+func enrichQueryLogConfig(ctx context.Context, client Route53ResolverClient, config *types.ResolverQueryLogConfig) (*Route53ResolverQueryLogConfigDetails, error) {
+	details := Route53ResolverQueryLogConfigDetails{
+		QueryLogConfig: config,
+	}
+
+	if config.Id == nil || config.AssociationCount == 0 {
+		return &details, nil
+	}
+
+	paginator := route53resolver.NewListResolverQueryLogConfigAssociationsPaginator(client, &route53resolver.ListResolverQueryLogConfigAssociationsInput{
+		Filters: route53ResolverFilter("ResolverQueryLogConfigId", *config.Id),
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Associations = append(details.Associations, out.ResolverQueryLogConfigAssociations...)
+	}
+
+	return &details, nil
+}
+
+func queryLogConfigItemMapper(_ *string, scope string, awsItem *Route53ResolverQueryLogConfigDetails) (*sdp.Item, error) {
+	config := awsItem.QueryLogConfig
+
+	enrichedConfig := struct {
+		*types.ResolverQueryLogConfig
+		Associations []types.ResolverQueryLogConfigAssociation
+	}{
+		ResolverQueryLogConfig: config,
+		Associations:           awsItem.Associations,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(enrichedConfig)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-query-log-config",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch config.Status {
+	case types.ResolverQueryLogConfigStatusCreated:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ResolverQueryLogConfigStatusCreating, types.ResolverQueryLogConfigStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ResolverQueryLogConfigStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	for _, association := range awsItem.Associations {
+		if association.Status == types.ResolverQueryLogConfigAssociationStatusActionNeeded ||
+			association.Status == types.ResolverQueryLogConfigAssociationStatusFailed {
+			// Queries from at least one VPC aren't being logged
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if association.ResourceId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, route53ResolverVPCLink(*association.ResourceId, scope))
+		}
+	}
+
+	if config.DestinationArn != nil {
+		if a, err := adapterhelpers.ParseARN(*config.DestinationArn); err == nil {
+			query := &sdp.Query{
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *config.DestinationArn,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			}
+
+			switch a.Service {
+			case "s3":
+				// S3 ARNs don't include the account, so we assume that the
+				// bucket is in the same account as the config. The ARN can
+				// also include a prefix after the bucket name
+				accountID, _, _ := adapterhelpers.ParseScope(scope)
+				bucket, _, _ := strings.Cut(a.Resource, "/")
+
+				query.Type = "s3-bucket"
+				query.Method = sdp.QueryMethod_GET
+				query.Query = bucket
+				query.Scope = adapterhelpers.FormatScope(accountID, "")
+			case "logs":
+				query.Type = "logs-log-group"
+			case "firehose":
+				query.Type = "firehose-delivery-stream"
+			}
+
+			if query.Type != "" {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: query,
+					BlastPropagation: &sdp.BlastPropagation{
+						// Changes to the destination can stop logs being
+						// delivered
+						In: true,
+						// Queries are written to the destination
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverQueryLogConfigAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverQueryLogConfigsInput, *route53resolver.ListResolverQueryLogConfigsOutput, *Route53ResolverQueryLogConfigDetails, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverQueryLogConfigsInput, *route53resolver.ListResolverQueryLogConfigsOutput, *Route53ResolverQueryLogConfigDetails, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-query-log-config",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: queryLogConfigAdapterMetadata,
+		GetFunc:         queryLogConfigGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListResolverQueryLogConfigsInput, error) {
+			return &route53resolver.ListResolverQueryLogConfigsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListResolverQueryLogConfigsInput) adapterhelpers.Paginator[*route53resolver.ListResolverQueryLogConfigsOutput, *route53resolver.Options] {
+			return route53resolver.NewListResolverQueryLogConfigsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListResolverQueryLogConfigsOutput, client Route53ResolverClient) ([]*Route53ResolverQueryLogConfigDetails, error) {
+			configs := make([]*Route53ResolverQueryLogConfigDetails, 0, len(output.ResolverQueryLogConfigs))
+
+			for i := range output.ResolverQueryLogConfigs {
+				details, err := enrichQueryLogConfig(ctx, client, &output.ResolverQueryLogConfigs[i])
+
+				if err != nil {
+					return nil, err
+				}
+
+				configs = append(configs, details)
+			}
+
+			return configs, nil
+		},
+		ItemMapper: queryLogConfigItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *Route53ResolverQueryLogConfigDetails, client Route53ResolverClient) (map[string]string, error) {
+			if details.QueryLogConfig.Arn == nil {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *details.QueryLogConfig.Arn)
+		},
+	}
+}
+
+var queryLogConfigAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-query-log-config",
+	DescriptiveName: "Route 53 Resolver Query Logging Config",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Route 53 Resolver query logging config by ID",
+		ListDescription:   "List all Route 53 Resolver query logging configs",
+		SearchDescription: "Search for Route 53 Resolver query logging configs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_query_log_config.id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "s3-bucket", "logs-log-group", "firehose-delivery-stream"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
@@ -0,0 +1,89 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestQueryLogConfigItemMapper(t *testing.T) {
+	details := &Route53ResolverQueryLogConfigDetails{
+		QueryLogConfig: &types.ResolverQueryLogConfig{
+			Id:               adapterhelpers.PtrString("rslvr-rqlc-0a1b2c3d4e5f6a7b"),
+			Arn:              adapterhelpers.PtrString("arn:aws:route53resolver:eu-west-2:123456789012:resolver-query-log-config/rslvr-rqlc-0a1b2c3d4e5f6a7b"),
+			Name:             adapterhelpers.PtrString("dns-logs"),
+			DestinationArn:   adapterhelpers.PtrString("arn:aws:s3:::dns-query-logs/resolver"),
+			AssociationCount: 2,
+			Status:           types.ResolverQueryLogConfigStatusCreated,
+		},
+		Associations: []types.ResolverQueryLogConfigAssociation{
+			{
+				Id:                       adapterhelpers.PtrString("rslvr-qlcassoc-0a1b2c3d"),
+				ResolverQueryLogConfigId: adapterhelpers.PtrString("rslvr-rqlc-0a1b2c3d4e5f6a7b"),
+				ResourceId:               adapterhelpers.PtrString("vpc-0a1b2c3d"),
+				Status:                   types.ResolverQueryLogConfigAssociationStatusActive,
+			},
+			{
+				Id:                       adapterhelpers.PtrString("rslvr-qlcassoc-4e5f6a7b"),
+				ResolverQueryLogConfigId: adapterhelpers.PtrString("rslvr-rqlc-0a1b2c3d4e5f6a7b"),
+				ResourceId:               adapterhelpers.PtrString("vpc-4e5f6a7b"),
+				Status:                   types.ResolverQueryLogConfigAssociationStatusActionNeeded,
+				Error:                    types.ResolverQueryLogConfigAssociationErrorAccessDenied,
+			},
+		},
+	}
+
+	item, err := queryLogConfigItemMapper(nil, "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-4e5f6a7b",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "dns-query-logs",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverQueryLogConfigAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverQueryLogConfigAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
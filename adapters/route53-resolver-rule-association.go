@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func resolverRuleAssociationGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*types.ResolverRuleAssociation, error) {
+	out, err := client.GetResolverRuleAssociation(ctx, &route53resolver.GetResolverRuleAssociationInput{
+		ResolverRuleAssociationId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ResolverRuleAssociation == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "resolver rule association was nil",
+		}
+	}
+
+	return out.ResolverRuleAssociation, nil
+}
+
+func resolverRuleAssociationItemMapper(_ *string, scope string, awsItem *types.ResolverRuleAssociation) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-rule-association",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.ResolverRuleAssociationStatusComplete:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ResolverRuleAssociationStatusCreating, types.ResolverRuleAssociationStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ResolverRuleAssociationStatusOverridden:
+		// Another rule for the same domain is taking precedence in this VPC
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.ResolverRuleAssociationStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.ResolverRuleId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53-resolver-rule",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverRuleId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the rule change resolution in the VPC
+				In: true,
+				// The association can't affect the rule
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.VPCId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, route53ResolverVPCLink(*awsItem.VPCId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverRuleAssociationAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverRuleAssociationsInput, *route53resolver.ListResolverRuleAssociationsOutput, *types.ResolverRuleAssociation, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverRuleAssociationsInput, *route53resolver.ListResolverRuleAssociationsOutput, *types.ResolverRuleAssociation, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-rule-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: resolverRuleAssociationAdapterMetadata,
+		GetFunc:         resolverRuleAssociationGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListResolverRuleAssociationsInput, error) {
+			return &route53resolver.ListResolverRuleAssociationsInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListResolverRuleAssociationsInput) adapterhelpers.Paginator[*route53resolver.ListResolverRuleAssociationsOutput, *route53resolver.Options] {
+			return route53resolver.NewListResolverRuleAssociationsPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListResolverRuleAssociationsOutput, client Route53ResolverClient) ([]*types.ResolverRuleAssociation, error) {
+			associations := make([]*types.ResolverRuleAssociation, 0, len(output.ResolverRuleAssociations))
+
+			for i := range output.ResolverRuleAssociations {
+				associations = append(associations, &output.ResolverRuleAssociations[i])
+			}
+
+			return associations, nil
+		},
+		ItemMapper: resolverRuleAssociationItemMapper,
+	}
+}
+
+var resolverRuleAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-rule-association",
+	DescriptiveName: "Route 53 Resolver Rule Association",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:             true,
+		List:            true,
+		GetDescription:  "Get a Route 53 Resolver rule association by ID",
+		ListDescription: "List all Route 53 Resolver rule associations",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_rule_association.id"},
+	},
+	PotentialLinks: []string{"route53-resolver-rule", "ec2-vpc"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,65 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestResolverRuleAssociationItemMapper(t *testing.T) {
+	association := &types.ResolverRuleAssociation{
+		Id:             adapterhelpers.PtrString("rslvr-rrassoc-0a1b2c3d4e5f6a7b8"),
+		Name:           adapterhelpers.PtrString("corp-main-vpc"),
+		ResolverRuleId: adapterhelpers.PtrString("rslvr-rr-0a1b2c3d4e5f6a7b8"),
+		VPCId:          adapterhelpers.PtrString("vpc-0a1b2c3d"),
+		Status:         types.ResolverRuleAssociationStatusOverridden,
+	}
+
+	item, err := resolverRuleAssociationItemMapper(nil, "123456789012.eu-west-2", association)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53-resolver-rule",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-rr-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverRuleAssociationAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverRuleAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
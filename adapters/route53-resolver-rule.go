@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func resolverRuleGetFunc(ctx context.Context, client Route53ResolverClient, _, query string) (*types.ResolverRule, error) {
+	out, err := client.GetResolverRule(ctx, &route53resolver.GetResolverRuleInput{
+		ResolverRuleId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.ResolverRule == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "resolver rule was nil",
+		}
+	}
+
+	return out.ResolverRule, nil
+}
+
+func resolverRuleItemMapper(_ *string, scope string, awsItem *types.ResolverRule) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "route53-resolver-rule",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.ResolverRuleStatusComplete:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.ResolverRuleStatusUpdating, types.ResolverRuleStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.ResolverRuleStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.ResolverEndpointId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "route53-resolver-endpoint",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ResolverEndpointId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Queries are forwarded out through the endpoint
+				In: true,
+				// The rule can't affect the endpoint
+				Out: false,
+			},
+		})
+	}
+
+	// The target IPs are the DNS servers (usually on-prem) that queries are
+	// forwarded to
+	for _, target := range awsItem.TargetIps {
+		for _, address := range []*string{target.Ip, target.Ipv6} {
+			if address != nil {
+				if link := route53ResolverIPLink(*address); link != nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+				}
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewRoute53ResolverRuleAdapter(client Route53ResolverClient, accountID string, region string) *adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverRulesInput, *route53resolver.ListResolverRulesOutput, *types.ResolverRule, Route53ResolverClient, *route53resolver.Options] {
+	return &adapterhelpers.GetListAdapterV2[*route53resolver.ListResolverRulesInput, *route53resolver.ListResolverRulesOutput, *types.ResolverRule, Route53ResolverClient, *route53resolver.Options]{
+		ItemType:        "route53-resolver-rule",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: resolverRuleAdapterMetadata,
+		GetFunc:         resolverRuleGetFunc,
+		InputMapperList: func(scope string) (*route53resolver.ListResolverRulesInput, error) {
+			return &route53resolver.ListResolverRulesInput{}, nil
+		},
+		ListFuncPaginatorBuilder: func(client Route53ResolverClient, input *route53resolver.ListResolverRulesInput) adapterhelpers.Paginator[*route53resolver.ListResolverRulesOutput, *route53resolver.Options] {
+			return route53resolver.NewListResolverRulesPaginator(client, input)
+		},
+		ListExtractor: func(ctx context.Context, output *route53resolver.ListResolverRulesOutput, client Route53ResolverClient) ([]*types.ResolverRule, error) {
+			rules := make([]*types.ResolverRule, 0, len(output.ResolverRules))
+
+			for i := range output.ResolverRules {
+				rules = append(rules, &output.ResolverRules[i])
+			}
+
+			return rules, nil
+		},
+		ItemMapper: resolverRuleItemMapper,
+		ListTagsFunc: func(ctx context.Context, rule *types.ResolverRule, client Route53ResolverClient) (map[string]string, error) {
+			// The built-in system rule can't be tagged
+			if rule.Arn == nil || rule.RuleType == types.RuleTypeOptionRecursive {
+				return nil, nil
+			}
+
+			return route53ResolverListTags(ctx, client, *rule.Arn)
+		},
+	}
+}
+
+var resolverRuleAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "route53-resolver-rule",
+	DescriptiveName: "Route 53 Resolver Rule",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Route 53 Resolver rule by ID",
+		ListDescription:   "List all Route 53 Resolver rules",
+		SearchDescription: "Search for Route 53 Resolver rules by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_route53_resolver_rule.id"},
+	},
+	PotentialLinks: []string{"route53-resolver-endpoint", "ip"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
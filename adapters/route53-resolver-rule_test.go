@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestResolverRuleItemMapper(t *testing.T) {
+	rule := &types.ResolverRule{
+		Id:                 adapterhelpers.PtrString("rslvr-rr-0a1b2c3d4e5f6a7b8"),
+		Arn:                adapterhelpers.PtrString("arn:aws:route53resolver:eu-west-2:123456789012:resolver-rule/rslvr-rr-0a1b2c3d4e5f6a7b8"),
+		Name:               adapterhelpers.PtrString("corp"),
+		DomainName:         adapterhelpers.PtrString("corp.example.com."),
+		OwnerId:            adapterhelpers.PtrString("123456789012"),
+		ResolverEndpointId: adapterhelpers.PtrString("rslvr-out-0a1b2c3d4e5f6a7b8"),
+		RuleType:           types.RuleTypeOptionForward,
+		ShareStatus:        types.ShareStatusNotShared,
+		Status:             types.ResolverRuleStatusComplete,
+		TargetIps: []types.TargetAddress{
+			{
+				Ip:   adapterhelpers.PtrString("192.168.10.53"),
+				Port: adapterhelpers.PtrInt32(53),
+			},
+			{
+				Ip:   adapterhelpers.PtrString("192.168.20.53"),
+				Port: adapterhelpers.PtrInt32(53),
+			},
+		},
+	}
+
+	item, err := resolverRuleItemMapper(nil, "123456789012.eu-west-2", rule)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "route53-resolver-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "rslvr-out-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.168.10.53",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "192.168.20.53",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewRoute53ResolverRuleAdapter(t *testing.T) {
+	client, account, region := route53ResolverGetAutoConfig(t)
+
+	adapter := NewRoute53ResolverRuleAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
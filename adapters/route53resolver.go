@@ -0,0 +1,107 @@
+package adapters
+
+import (
+	"context"
+	"net"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver/types"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+type Route53ResolverClient interface {
+	GetFirewallRuleGroup(ctx context.Context, params *route53resolver.GetFirewallRuleGroupInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupOutput, error)
+	GetFirewallRuleGroupAssociation(ctx context.Context, params *route53resolver.GetFirewallRuleGroupAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetFirewallRuleGroupAssociationOutput, error)
+	GetResolverEndpoint(ctx context.Context, params *route53resolver.GetResolverEndpointInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverEndpointOutput, error)
+	GetResolverQueryLogConfig(ctx context.Context, params *route53resolver.GetResolverQueryLogConfigInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverQueryLogConfigOutput, error)
+	GetResolverRule(ctx context.Context, params *route53resolver.GetResolverRuleInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleOutput, error)
+	GetResolverRuleAssociation(ctx context.Context, params *route53resolver.GetResolverRuleAssociationInput, optFns ...func(*route53resolver.Options)) (*route53resolver.GetResolverRuleAssociationOutput, error)
+
+	route53resolver.ListFirewallRuleGroupAssociationsAPIClient
+	route53resolver.ListFirewallRuleGroupsAPIClient
+	route53resolver.ListFirewallRulesAPIClient
+	route53resolver.ListResolverEndpointIpAddressesAPIClient
+	route53resolver.ListResolverEndpointsAPIClient
+	route53resolver.ListResolverQueryLogConfigAssociationsAPIClient
+	route53resolver.ListResolverQueryLogConfigsAPIClient
+	route53resolver.ListResolverRuleAssociationsAPIClient
+	route53resolver.ListResolverRulesAPIClient
+	route53resolver.ListTagsForResourceAPIClient
+}
+
+// route53ResolverListTags Gets the tags for a given Route 53 Resolver resource
+// ARN
+func route53ResolverListTags(ctx context.Context, client Route53ResolverClient, arn string) (map[string]string, error) {
+	tags := make(map[string]string)
+
+	paginator := route53resolver.NewListTagsForResourcePaginator(client, &route53resolver.ListTagsForResourceInput{
+		ResourceArn: &arn,
+	})
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// route53ResolverIPLink Links to an IP address used by an endpoint or as the
+// target of a forwarding rule
+func route53ResolverIPLink(ip string) *sdp.LinkedItemQuery {
+	if net.ParseIP(ip) == nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ip",
+			Method: sdp.QueryMethod_GET,
+			Query:  ip,
+			Scope:  "global",
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// IPs are always linked
+			In:  true,
+			Out: true,
+		},
+	}
+}
+
+// route53ResolverVPCLink Links to a VPC that a rule, firewall rule group or
+// query logging config is associated with. The association changes how DNS
+// is resolved in the VPC, but the VPC can't affect the association
+func route53ResolverVPCLink(vpcID string, scope string) *sdp.LinkedItemQuery {
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ec2-vpc",
+			Method: sdp.QueryMethod_GET,
+			Query:  vpcID,
+			Scope:  scope,
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			In:  false,
+			Out: true,
+		},
+	}
+}
+
+// route53ResolverFilter Builds a filter for the Route 53 Resolver list APIs
+func route53ResolverFilter(name string, value string) []types.Filter {
+	return []types.Filter{
+		{
+			Name:   &name,
+			Values: []string{value},
+		},
+	}
+}
@@ -0,0 +1,34 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func route53ResolverGetAutoConfig(t *testing.T) (*route53resolver.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := route53resolver.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestRoute53ResolverIPLink(t *testing.T) {
+	for _, ip := range []string{"10.0.1.53", "2001:db8::53"} {
+		link := route53ResolverIPLink(ip)
+
+		if link == nil {
+			t.Errorf("expected a link for %v", ip)
+			continue
+		}
+
+		if link.GetQuery().GetType() != "ip" || link.GetQuery().GetQuery() != ip {
+			t.Errorf("expected ip link to %v, got %v", ip, link.GetQuery())
+		}
+	}
+
+	if link := route53ResolverIPLink("not-an-ip"); link != nil {
+		t.Errorf("expected no link, got %v", link)
+	}
+}
@@ -0,0 +1,217 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// S3AccessPointDetails An access point along with its parsed policy
+type S3AccessPointDetails struct {
+	AccessPoint *s3control.GetAccessPointOutput
+	Policy      *policy.Policy
+}
+
+func s3AccessPointGetFunc(ctx context.Context, client S3ControlClient, scope, query string) (*S3AccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	out, err := client.GetAccessPoint(ctx, &s3control.GetAccessPointInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := S3AccessPointDetails{
+		AccessPoint: out,
+	}
+
+	// Access points don't have to have a policy, in which case this returns
+	// an error that we can ignore
+	policyOut, err := client.GetAccessPointPolicy(ctx, &s3control.GetAccessPointPolicyInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err == nil && policyOut.Policy != nil {
+		// If the document can't be parsed we still want to return the access
+		// point
+		details.Policy, _ = ParsePolicyDocument(*policyOut.Policy)
+	}
+
+	return &details, nil
+}
+
+func s3AccessPointListFunc(ctx context.Context, client S3ControlClient, scope string) ([]*S3AccessPointDetails, error) {
+	return s3AccessPointListByBucket(ctx, client, scope, nil)
+}
+
+// s3AccessPointListByBucket Lists the access points in a region, optionally
+// only those for a given bucket. The summaries don't include the policy or
+// the public access block config so we need to get each access point
+func s3AccessPointListByBucket(ctx context.Context, client S3ControlClient, scope string, bucket *string) ([]*S3AccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	paginator := s3control.NewListAccessPointsPaginator(client, &s3control.ListAccessPointsInput{
+		AccountId: &accountID,
+		Bucket:    bucket,
+	})
+
+	accessPoints := make([]*S3AccessPointDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.AccessPointList {
+			if summary.Name == nil {
+				continue
+			}
+
+			details, err := s3AccessPointGetFunc(ctx, client, scope, *summary.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			accessPoints = append(accessPoints, details)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+// s3AccessPointSearchFunc Searches for access points either by ARN, or by the
+// name of the bucket that they provide access to
+func s3AccessPointSearchFunc(ctx context.Context, client S3ControlClient, scope, query string) ([]*S3AccessPointDetails, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		details, err := s3AccessPointGetFunc(ctx, client, scope, a.ResourceID())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*S3AccessPointDetails{details}, nil
+	}
+
+	return s3AccessPointListByBucket(ctx, client, scope, &query)
+}
+
+func s3AccessPointItemMapper(_, scope string, awsItem *S3AccessPointDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*s3control.GetAccessPointOutput
+		Policy *policy.Policy
+	}{
+		GetAccessPointOutput: awsItem.AccessPoint,
+		Policy:               awsItem.Policy,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "s3-access-point",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	accessPoint := awsItem.AccessPoint
+
+	if accessPoint.Bucket != nil {
+		// The bucket can be in a different account to the access point
+		bucketAccountID := s3ControlAccountID(scope)
+		if accessPoint.BucketAccountId != nil {
+			bucketAccountID = *accessPoint.BucketAccountId
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *accessPoint.Bucket,
+				Scope:  adapterhelpers.FormatScope(bucketAccountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting the bucket breaks the access point
+				In: true,
+				// The access point controls who can access the bucket's data
+				Out: true,
+			},
+		})
+	}
+
+	if accessPoint.VpcConfiguration != nil && accessPoint.VpcConfiguration.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *accessPoint.VpcConfiguration.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The access point can only be used from within the VPC
+				In: true,
+				// The access point can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.Policy != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.Policy)...)
+	}
+
+	return &item, nil
+}
+
+func NewS3AccessPointAdapter(client S3ControlClient, accountID string, region string) *adapterhelpers.GetListAdapter[*S3AccessPointDetails, S3ControlClient, *s3control.Options] {
+	return &adapterhelpers.GetListAdapter[*S3AccessPointDetails, S3ControlClient, *s3control.Options]{
+		ItemType:        "s3-access-point",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: s3AccessPointAdapterMetadata,
+		GetFunc:         s3AccessPointGetFunc,
+		ListFunc:        s3AccessPointListFunc,
+		SearchFunc:      s3AccessPointSearchFunc,
+		ItemMapper:      s3AccessPointItemMapper,
+	}
+}
+
+var s3AccessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "s3-access-point",
+	DescriptiveName: "S3 Access Point",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an access point by name",
+		ListDescription:   "List all access points",
+		SearchDescription: "Search for access points by ARN, or by the name of the bucket that they provide access to",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_s3_access_point.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+		{
+			TerraformQueryMap: "aws_s3control_access_point_policy.access_point_arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"s3-bucket", "ec2-vpc", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
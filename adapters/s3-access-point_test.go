@@ -0,0 +1,94 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestS3AccessPointItemMapper(t *testing.T) {
+	document, err := ParsePolicyDocument(`{
+    "Version": "2012-10-17",
+    "Statement": [
+        {
+            "Effect": "Allow",
+            "Principal": {
+                "AWS": "arn:aws:iam::123456789012:role/analytics"
+            },
+            "Action": "s3:GetObject",
+            "Resource": "arn:aws:s3:eu-west-2:123456789012:accesspoint/analytics/object/*"
+        }
+    ]
+}`)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	details := &S3AccessPointDetails{
+		AccessPoint: &s3control.GetAccessPointOutput{
+			AccessPointArn:  adapterhelpers.PtrString("arn:aws:s3:eu-west-2:123456789012:accesspoint/analytics"),
+			Alias:           adapterhelpers.PtrString("analytics-hrzrlukc5m36ft7okagglf3gmwluquse1b-s3alias"),
+			Bucket:          adapterhelpers.PtrString("shared-data"),
+			BucketAccountId: adapterhelpers.PtrString("210987654321"),
+			CreationDate:    adapterhelpers.PtrTime(time.Now()),
+			Name:            adapterhelpers.PtrString("analytics"),
+			NetworkOrigin:   types.NetworkOriginVpc,
+			VpcConfiguration: &types.VpcConfiguration{
+				VpcId: adapterhelpers.PtrString("vpc-0a1b2c3d"),
+			},
+		},
+		Policy: document,
+	}
+
+	item, err := s3AccessPointItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "shared-data",
+			ExpectedScope:  "210987654321",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/analytics",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewS3AccessPointAdapter(t *testing.T) {
+	client, account, region := s3ControlGetAutoConfig(t)
+
+	adapter := NewS3AccessPointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
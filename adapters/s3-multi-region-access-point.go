@@ -0,0 +1,225 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// S3MultiRegionAccessPointDetails A Multi-Region Access Point along with its
+// parsed policy
+type S3MultiRegionAccessPointDetails struct {
+	AccessPoint *types.MultiRegionAccessPointReport
+	Policy      *policy.Policy
+}
+
+func s3MultiRegionAccessPointGetFunc(ctx context.Context, client S3ControlClient, scope, query string) (*S3MultiRegionAccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	out, err := client.GetMultiRegionAccessPoint(ctx, &s3control.GetMultiRegionAccessPointInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.AccessPoint == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "multi-region access point was nil",
+		}
+	}
+
+	return s3MultiRegionAccessPointWithPolicy(ctx, client, accountID, out.AccessPoint), nil
+}
+
+// s3MultiRegionAccessPointWithPolicy Adds the policy to an access point
+// report. The list response includes the full report so there is no need to
+// get each access point, but the policy still has to be fetched separately
+func s3MultiRegionAccessPointWithPolicy(ctx context.Context, client S3ControlClient, accountID string, accessPoint *types.MultiRegionAccessPointReport) *S3MultiRegionAccessPointDetails {
+	details := S3MultiRegionAccessPointDetails{
+		AccessPoint: accessPoint,
+	}
+
+	if accessPoint.Name == nil {
+		return &details
+	}
+
+	// The policy is optional, so errors here are ignored
+	policyOut, err := client.GetMultiRegionAccessPointPolicy(ctx, &s3control.GetMultiRegionAccessPointPolicyInput{
+		AccountId: &accountID,
+		Name:      accessPoint.Name,
+	})
+
+	// Changes to the policy are proposed and then established once they have
+	// propagated to all regions, we use the established one since that's
+	// what is actually in effect
+	if err == nil && policyOut.Policy != nil && policyOut.Policy.Established != nil && policyOut.Policy.Established.Policy != nil {
+		details.Policy, _ = ParsePolicyDocument(*policyOut.Policy.Established.Policy)
+	}
+
+	return &details
+}
+
+func s3MultiRegionAccessPointListFunc(ctx context.Context, client S3ControlClient, scope string) ([]*S3MultiRegionAccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	paginator := s3control.NewListMultiRegionAccessPointsPaginator(client, &s3control.ListMultiRegionAccessPointsInput{
+		AccountId: &accountID,
+	})
+
+	accessPoints := make([]*S3MultiRegionAccessPointDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for i := range out.AccessPoints {
+			accessPoints = append(accessPoints, s3MultiRegionAccessPointWithPolicy(ctx, client, accountID, &out.AccessPoints[i]))
+		}
+	}
+
+	return accessPoints, nil
+}
+
+// s3MultiRegionAccessPointSearchFunc Searches for a Multi-Region Access Point
+// by ARN. The ARN contains the alias rather than the name e.g.
+// arn:aws:s3::123456789012:accesspoint/mfzwi23gnjvgw.mrap so we have to list
+// them all and find the one with the matching alias
+func s3MultiRegionAccessPointSearchFunc(ctx context.Context, client S3ControlClient, scope, query string) ([]*S3MultiRegionAccessPointDetails, error) {
+	a, err := adapterhelpers.ParseARN(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be a multi-region access point ARN",
+		}
+	}
+
+	accessPoints, err := s3MultiRegionAccessPointListFunc(ctx, client, scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]*S3MultiRegionAccessPointDetails, 0)
+
+	for _, accessPoint := range accessPoints {
+		if accessPoint.AccessPoint.Alias != nil && *accessPoint.AccessPoint.Alias+".mrap" == a.ResourceID() {
+			matches = append(matches, accessPoint)
+		}
+	}
+
+	return matches, nil
+}
+
+func s3MultiRegionAccessPointItemMapper(_, scope string, awsItem *S3MultiRegionAccessPointDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*types.MultiRegionAccessPointReport
+		Policy *policy.Policy
+	}{
+		MultiRegionAccessPointReport: awsItem.AccessPoint,
+		Policy:                       awsItem.Policy,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "s3-multi-region-access-point",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.AccessPoint.Status {
+	case types.MultiRegionAccessPointStatusReady:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.MultiRegionAccessPointStatusCreating, types.MultiRegionAccessPointStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.MultiRegionAccessPointStatusInconsistentAcrossRegions, types.MultiRegionAccessPointStatusPartiallyCreated, types.MultiRegionAccessPointStatusPartiallyDeleted:
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	}
+
+	for _, region := range awsItem.AccessPoint.Regions {
+		if region.Bucket == nil {
+			continue
+		}
+
+		// Multi-Region Access Points aren't regional so the scope is just
+		// the account ID. The buckets can be in other accounts
+		bucketAccountID := scope
+		if region.BucketAccountId != nil {
+			bucketAccountID = *region.BucketAccountId
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *region.Bucket,
+				Scope:  adapterhelpers.FormatScope(bucketAccountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Deleting a bucket removes it from the access point's routing
+				In: true,
+				// The access point controls who can access the bucket's data
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.Policy != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.Policy)...)
+	}
+
+	return &item, nil
+}
+
+func NewS3MultiRegionAccessPointAdapter(client S3ControlClient, accountID string) *adapterhelpers.GetListAdapter[*S3MultiRegionAccessPointDetails, S3ControlClient, *s3control.Options] {
+	return &adapterhelpers.GetListAdapter[*S3MultiRegionAccessPointDetails, S3ControlClient, *s3control.Options]{
+		ItemType:        "s3-multi-region-access-point",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          "", // Multi-Region Access Points aren't tied to a region
+		AdapterMetadata: s3MultiRegionAccessPointAdapterMetadata,
+		GetFunc:         s3MultiRegionAccessPointGetFunc,
+		ListFunc:        s3MultiRegionAccessPointListFunc,
+		SearchFunc:      s3MultiRegionAccessPointSearchFunc,
+		ItemMapper:      s3MultiRegionAccessPointItemMapper,
+	}
+}
+
+var s3MultiRegionAccessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "s3-multi-region-access-point",
+	DescriptiveName: "S3 Multi-Region Access Point",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Multi-Region Access Point by name",
+		ListDescription:   "List all Multi-Region Access Points",
+		SearchDescription: "Search for Multi-Region Access Points by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformQueryMap: "aws_s3control_multi_region_access_point.arn",
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+		},
+	},
+	PotentialLinks: []string{"s3-bucket", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
@@ -0,0 +1,77 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestS3MultiRegionAccessPointItemMapper(t *testing.T) {
+	details := &S3MultiRegionAccessPointDetails{
+		AccessPoint: &types.MultiRegionAccessPointReport{
+			Alias:     adapterhelpers.PtrString("mfzwi23gnjvgw"),
+			CreatedAt: adapterhelpers.PtrTime(time.Now()),
+			Name:      adapterhelpers.PtrString("global-assets"),
+			Status:    types.MultiRegionAccessPointStatusInconsistentAcrossRegions,
+			Regions: []types.RegionReport{
+				{
+					Bucket: adapterhelpers.PtrString("assets-eu-west-2"),
+					Region: adapterhelpers.PtrString("eu-west-2"),
+				},
+				{
+					Bucket:          adapterhelpers.PtrString("assets-us-east-1"),
+					BucketAccountId: adapterhelpers.PtrString("210987654321"),
+					Region:          adapterhelpers.PtrString("us-east-1"),
+				},
+			},
+		},
+	}
+
+	item, err := s3MultiRegionAccessPointItemMapper("", "123456789012", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "assets-eu-west-2",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "assets-us-east-1",
+			ExpectedScope:  "210987654321",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewS3MultiRegionAccessPointAdapter(t *testing.T) {
+	client, account, _ := s3ControlGetAutoConfig(t)
+
+	adapter := NewS3MultiRegionAccessPointAdapter(client, account)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,213 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// S3ObjectLambdaAccessPointDetails An Object Lambda access point along with
+// its configuration and parsed policy
+type S3ObjectLambdaAccessPointDetails struct {
+	AccessPoint   *s3control.GetAccessPointForObjectLambdaOutput
+	Configuration *types.ObjectLambdaConfiguration
+	Policy        *policy.Policy
+}
+
+func s3ObjectLambdaAccessPointGetFunc(ctx context.Context, client S3ControlClient, scope, query string) (*S3ObjectLambdaAccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	out, err := client.GetAccessPointForObjectLambda(ctx, &s3control.GetAccessPointForObjectLambdaInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := S3ObjectLambdaAccessPointDetails{
+		AccessPoint: out,
+	}
+
+	// The configuration holds the supporting access point and the functions,
+	// which are the main things we want to link to
+	configOut, err := client.GetAccessPointConfigurationForObjectLambda(ctx, &s3control.GetAccessPointConfigurationForObjectLambdaInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details.Configuration = configOut.Configuration
+
+	// The policy is optional, so errors here are ignored
+	policyOut, err := client.GetAccessPointPolicyForObjectLambda(ctx, &s3control.GetAccessPointPolicyForObjectLambdaInput{
+		AccountId: &accountID,
+		Name:      &query,
+	})
+
+	if err == nil && policyOut.Policy != nil {
+		details.Policy, _ = ParsePolicyDocument(*policyOut.Policy)
+	}
+
+	return &details, nil
+}
+
+func s3ObjectLambdaAccessPointListFunc(ctx context.Context, client S3ControlClient, scope string) ([]*S3ObjectLambdaAccessPointDetails, error) {
+	accountID := s3ControlAccountID(scope)
+
+	paginator := s3control.NewListAccessPointsForObjectLambdaPaginator(client, &s3control.ListAccessPointsForObjectLambdaInput{
+		AccountId: &accountID,
+	})
+
+	accessPoints := make([]*S3ObjectLambdaAccessPointDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.ObjectLambdaAccessPointList {
+			if summary.Name == nil {
+				continue
+			}
+
+			details, err := s3ObjectLambdaAccessPointGetFunc(ctx, client, scope, *summary.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			accessPoints = append(accessPoints, details)
+		}
+	}
+
+	return accessPoints, nil
+}
+
+func s3ObjectLambdaAccessPointItemMapper(_, scope string, awsItem *S3ObjectLambdaAccessPointDetails) (*sdp.Item, error) {
+	finalAttributes := struct {
+		*s3control.GetAccessPointForObjectLambdaOutput
+		Configuration *types.ObjectLambdaConfiguration
+		Policy        *policy.Policy
+	}{
+		GetAccessPointForObjectLambdaOutput: awsItem.AccessPoint,
+		Configuration:                       awsItem.Configuration,
+		Policy:                              awsItem.Policy,
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(finalAttributes, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "s3-object-lambda-access-point",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if alias := awsItem.AccessPoint.Alias; alias != nil {
+		switch alias.Status {
+		case types.ObjectLambdaAccessPointAliasStatusReady:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.ObjectLambdaAccessPointAliasStatusProvisioning:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		}
+	}
+
+	if config := awsItem.Configuration; config != nil {
+		if config.SupportingAccessPoint != nil {
+			if a, err := adapterhelpers.ParseARN(*config.SupportingAccessPoint); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "s3-access-point",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *config.SupportingAccessPoint,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Objects are read through the supporting access point
+						In: true,
+						// The Object Lambda access point can't affect it
+						Out: false,
+					},
+				})
+			}
+		}
+
+		for _, transformation := range config.TransformationConfigurations {
+			awsLambda, ok := transformation.ContentTransformation.(*types.ObjectLambdaContentTransformationMemberAwsLambda)
+
+			if !ok || awsLambda.Value.FunctionArn == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*awsLambda.Value.FunctionArn); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *awsLambda.Value.FunctionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The function transforms every object that is read
+						In: true,
+						// The access point invokes the function
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.Policy != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.Policy)...)
+	}
+
+	return &item, nil
+}
+
+func NewS3ObjectLambdaAccessPointAdapter(client S3ControlClient, accountID string, region string) *adapterhelpers.GetListAdapter[*S3ObjectLambdaAccessPointDetails, S3ControlClient, *s3control.Options] {
+	return &adapterhelpers.GetListAdapter[*S3ObjectLambdaAccessPointDetails, S3ControlClient, *s3control.Options]{
+		ItemType:        "s3-object-lambda-access-point",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: s3ObjectLambdaAccessPointAdapterMetadata,
+		GetFunc:         s3ObjectLambdaAccessPointGetFunc,
+		ListFunc:        s3ObjectLambdaAccessPointListFunc,
+		ItemMapper:      s3ObjectLambdaAccessPointItemMapper,
+	}
+}
+
+var s3ObjectLambdaAccessPointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "s3-object-lambda-access-point",
+	DescriptiveName: "S3 Object Lambda Access Point",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an Object Lambda access point by name",
+		ListDescription:   "List all Object Lambda access points",
+		SearchDescription: "Search for Object Lambda access points by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_s3control_object_lambda_access_point.name"},
+	},
+	PotentialLinks: []string{"s3-access-point", "lambda-function", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
+})
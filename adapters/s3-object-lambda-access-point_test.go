@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/aws/aws-sdk-go-v2/service/s3control/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestS3ObjectLambdaAccessPointItemMapper(t *testing.T) {
+	details := &S3ObjectLambdaAccessPointDetails{
+		AccessPoint: &s3control.GetAccessPointForObjectLambdaOutput{
+			Alias: &types.ObjectLambdaAccessPointAlias{
+				Status: types.ObjectLambdaAccessPointAliasStatusReady,
+				Value:  adapterhelpers.PtrString("redacted-hrzrlukc5m36ft7okagglf3gmwluquse1b--ol-s3"),
+			},
+			CreationDate: adapterhelpers.PtrTime(time.Now()),
+			Name:         adapterhelpers.PtrString("redacted"),
+		},
+		Configuration: &types.ObjectLambdaConfiguration{
+			SupportingAccessPoint: adapterhelpers.PtrString("arn:aws:s3:eu-west-2:123456789012:accesspoint/analytics"),
+			TransformationConfigurations: []types.ObjectLambdaTransformationConfiguration{
+				{
+					Actions: []types.ObjectLambdaTransformationConfigurationAction{
+						types.ObjectLambdaTransformationConfigurationActionGetObject,
+					},
+					ContentTransformation: &types.ObjectLambdaContentTransformationMemberAwsLambda{
+						Value: types.AwsLambdaTransformation{
+							FunctionArn: adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:redact-pii"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	item, err := s3ObjectLambdaAccessPointItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-access-point",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:s3:eu-west-2:123456789012:accesspoint/analytics",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:redact-pii",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewS3ObjectLambdaAccessPointAdapter(t *testing.T) {
+	client, account, region := s3ControlGetAutoConfig(t)
+
+	adapter := NewS3ObjectLambdaAccessPointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
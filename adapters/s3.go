@@ -3,6 +3,7 @@ package adapters
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
@@ -62,7 +63,7 @@ var s3Metadata = Metadata.Register(&sdp.AdapterMetadata{
 		{TerraformQueryMap: "aws_s3_object_copy.bucket"},
 		{TerraformQueryMap: "aws_s3_object.bucket"},
 	},
-	PotentialLinks: []string{"lambda-function", "sqs-queue", "sns-topic", "s3-bucket"},
+	PotentialLinks: []string{"lambda-function", "sqs-queue", "sns-topic", "s3-bucket", "cloudtrail-trail"},
 	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_STORAGE,
 })
 
@@ -587,6 +588,27 @@ func getImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, scope
 		}
 	}
 
+	// CloudTrail can only deliver logs to buckets whose policy allows it to.
+	// The trail could be an organisation trail in another account, and in any
+	// region, so we need to search everywhere
+	if bucket.Policy != nil && strings.Contains(*bucket.Policy, "cloudtrail.amazonaws.com") {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cloudtrail-trail",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *bucketName,
+				Scope:  sdp.WILDCARD,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The trail writes to the bucket but doesn't change it
+				In: false,
+				// Changes to the bucket (e.g. its policy) can stop the trail
+				// from delivering logs
+				Out: true,
+			},
+		})
+	}
+
 	cache.StoreItem(&item, CacheDuration, ck)
 
 	return &item, nil
@@ -691,3 +713,43 @@ func searchImpl(ctx context.Context, cache *sdpcache.Cache, client S3Client, sco
 func (s *S3Source) Weight() int {
 	return 100
 }
+
+// s3URISchemes The schemes that refer to S3. s3n:// and s3a:// are used by
+// Hadoop, so services such as EMR and Glue accept them as well as s3://
+var s3URISchemes = []string{"s3://", "s3n://", "s3a://"}
+
+// s3BucketFromURI Extracts the bucket name from an S3 URI e.g.
+// s3://my-bucket/optional/prefix
+func s3BucketFromURI(uri string) (string, bool) {
+	for _, scheme := range s3URISchemes {
+		if bucket, ok := strings.CutPrefix(uri, scheme); ok {
+			bucket, _, _ = strings.Cut(bucket, "/")
+
+			return bucket, bucket != ""
+		}
+	}
+
+	return "", false
+}
+
+// s3BucketLink Links to the bucket of an S3 URI. Buckets are global so the
+// link is in the account scope. Returns nil if the URI isn't an S3 URI
+func s3BucketLink(uri string, scope string, propagation *sdp.BlastPropagation) *sdp.LinkedItemQuery {
+	bucket, ok := s3BucketFromURI(uri)
+
+	if !ok {
+		return nil
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-bucket",
+			Method: sdp.QueryMethod_GET,
+			Query:  bucket,
+			Scope:  adapterhelpers.FormatScope(accountID, ""),
+		},
+		BlastPropagation: propagation,
+	}
+}
@@ -125,6 +125,12 @@ func TestS3GetImpl(t *testing.T) {
 			ExpectedQuery:  "arn:partition:service:region:account-id:resource-type:resource-id",
 			ExpectedScope:  "account-id.region",
 		},
+		{
+			ExpectedType:   "cloudtrail-trail",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "bar",
+			ExpectedScope:  "*",
+		},
 	}
 
 	tests.Execute(t, item)
@@ -430,7 +436,7 @@ func (t TestS3Client) GetBucketOwnershipControls(ctx context.Context, params *s3
 
 func (t TestS3Client) GetBucketPolicy(ctx context.Context, params *s3.GetBucketPolicyInput, optFns ...func(*s3.Options)) (*s3.GetBucketPolicyOutput, error) {
 	return &s3.GetBucketPolicyOutput{
-		Policy: adapterhelpers.PtrString("policy"),
+		Policy: adapterhelpers.PtrString(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"Service":"cloudtrail.amazonaws.com"},"Action":"s3:PutObject","Resource":"arn:aws:s3:::bar/AWSLogs/*"}]}`),
 	}, nil
 }
 
@@ -675,3 +681,50 @@ func TestNewS3Adapter(t *testing.T) {
 
 	test.Run(t)
 }
+
+func TestS3BucketFromURI(t *testing.T) {
+	tests := []struct {
+		Path   string
+		Bucket string
+		OK     bool
+	}{
+		{Path: "s3://lustre-data", Bucket: "lustre-data", OK: true},
+		{Path: "s3://lustre-data/import/prefix", Bucket: "lustre-data", OK: true},
+		{Path: "s3n://aws-logs/elasticmapreduce/", Bucket: "aws-logs", OK: true},
+		{Path: "s3a://datalake/raw", Bucket: "datalake", OK: true},
+		{Path: "s3://", OK: false},
+		{Path: "/fsx/export", OK: false},
+	}
+
+	for _, test := range tests {
+		bucket, ok := s3BucketFromURI(test.Path)
+
+		if ok != test.OK || bucket != test.Bucket {
+			t.Errorf("expected %v (%v) from %v, got %v (%v)", test.Bucket, test.OK, test.Path, bucket, ok)
+		}
+	}
+}
+
+func TestS3BucketLink(t *testing.T) {
+	propagation := &sdp.BlastPropagation{In: true}
+
+	link := s3BucketLink("s3://ml-artifacts/fraud/model.tar.gz", "123456789012.eu-west-2", propagation)
+
+	if link == nil {
+		t.Fatal("expected a link")
+	}
+
+	if link.GetQuery().GetQuery() != "ml-artifacts" || link.GetQuery().GetScope() != "123456789012" {
+		t.Errorf("expected bucket ml-artifacts in scope 123456789012, got %v in %v", link.GetQuery().GetQuery(), link.GetQuery().GetScope())
+	}
+
+	if link.GetBlastPropagation() != propagation {
+		t.Errorf("expected the given propagation, got %v", link.GetBlastPropagation())
+	}
+
+	for _, uri := range []string{"hdfs:///logs", "https://example.com/model.tar.gz"} {
+		if link := s3BucketLink(uri, "123456789012.eu-west-2", propagation); link != nil {
+			t.Errorf("expected no link for %v, got %v", uri, link.GetQuery())
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type S3ControlClient interface {
+	GetAccessPoint(ctx context.Context, params *s3control.GetAccessPointInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointOutput, error)
+	GetAccessPointPolicy(ctx context.Context, params *s3control.GetAccessPointPolicyInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointPolicyOutput, error)
+	GetAccessPointForObjectLambda(ctx context.Context, params *s3control.GetAccessPointForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointForObjectLambdaOutput, error)
+	GetAccessPointConfigurationForObjectLambda(ctx context.Context, params *s3control.GetAccessPointConfigurationForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointConfigurationForObjectLambdaOutput, error)
+	GetAccessPointPolicyForObjectLambda(ctx context.Context, params *s3control.GetAccessPointPolicyForObjectLambdaInput, optFns ...func(*s3control.Options)) (*s3control.GetAccessPointPolicyForObjectLambdaOutput, error)
+	GetMultiRegionAccessPoint(ctx context.Context, params *s3control.GetMultiRegionAccessPointInput, optFns ...func(*s3control.Options)) (*s3control.GetMultiRegionAccessPointOutput, error)
+	GetMultiRegionAccessPointPolicy(ctx context.Context, params *s3control.GetMultiRegionAccessPointPolicyInput, optFns ...func(*s3control.Options)) (*s3control.GetMultiRegionAccessPointPolicyOutput, error)
+
+	s3control.ListAccessPointsAPIClient
+	s3control.ListAccessPointsForObjectLambdaAPIClient
+	s3control.ListMultiRegionAccessPointsAPIClient
+}
+
+// s3ControlAccountID Returns the account ID from a scope. Every S3 Control
+// request has to include the account ID. Regional adapters have scopes in the
+// format {accountID}.{region}, but Multi-Region Access Points aren't regional
+// so their scope is just the account ID
+func s3ControlAccountID(scope string) string {
+	if accountID, _, err := adapterhelpers.ParseScope(scope); err == nil {
+		return accountID
+	}
+
+	return scope
+}
@@ -0,0 +1,28 @@
+package adapters
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+func s3ControlGetAutoConfig(t *testing.T) (*s3control.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := s3control.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestS3ControlAccountID(t *testing.T) {
+	tests := map[string]string{
+		"123456789012.eu-west-2": "123456789012",
+		"123456789012":           "123456789012",
+	}
+
+	for scope, expected := range tests {
+		if actual := s3ControlAccountID(scope); actual != expected {
+			t.Errorf("expected %v for %v, got %v", expected, scope, actual)
+		}
+	}
+}
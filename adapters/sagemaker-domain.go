@@ -0,0 +1,197 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sagemakerDomainGetFunc(ctx context.Context, client SageMakerClient, scope, query string) (*sagemaker.DescribeDomainOutput, error) {
+	return client.DescribeDomain(ctx, &sagemaker.DescribeDomainInput{
+		DomainId: &query,
+	})
+}
+
+func sagemakerDomainListFunc(ctx context.Context, client SageMakerClient, scope string) ([]*sagemaker.DescribeDomainOutput, error) {
+	paginator := sagemaker.NewListDomainsPaginator(client, &sagemaker.ListDomainsInput{})
+
+	domains := make([]*sagemaker.DescribeDomainOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The details don't include the network or default settings
+		for _, details := range out.Domains {
+			if details.DomainId == nil {
+				continue
+			}
+
+			domain, err := sagemakerDomainGetFunc(ctx, client, scope, *details.DomainId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			domains = append(domains, domain)
+		}
+	}
+
+	return domains, nil
+}
+
+func sagemakerDomainItemMapper(_, scope string, awsItem *sagemaker.DescribeDomainOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sagemaker-domain",
+		UniqueAttribute: "DomainId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.DomainStatusInService:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DomainStatusPending, types.DomainStatusUpdating, types.DomainStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DomainStatusFailed, types.DomainStatusUpdateFailed, types.DomainStatusDeleteFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.VpcId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.VpcId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Apps in the domain communicate through the VPC
+				In: true,
+				// The domain can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	// Security groups can be set for the domain itself, and as defaults for
+	// the users and spaces in it. These often overlap
+	securityGroups := make([]string, 0)
+	seen := make(map[string]bool)
+
+	addSecurityGroups := func(groups ...string) {
+		for _, group := range groups {
+			if !seen[group] {
+				seen[group] = true
+				securityGroups = append(securityGroups, group)
+			}
+		}
+	}
+
+	if awsItem.SecurityGroupIdForDomainBoundary != nil {
+		addSecurityGroups(*awsItem.SecurityGroupIdForDomainBoundary)
+	}
+
+	if awsItem.DomainSettings != nil {
+		addSecurityGroups(awsItem.DomainSettings.SecurityGroupIds...)
+	}
+
+	roles := make([]string, 0)
+
+	if settings := awsItem.DefaultUserSettings; settings != nil {
+		addSecurityGroups(settings.SecurityGroups...)
+
+		if settings.ExecutionRole != nil {
+			roles = append(roles, *settings.ExecutionRole)
+		}
+	}
+
+	if settings := awsItem.DefaultSpaceSettings; settings != nil {
+		addSecurityGroups(settings.SecurityGroups...)
+
+		// Spaces usually use the same role as users
+		if settings.ExecutionRole != nil && (len(roles) == 0 || roles[0] != *settings.ExecutionRole) {
+			roles = append(roles, *settings.ExecutionRole)
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerVpcConfigLinks(&types.VpcConfig{
+		SecurityGroupIds: securityGroups,
+		Subnets:          awsItem.SubnetIds,
+	}, scope)...)
+
+	for _, role := range roles {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(role, scope))
+	}
+
+	if awsItem.HomeEfsFileSystemId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "efs-file-system",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.HomeEfsFileSystemId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Users' home directories are stored on the file system
+				In: true,
+				// Users write to the file system
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.KmsKeyId != nil {
+		// Used to encrypt the home file system
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.KmsKeyId, scope))
+	}
+
+	return &item, nil
+}
+
+func NewSageMakerDomainAdapter(client SageMakerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*sagemaker.DescribeDomainOutput, SageMakerClient, *sagemaker.Options] {
+	return &adapterhelpers.GetListAdapter[*sagemaker.DescribeDomainOutput, SageMakerClient, *sagemaker.Options]{
+		ItemType:        "sagemaker-domain",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sagemakerDomainAdapterMetadata,
+		GetFunc:         sagemakerDomainGetFunc,
+		ListFunc:        sagemakerDomainListFunc,
+		ItemMapper:      sagemakerDomainItemMapper,
+		ListTagsFunc: func(ctx context.Context, domain *sagemaker.DescribeDomainOutput, client SageMakerClient) (map[string]string, error) {
+			return sagemakerListTags(ctx, client, domain.DomainArn)
+		},
+	}
+}
+
+var sagemakerDomainAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sagemaker-domain",
+	DescriptiveName: "SageMaker Domain",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a SageMaker domain by ID",
+		ListDescription:   "List all SageMaker domains",
+		SearchDescription: "Search for SageMaker domains by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_sagemaker_domain.id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-subnet", "ec2-security-group", "iam-role", "efs-file-system", "kms-key"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSageMakerDomainItemMapper(t *testing.T) {
+	domain := &sagemaker.DescribeDomainOutput{
+		DomainArn:                        adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:domain/d-xxxxxxxxxxxx"),
+		DomainId:                         adapterhelpers.PtrString("d-xxxxxxxxxxxx"),
+		DomainName:                       adapterhelpers.PtrString("data-science"),
+		Status:                           types.DomainStatusUpdateFailed,
+		AuthMode:                         types.AuthModeIam,
+		AppNetworkAccessType:             types.AppNetworkAccessTypeVpcOnly,
+		VpcId:                            adapterhelpers.PtrString("vpc-0d4c3b2a1f0e9d8c7"),
+		SubnetIds:                        []string{"subnet-0a1b2c3d4e5f6a7b8", "subnet-0c9d8e7f6a5b4c3d2"},
+		HomeEfsFileSystemId:              adapterhelpers.PtrString("fs-0123456789abcdef0"),
+		KmsKeyId:                         adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		SecurityGroupIdForDomainBoundary: adapterhelpers.PtrString("sg-0b7f3c2a1d4e5f6a7"),
+		DefaultUserSettings: &types.UserSettings{
+			ExecutionRole:  adapterhelpers.PtrString("arn:aws:iam::123456789012:role/SageMakerStudio"),
+			SecurityGroups: []string{"sg-0a1b2c3d4e5f6a7b8"},
+		},
+		DefaultSpaceSettings: &types.DefaultSpaceSettings{
+			ExecutionRole:  adapterhelpers.PtrString("arn:aws:iam::123456789012:role/SageMakerStudio"),
+			SecurityGroups: []string{"sg-0a1b2c3d4e5f6a7b8"},
+		},
+		CreationTime: adapterhelpers.PtrTime(time.Now()),
+	}
+
+	item, err := sagemakerDomainItemMapper("", "123456789012.eu-west-2", domain)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0d4c3b2a1f0e9d8c7",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0c9d8e7f6a5b4c3d2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0b7f3c2a1d4e5f6a7",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/SageMakerStudio",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "efs-file-system",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// Security groups and roles that are shared between the defaults should
+	// only be linked once
+	counts := make(map[string]int)
+	for _, link := range item.GetLinkedItemQueries() {
+		counts[link.GetQuery().GetQuery()]++
+	}
+
+	for _, query := range []string{"sg-0a1b2c3d4e5f6a7b8", "arn:aws:iam::123456789012:role/SageMakerStudio"} {
+		if counts[query] != 1 {
+			t.Errorf("expected 1 link to %v, got %v", query, counts[query])
+		}
+	}
+}
+
+func TestNewSageMakerDomainAdapter(t *testing.T) {
+	client, account, region := sagemakerGetAutoConfig(t)
+
+	adapter := NewSageMakerDomainAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sagemakerEndpointConfigGetFunc(ctx context.Context, client SageMakerClient, scope, query string) (*sagemaker.DescribeEndpointConfigOutput, error) {
+	return client.DescribeEndpointConfig(ctx, &sagemaker.DescribeEndpointConfigInput{
+		EndpointConfigName: &query,
+	})
+}
+
+func sagemakerEndpointConfigListFunc(ctx context.Context, client SageMakerClient, scope string) ([]*sagemaker.DescribeEndpointConfigOutput, error) {
+	paginator := sagemaker.NewListEndpointConfigsPaginator(client, &sagemaker.ListEndpointConfigsInput{})
+
+	configs := make([]*sagemaker.DescribeEndpointConfigOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries only include the name and ARN
+		for _, summary := range out.EndpointConfigs {
+			if summary.EndpointConfigName == nil {
+				continue
+			}
+
+			config, err := sagemakerEndpointConfigGetFunc(ctx, client, scope, *summary.EndpointConfigName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			configs = append(configs, config)
+		}
+	}
+
+	return configs, nil
+}
+
+// sagemakerAsyncInferenceLinks Links to where the results of asynchronous
+// inference are written, and the topics that are notified when it completes
+func sagemakerAsyncInferenceLinks(config *types.AsyncInferenceConfig, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if config == nil || config.OutputConfig == nil {
+		return links
+	}
+
+	output := config.OutputConfig
+
+	for _, path := range []*string{output.S3OutputPath, output.S3FailurePath} {
+		if path == nil {
+			continue
+		}
+
+		link := s3BucketLink(*path, scope, &sdp.BlastPropagation{
+			// Results can't be written if the bucket is removed
+			In: true,
+			// Results are written to the bucket
+			Out: true,
+		})
+
+		if link != nil {
+			links = append(links, link)
+		}
+	}
+
+	if output.KmsKeyId != nil {
+		links = append(links, kmsKeyLink(*output.KmsKeyId, scope))
+	}
+
+	if output.NotificationConfig != nil {
+		for _, topic := range []*string{output.NotificationConfig.SuccessTopic, output.NotificationConfig.ErrorTopic} {
+			if topic == nil {
+				continue
+			}
+
+			if a, err := adapterhelpers.ParseARN(*topic); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "sns-topic",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *topic,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The topic can't affect inference
+						In: false,
+						// Notifications are published to the topic
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	return links
+}
+
+func sagemakerEndpointConfigItemMapper(_, scope string, awsItem *sagemaker.DescribeEndpointConfigOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sagemaker-endpoint-config",
+		UniqueAttribute: "EndpointConfigName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	variants := make([]types.ProductionVariant, 0, len(awsItem.ProductionVariants)+len(awsItem.ShadowProductionVariants))
+	variants = append(variants, awsItem.ProductionVariants...)
+	variants = append(variants, awsItem.ShadowProductionVariants...)
+
+	for _, variant := range variants {
+		if variant.ModelName == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "sagemaker-model",
+				Method: sdp.QueryMethod_GET,
+				Query:  *variant.ModelName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The model defines what the variant serves
+				In: true,
+				// The config can't affect the model
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.KmsKeyId != nil {
+		// Used to encrypt the storage volume of the instances
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.KmsKeyId, scope))
+	}
+
+	if capture := awsItem.DataCaptureConfig; capture != nil {
+		if capture.DestinationS3Uri != nil {
+			link := s3BucketLink(*capture.DestinationS3Uri, scope, &sdp.BlastPropagation{
+				// Data can't be captured if the bucket is removed
+				In: true,
+				// Requests and responses are written to the bucket
+				Out: true,
+			})
+
+			if link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if capture.KmsKeyId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*capture.KmsKeyId, scope))
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerAsyncInferenceLinks(awsItem.AsyncInferenceConfig, scope)...)
+
+	if awsItem.ExecutionRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.ExecutionRoleArn, scope))
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerVpcConfigLinks(awsItem.VpcConfig, scope)...)
+
+	return &item, nil
+}
+
+func NewSageMakerEndpointConfigAdapter(client SageMakerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*sagemaker.DescribeEndpointConfigOutput, SageMakerClient, *sagemaker.Options] {
+	return &adapterhelpers.GetListAdapter[*sagemaker.DescribeEndpointConfigOutput, SageMakerClient, *sagemaker.Options]{
+		ItemType:        "sagemaker-endpoint-config",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sagemakerEndpointConfigAdapterMetadata,
+		GetFunc:         sagemakerEndpointConfigGetFunc,
+		ListFunc:        sagemakerEndpointConfigListFunc,
+		ItemMapper:      sagemakerEndpointConfigItemMapper,
+		ListTagsFunc: func(ctx context.Context, config *sagemaker.DescribeEndpointConfigOutput, client SageMakerClient) (map[string]string, error) {
+			return sagemakerListTags(ctx, client, config.EndpointConfigArn)
+		},
+	}
+}
+
+var sagemakerEndpointConfigAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sagemaker-endpoint-config",
+	DescriptiveName: "SageMaker Endpoint Config",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an endpoint config by name",
+		ListDescription:   "List all endpoint configs",
+		SearchDescription: "Search for endpoint configs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_sagemaker_endpoint_configuration.name"},
+	},
+	PotentialLinks: []string{"sagemaker-model", "kms-key", "s3-bucket", "sns-topic", "iam-role", "ec2-subnet", "ec2-security-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
@@ -0,0 +1,117 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSageMakerEndpointConfigItemMapper(t *testing.T) {
+	config := &sagemaker.DescribeEndpointConfigOutput{
+		EndpointConfigArn:  adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:endpoint-config/fraud-detection-v2"),
+		EndpointConfigName: adapterhelpers.PtrString("fraud-detection-v2"),
+		CreationTime:       adapterhelpers.PtrTime(time.Now()),
+		KmsKeyId:           adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+		ProductionVariants: []types.ProductionVariant{
+			{
+				VariantName:          adapterhelpers.PtrString("primary"),
+				ModelName:            adapterhelpers.PtrString("fraud-detection"),
+				InstanceType:         types.ProductionVariantInstanceTypeMlM5Large,
+				InitialInstanceCount: adapterhelpers.PtrInt32(2),
+			},
+		},
+		ShadowProductionVariants: []types.ProductionVariant{
+			{
+				VariantName: adapterhelpers.PtrString("shadow"),
+				ModelName:   adapterhelpers.PtrString("fraud-detection-candidate"),
+			},
+		},
+		DataCaptureConfig: &types.DataCaptureConfig{
+			DestinationS3Uri: adapterhelpers.PtrString("s3://ml-capture/fraud"),
+			KmsKeyId:         adapterhelpers.PtrString("capture-key"),
+		},
+		AsyncInferenceConfig: &types.AsyncInferenceConfig{
+			OutputConfig: &types.AsyncInferenceOutputConfig{
+				S3OutputPath: adapterhelpers.PtrString("s3://ml-async/output"),
+				NotificationConfig: &types.AsyncInferenceNotificationConfig{
+					ErrorTopic: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:inference-errors"),
+				},
+			},
+		},
+	}
+
+	item, err := sagemakerEndpointConfigItemMapper("", "123456789012.eu-west-2", config)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "sagemaker-model",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-detection",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sagemaker-model",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-detection-candidate",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "capture-key",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ml-capture",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ml-async",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:inference-errors",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSageMakerEndpointConfigAdapter(t *testing.T) {
+	client, account, region := sagemakerGetAutoConfig(t)
+
+	adapter := NewSageMakerEndpointConfigAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,179 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sagemakerEndpointGetFunc(ctx context.Context, client SageMakerClient, scope, query string) (*sagemaker.DescribeEndpointOutput, error) {
+	return client.DescribeEndpoint(ctx, &sagemaker.DescribeEndpointInput{
+		EndpointName: &query,
+	})
+}
+
+func sagemakerEndpointListFunc(ctx context.Context, client SageMakerClient, scope string) ([]*sagemaker.DescribeEndpointOutput, error) {
+	paginator := sagemaker.NewListEndpointsPaginator(client, &sagemaker.ListEndpointsInput{})
+
+	endpoints := make([]*sagemaker.DescribeEndpointOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the config or variants
+		for _, summary := range out.Endpoints {
+			if summary.EndpointName == nil {
+				continue
+			}
+
+			endpoint, err := sagemakerEndpointGetFunc(ctx, client, scope, *summary.EndpointName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			endpoints = append(endpoints, endpoint)
+		}
+	}
+
+	return endpoints, nil
+}
+
+func sagemakerEndpointItemMapper(_, scope string, awsItem *sagemaker.DescribeEndpointOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sagemaker-endpoint",
+		UniqueAttribute: "EndpointName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.EndpointStatus {
+	case types.EndpointStatusInService:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.EndpointStatusCreating, types.EndpointStatusUpdating, types.EndpointStatusSystemUpdating, types.EndpointStatusRollingBack, types.EndpointStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.EndpointStatusOutOfService:
+		// The endpoint exists but isn't serving requests
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.EndpointStatusFailed, types.EndpointStatusUpdateRollbackFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	configNames := make([]string, 0)
+
+	if awsItem.EndpointConfigName != nil {
+		configNames = append(configNames, *awsItem.EndpointConfigName)
+	}
+
+	// While an update is in progress the endpoint is moving to a new config
+	if awsItem.PendingDeploymentSummary != nil && awsItem.PendingDeploymentSummary.EndpointConfigName != nil {
+		configNames = append(configNames, *awsItem.PendingDeploymentSummary.EndpointConfigName)
+	}
+
+	for _, name := range configNames {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "sagemaker-endpoint-config",
+				Method: sdp.QueryMethod_GET,
+				Query:  name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The config defines the models and instances that serve
+				// the endpoint
+				In: true,
+				// The endpoint can't affect the config
+				Out: false,
+			},
+		})
+	}
+
+	// The images that are actually running, which may differ from the ones
+	// in the model if a tag has since been moved
+	variants := make([]types.ProductionVariantSummary, 0, len(awsItem.ProductionVariants)+len(awsItem.ShadowProductionVariants))
+	variants = append(variants, awsItem.ProductionVariants...)
+	variants = append(variants, awsItem.ShadowProductionVariants...)
+
+	for _, variant := range variants {
+		for _, image := range variant.DeployedImages {
+			if image.ResolvedImage == nil {
+				continue
+			}
+
+			if link := batchImageLink(*image.ResolvedImage); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	if capture := awsItem.DataCaptureConfig; capture != nil {
+		if capture.DestinationS3Uri != nil {
+			link := s3BucketLink(*capture.DestinationS3Uri, scope, &sdp.BlastPropagation{
+				// Data can't be captured if the bucket is removed
+				In: true,
+				// Requests and responses are written to the bucket
+				Out: true,
+			})
+
+			if link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if capture.KmsKeyId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*capture.KmsKeyId, scope))
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerAsyncInferenceLinks(awsItem.AsyncInferenceConfig, scope)...)
+
+	return &item, nil
+}
+
+func NewSageMakerEndpointAdapter(client SageMakerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*sagemaker.DescribeEndpointOutput, SageMakerClient, *sagemaker.Options] {
+	return &adapterhelpers.GetListAdapter[*sagemaker.DescribeEndpointOutput, SageMakerClient, *sagemaker.Options]{
+		ItemType:        "sagemaker-endpoint",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sagemakerEndpointAdapterMetadata,
+		GetFunc:         sagemakerEndpointGetFunc,
+		ListFunc:        sagemakerEndpointListFunc,
+		ItemMapper:      sagemakerEndpointItemMapper,
+		ListTagsFunc: func(ctx context.Context, endpoint *sagemaker.DescribeEndpointOutput, client SageMakerClient) (map[string]string, error) {
+			return sagemakerListTags(ctx, client, endpoint.EndpointArn)
+		},
+	}
+}
+
+var sagemakerEndpointAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sagemaker-endpoint",
+	DescriptiveName: "SageMaker Endpoint",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a SageMaker endpoint by name",
+		ListDescription:   "List all SageMaker endpoints",
+		SearchDescription: "Search for SageMaker endpoints by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_sagemaker_endpoint.name"},
+	},
+	PotentialLinks: []string{"sagemaker-endpoint-config", "ecr-repository", "s3-bucket", "kms-key", "sns-topic"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,122 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testSageMakerEndpoint = &sagemaker.DescribeEndpointOutput{
+	EndpointArn:        adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:endpoint/fraud-detection"),
+	EndpointName:       adapterhelpers.PtrString("fraud-detection"),
+	EndpointConfigName: adapterhelpers.PtrString("fraud-detection-v1"),
+	EndpointStatus:     types.EndpointStatusUpdating,
+	CreationTime:       adapterhelpers.PtrTime(time.Now()),
+	LastModifiedTime:   adapterhelpers.PtrTime(time.Now()),
+	ProductionVariants: []types.ProductionVariantSummary{
+		{
+			VariantName:          adapterhelpers.PtrString("primary"),
+			CurrentInstanceCount: adapterhelpers.PtrInt32(2),
+			DeployedImages: []types.DeployedImage{
+				{
+					SpecifiedImage: adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/fraud-inference:1.4.0"),
+					ResolvedImage:  adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/fraud-inference@sha256:8f2a6c1e3b4d4e5fa6b7c8d9e0f1a2b38f2a6c1e3b4d4e5fa6b7c8d9e0f1a2b3"),
+				},
+			},
+		},
+	},
+	PendingDeploymentSummary: &types.PendingDeploymentSummary{
+		EndpointConfigName: adapterhelpers.PtrString("fraud-detection-v2"),
+	},
+	DataCaptureConfig: &types.DataCaptureConfigSummary{
+		DestinationS3Uri: adapterhelpers.PtrString("s3://ml-capture/fraud"),
+		EnableCapture:    adapterhelpers.PtrBool(true),
+	},
+}
+
+func TestSageMakerEndpointItemMapper(t *testing.T) {
+	item, err := sagemakerEndpointItemMapper("", "123456789012.eu-west-2", testSageMakerEndpoint)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "sagemaker-endpoint-config",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-detection-v1",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sagemaker-endpoint-config",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-detection-v2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-inference",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ml-capture",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSageMakerEndpointListFunc(t *testing.T) {
+	client := SageMakerTestClient{
+		ListEndpointsOutput: &sagemaker.ListEndpointsOutput{
+			Endpoints: []types.EndpointSummary{
+				{
+					EndpointName: testSageMakerEndpoint.EndpointName,
+				},
+			},
+		},
+		DescribeEndpointOutput: testSageMakerEndpoint,
+	}
+
+	endpoints, err := sagemakerEndpointListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(endpoints) != 1 {
+		t.Errorf("expected 1 endpoint, got %v", len(endpoints))
+	}
+}
+
+func TestNewSageMakerEndpointAdapter(t *testing.T) {
+	client, account, region := sagemakerGetAutoConfig(t)
+
+	adapter := NewSageMakerEndpointAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,155 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sagemakerModelGetFunc(ctx context.Context, client SageMakerClient, scope, query string) (*sagemaker.DescribeModelOutput, error) {
+	return client.DescribeModel(ctx, &sagemaker.DescribeModelInput{
+		ModelName: &query,
+	})
+}
+
+func sagemakerModelListFunc(ctx context.Context, client SageMakerClient, scope string) ([]*sagemaker.DescribeModelOutput, error) {
+	paginator := sagemaker.NewListModelsPaginator(client, &sagemaker.ListModelsInput{})
+
+	models := make([]*sagemaker.DescribeModelOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries only include the name and ARN
+		for _, summary := range out.Models {
+			if summary.ModelName == nil {
+				continue
+			}
+
+			model, err := sagemakerModelGetFunc(ctx, client, scope, *summary.ModelName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			models = append(models, model)
+		}
+	}
+
+	return models, nil
+}
+
+// sagemakerContainerLinks Links to the image that a model's container runs and
+// the S3 location that its artifacts are loaded from
+func sagemakerContainerLinks(container types.ContainerDefinition, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if container.Image != nil {
+		if link := batchImageLink(*container.Image); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	artifacts := make([]string, 0)
+
+	if container.ModelDataUrl != nil {
+		artifacts = append(artifacts, *container.ModelDataUrl)
+	}
+
+	if container.ModelDataSource != nil && container.ModelDataSource.S3DataSource != nil && container.ModelDataSource.S3DataSource.S3Uri != nil {
+		artifacts = append(artifacts, *container.ModelDataSource.S3DataSource.S3Uri)
+	}
+
+	for _, uri := range artifacts {
+		link := s3BucketLink(uri, scope, &sdp.BlastPropagation{
+			// The model can't be deployed if its artifacts are removed
+			In: true,
+			// The model can't affect the bucket
+			Out: false,
+		})
+
+		if link != nil {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+func sagemakerModelItemMapper(_, scope string, awsItem *sagemaker.DescribeModelOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sagemaker-model",
+		UniqueAttribute: "ModelName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	containers := make([]types.ContainerDefinition, 0, len(awsItem.Containers)+1)
+
+	if awsItem.PrimaryContainer != nil {
+		containers = append(containers, *awsItem.PrimaryContainer)
+	}
+
+	// Inference pipelines have multiple containers rather than a primary
+	containers = append(containers, awsItem.Containers...)
+
+	for _, container := range containers {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerContainerLinks(container, scope)...)
+	}
+
+	if awsItem.ExecutionRoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.ExecutionRoleArn, scope))
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerVpcConfigLinks(awsItem.VpcConfig, scope)...)
+
+	return &item, nil
+}
+
+func NewSageMakerModelAdapter(client SageMakerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*sagemaker.DescribeModelOutput, SageMakerClient, *sagemaker.Options] {
+	return &adapterhelpers.GetListAdapter[*sagemaker.DescribeModelOutput, SageMakerClient, *sagemaker.Options]{
+		ItemType:        "sagemaker-model",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sagemakerModelAdapterMetadata,
+		GetFunc:         sagemakerModelGetFunc,
+		ListFunc:        sagemakerModelListFunc,
+		ItemMapper:      sagemakerModelItemMapper,
+		ListTagsFunc: func(ctx context.Context, model *sagemaker.DescribeModelOutput, client SageMakerClient) (map[string]string, error) {
+			return sagemakerListTags(ctx, client, model.ModelArn)
+		},
+	}
+}
+
+var sagemakerModelAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sagemaker-model",
+	DescriptiveName: "SageMaker Model",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a SageMaker model by name",
+		ListDescription:   "List all SageMaker models",
+		SearchDescription: "Search for SageMaker models by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_sagemaker_model.name"},
+	},
+	PotentialLinks: []string{"ecr-repository", "s3-bucket", "iam-role", "ec2-subnet", "ec2-security-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSageMakerModelItemMapper(t *testing.T) {
+	model := &sagemaker.DescribeModelOutput{
+		ModelArn:         adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:model/fraud-detection"),
+		ModelName:        adapterhelpers.PtrString("fraud-detection"),
+		CreationTime:     adapterhelpers.PtrTime(time.Now()),
+		ExecutionRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/SageMakerExecution"),
+		PrimaryContainer: &types.ContainerDefinition{
+			Image:        adapterhelpers.PtrString("123456789012.dkr.ecr.eu-west-2.amazonaws.com/fraud-inference:1.4.0"),
+			ModelDataUrl: adapterhelpers.PtrString("s3://ml-artifacts/fraud/model.tar.gz"),
+		},
+		Containers: []types.ContainerDefinition{
+			{
+				Image: adapterhelpers.PtrString("763104351884.dkr.ecr.eu-west-2.amazonaws.com/pytorch-inference:2.1.0-cpu-py310"),
+				ModelDataSource: &types.ModelDataSource{
+					S3DataSource: &types.S3ModelDataSource{
+						S3Uri:           adapterhelpers.PtrString("s3://ml-features/fraud/"),
+						S3DataType:      types.S3ModelDataTypeS3Prefix,
+						CompressionType: types.ModelCompressionTypeNone,
+					},
+				},
+			},
+		},
+		VpcConfig: &types.VpcConfig{
+			SecurityGroupIds: []string{"sg-0a1b2c3d4e5f6a7b8"},
+			Subnets:          []string{"subnet-0c9d8e7f6a5b4c3d2"},
+		},
+	}
+
+	item, err := sagemakerModelItemMapper("", "123456789012.eu-west-2", model)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fraud-inference",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ecr-repository",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "pytorch-inference",
+			ExpectedScope:  "763104351884.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ml-artifacts",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ml-features",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/SageMakerExecution",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0c9d8e7f6a5b4c3d2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSageMakerModelAdapter(t *testing.T) {
+	client, account, region := sagemakerGetAutoConfig(t)
+
+	adapter := NewSageMakerModelAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,175 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sagemakerNotebookInstanceGetFunc(ctx context.Context, client SageMakerClient, scope, query string) (*sagemaker.DescribeNotebookInstanceOutput, error) {
+	return client.DescribeNotebookInstance(ctx, &sagemaker.DescribeNotebookInstanceInput{
+		NotebookInstanceName: &query,
+	})
+}
+
+func sagemakerNotebookInstanceListFunc(ctx context.Context, client SageMakerClient, scope string) ([]*sagemaker.DescribeNotebookInstanceOutput, error) {
+	paginator := sagemaker.NewListNotebookInstancesPaginator(client, &sagemaker.ListNotebookInstancesInput{})
+
+	instances := make([]*sagemaker.DescribeNotebookInstanceOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the network or role
+		for _, summary := range out.NotebookInstances {
+			if summary.NotebookInstanceName == nil {
+				continue
+			}
+
+			instance, err := sagemakerNotebookInstanceGetFunc(ctx, client, scope, *summary.NotebookInstanceName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			instances = append(instances, instance)
+		}
+	}
+
+	return instances, nil
+}
+
+func sagemakerNotebookInstanceItemMapper(_, scope string, awsItem *sagemaker.DescribeNotebookInstanceOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "sagemaker-notebook-instance",
+		UniqueAttribute: "NotebookInstanceName",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.NotebookInstanceStatus {
+	case types.NotebookInstanceStatusInService:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.NotebookInstanceStatusPending, types.NotebookInstanceStatusStopping, types.NotebookInstanceStatusDeleting, types.NotebookInstanceStatusUpdating:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.NotebookInstanceStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	vpcConfig := &types.VpcConfig{
+		SecurityGroupIds: awsItem.SecurityGroups,
+	}
+
+	if awsItem.SubnetId != nil {
+		vpcConfig.Subnets = []string{*awsItem.SubnetId}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, sagemakerVpcConfigLinks(vpcConfig, scope)...)
+
+	if awsItem.NetworkInterfaceId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-network-interface",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.NetworkInterfaceId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Tightly coupled
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.RoleArn != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.RoleArn, scope))
+	}
+
+	if awsItem.KmsKeyId != nil {
+		// Used to encrypt the instance's storage volume
+		item.LinkedItemQueries = append(item.LinkedItemQueries, kmsKeyLink(*awsItem.KmsKeyId, scope))
+	}
+
+	repositories := make([]string, 0, len(awsItem.AdditionalCodeRepositories)+1)
+
+	if awsItem.DefaultCodeRepository != nil {
+		repositories = append(repositories, *awsItem.DefaultCodeRepository)
+	}
+
+	repositories = append(repositories, awsItem.AdditionalCodeRepositories...)
+
+	for _, repository := range repositories {
+		// Repositories are either URLs, or the names of repositories that
+		// are registered with SageMaker
+		if !strings.HasPrefix(repository, "https://") {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "http",
+				Method: sdp.QueryMethod_GET,
+				Query:  repository,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The repository is cloned when the instance starts
+				In: true,
+				// The instance can push changes to the repository
+				Out: true,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewSageMakerNotebookInstanceAdapter(client SageMakerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*sagemaker.DescribeNotebookInstanceOutput, SageMakerClient, *sagemaker.Options] {
+	return &adapterhelpers.GetListAdapter[*sagemaker.DescribeNotebookInstanceOutput, SageMakerClient, *sagemaker.Options]{
+		ItemType:        "sagemaker-notebook-instance",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sagemakerNotebookInstanceAdapterMetadata,
+		GetFunc:         sagemakerNotebookInstanceGetFunc,
+		ListFunc:        sagemakerNotebookInstanceListFunc,
+		ItemMapper:      sagemakerNotebookInstanceItemMapper,
+		ListTagsFunc: func(ctx context.Context, instance *sagemaker.DescribeNotebookInstanceOutput, client SageMakerClient) (map[string]string, error) {
+			return sagemakerListTags(ctx, client, instance.NotebookInstanceArn)
+		},
+	}
+}
+
+var sagemakerNotebookInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "sagemaker-notebook-instance",
+	DescriptiveName: "SageMaker Notebook Instance",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a notebook instance by name",
+		ListDescription:   "List all notebook instances",
+		SearchDescription: "Search for notebook instances by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_sagemaker_notebook_instance.name"},
+	},
+	PotentialLinks: []string{"ec2-subnet", "ec2-security-group", "ec2-network-interface", "iam-role", "kms-key", "http"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSageMakerNotebookInstanceItemMapper(t *testing.T) {
+	instance := &sagemaker.DescribeNotebookInstanceOutput{
+		NotebookInstanceArn:        adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:notebook-instance/research"),
+		NotebookInstanceName:       adapterhelpers.PtrString("research"),
+		NotebookInstanceStatus:     types.NotebookInstanceStatusInService,
+		InstanceType:               types.InstanceTypeMlT3Medium,
+		DirectInternetAccess:       types.DirectInternetAccessDisabled,
+		SubnetId:                   adapterhelpers.PtrString("subnet-0c9d8e7f6a5b4c3d2"),
+		SecurityGroups:             []string{"sg-0a1b2c3d4e5f6a7b8"},
+		NetworkInterfaceId:         adapterhelpers.PtrString("eni-0f1e2d3c4b5a69788"),
+		RoleArn:                    adapterhelpers.PtrString("arn:aws:iam::123456789012:role/SageMakerNotebook"),
+		KmsKeyId:                   adapterhelpers.PtrString("1234abcd-12ab-34cd-56ef-1234567890ab"),
+		DefaultCodeRepository:      adapterhelpers.PtrString("https://github.com/example/research.git"),
+		AdditionalCodeRepositories: []string{"shared-notebooks"},
+		Url:                        adapterhelpers.PtrString("research.notebook.eu-west-2.sagemaker.aws"),
+		VolumeSizeInGB:             adapterhelpers.PtrInt32(50),
+		CreationTime:               adapterhelpers.PtrTime(time.Now()),
+	}
+
+	item, err := sagemakerNotebookInstanceItemMapper("", "123456789012.eu-west-2", instance)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0c9d8e7f6a5b4c3d2",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-network-interface",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eni-0f1e2d3c4b5a69788",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/SageMakerNotebook",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://github.com/example/research.git",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	for _, link := range item.GetLinkedItemQueries() {
+		if link.GetQuery().GetQuery() == "shared-notebooks" {
+			t.Error("expected no link for a repository registered by name")
+		}
+	}
+}
+
+func TestNewSageMakerNotebookInstanceAdapter(t *testing.T) {
+	client, account, region := sagemakerGetAutoConfig(t)
+
+	adapter := NewSageMakerNotebookInstanceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
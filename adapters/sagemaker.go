@@ -0,0 +1,101 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/sdp-go"
+)
+
+type SageMakerClient interface {
+	DescribeDomain(ctx context.Context, params *sagemaker.DescribeDomainInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeDomainOutput, error)
+	DescribeEndpoint(ctx context.Context, params *sagemaker.DescribeEndpointInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointOutput, error)
+	DescribeEndpointConfig(ctx context.Context, params *sagemaker.DescribeEndpointConfigInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointConfigOutput, error)
+	DescribeModel(ctx context.Context, params *sagemaker.DescribeModelInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeModelOutput, error)
+	DescribeNotebookInstance(ctx context.Context, params *sagemaker.DescribeNotebookInstanceInput, optFns ...func(*sagemaker.Options)) (*sagemaker.DescribeNotebookInstanceOutput, error)
+
+	sagemaker.ListDomainsAPIClient
+	sagemaker.ListEndpointConfigsAPIClient
+	sagemaker.ListEndpointsAPIClient
+	sagemaker.ListModelsAPIClient
+	sagemaker.ListNotebookInstancesAPIClient
+	sagemaker.ListTagsAPIClient
+}
+
+// sagemakerListTags Gets the tags for a SageMaker resource by ARN
+func sagemakerListTags(ctx context.Context, client SageMakerClient, arn *string) (map[string]string, error) {
+	if arn == nil {
+		return nil, nil
+	}
+
+	paginator := sagemaker.NewListTagsPaginator(client, &sagemaker.ListTagsInput{
+		ResourceArn: arn,
+	})
+
+	tags := make(map[string]string)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tag := range out.Tags {
+			if tag.Key != nil && tag.Value != nil {
+				tags[*tag.Key] = *tag.Value
+			}
+		}
+	}
+
+	return tags, nil
+}
+
+// sagemakerVpcConfigLinks Links to the subnets and security groups that
+// SageMaker creates network interfaces in when models or endpoints call into a
+// VPC
+func sagemakerVpcConfigLinks(config *types.VpcConfig, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if config == nil {
+		return links
+	}
+
+	for _, subnet := range config.Subnets {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-subnet",
+				Method: sdp.QueryMethod_GET,
+				Query:  subnet,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Containers lose access to the VPC if the subnet breaks
+				In: true,
+				// SageMaker can't affect the subnet
+				Out: false,
+			},
+		})
+	}
+
+	for _, securityGroup := range config.SecurityGroupIds {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-security-group",
+				Method: sdp.QueryMethod_GET,
+				Query:  securityGroup,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The security group controls the containers' network access
+				In: true,
+				// SageMaker can't affect the security group
+				Out: false,
+			},
+		})
+	}
+
+	return links
+}
@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	"github.com/aws/aws-sdk-go-v2/service/sagemaker/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type SageMakerTestClient struct {
+	DescribeDomainOutput           *sagemaker.DescribeDomainOutput
+	DescribeEndpointOutput         *sagemaker.DescribeEndpointOutput
+	DescribeEndpointConfigOutput   *sagemaker.DescribeEndpointConfigOutput
+	DescribeModelOutput            *sagemaker.DescribeModelOutput
+	DescribeNotebookInstanceOutput *sagemaker.DescribeNotebookInstanceOutput
+	ListDomainsOutput              *sagemaker.ListDomainsOutput
+	ListEndpointConfigsOutput      *sagemaker.ListEndpointConfigsOutput
+	ListEndpointsOutput            *sagemaker.ListEndpointsOutput
+	ListModelsOutput               *sagemaker.ListModelsOutput
+	ListNotebookInstancesOutput    *sagemaker.ListNotebookInstancesOutput
+	ListTagsOutput                 *sagemaker.ListTagsOutput
+}
+
+func (t SageMakerTestClient) DescribeDomain(context.Context, *sagemaker.DescribeDomainInput, ...func(*sagemaker.Options)) (*sagemaker.DescribeDomainOutput, error) {
+	return t.DescribeDomainOutput, nil
+}
+
+func (t SageMakerTestClient) DescribeEndpoint(context.Context, *sagemaker.DescribeEndpointInput, ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointOutput, error) {
+	return t.DescribeEndpointOutput, nil
+}
+
+func (t SageMakerTestClient) DescribeEndpointConfig(context.Context, *sagemaker.DescribeEndpointConfigInput, ...func(*sagemaker.Options)) (*sagemaker.DescribeEndpointConfigOutput, error) {
+	return t.DescribeEndpointConfigOutput, nil
+}
+
+func (t SageMakerTestClient) DescribeModel(context.Context, *sagemaker.DescribeModelInput, ...func(*sagemaker.Options)) (*sagemaker.DescribeModelOutput, error) {
+	return t.DescribeModelOutput, nil
+}
+
+func (t SageMakerTestClient) DescribeNotebookInstance(context.Context, *sagemaker.DescribeNotebookInstanceInput, ...func(*sagemaker.Options)) (*sagemaker.DescribeNotebookInstanceOutput, error) {
+	return t.DescribeNotebookInstanceOutput, nil
+}
+
+func (t SageMakerTestClient) ListDomains(context.Context, *sagemaker.ListDomainsInput, ...func(*sagemaker.Options)) (*sagemaker.ListDomainsOutput, error) {
+	return t.ListDomainsOutput, nil
+}
+
+func (t SageMakerTestClient) ListEndpointConfigs(context.Context, *sagemaker.ListEndpointConfigsInput, ...func(*sagemaker.Options)) (*sagemaker.ListEndpointConfigsOutput, error) {
+	return t.ListEndpointConfigsOutput, nil
+}
+
+func (t SageMakerTestClient) ListEndpoints(context.Context, *sagemaker.ListEndpointsInput, ...func(*sagemaker.Options)) (*sagemaker.ListEndpointsOutput, error) {
+	return t.ListEndpointsOutput, nil
+}
+
+func (t SageMakerTestClient) ListModels(context.Context, *sagemaker.ListModelsInput, ...func(*sagemaker.Options)) (*sagemaker.ListModelsOutput, error) {
+	return t.ListModelsOutput, nil
+}
+
+func (t SageMakerTestClient) ListNotebookInstances(context.Context, *sagemaker.ListNotebookInstancesInput, ...func(*sagemaker.Options)) (*sagemaker.ListNotebookInstancesOutput, error) {
+	return t.ListNotebookInstancesOutput, nil
+}
+
+func (t SageMakerTestClient) ListTags(context.Context, *sagemaker.ListTagsInput, ...func(*sagemaker.Options)) (*sagemaker.ListTagsOutput, error) {
+	return t.ListTagsOutput, nil
+}
+
+func sagemakerGetAutoConfig(t *testing.T) (*sagemaker.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := sagemaker.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestSageMakerListTags(t *testing.T) {
+	client := SageMakerTestClient{
+		ListTagsOutput: &sagemaker.ListTagsOutput{
+			Tags: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("team"),
+					Value: adapterhelpers.PtrString("ml"),
+				},
+			},
+		},
+	}
+
+	tags, err := sagemakerListTags(context.Background(), client, adapterhelpers.PtrString("arn:aws:sagemaker:eu-west-2:123456789012:endpoint/fraud-detection"))
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tags["team"] != "ml" {
+		t.Errorf("expected tag team=ml, got %v", tags)
+	}
+}
@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// securityhubHubGetFunc Gets the hub for the current region. There is only
+// ever one hub per account and region, and its ARN always ends in
+// "hub/default", so the query can be either the ARN or "default"
+func securityhubHubGetFunc(ctx context.Context, client SecurityHubClient, scope, query string) (*securityhub.DescribeHubOutput, error) {
+	input := &securityhub.DescribeHubInput{}
+
+	if _, err := adapterhelpers.ParseARN(query); err == nil {
+		input.HubArn = &query
+	} else if query != "default" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be either the hub ARN or \"default\"",
+		}
+	}
+
+	return client.DescribeHub(ctx, input)
+}
+
+// securityhubHubListFunc Returns the hub for the current region if Security
+// Hub is enabled
+func securityhubHubListFunc(ctx context.Context, client SecurityHubClient, scope string) ([]*securityhub.DescribeHubOutput, error) {
+	out, err := client.DescribeHub(ctx, &securityhub.DescribeHubInput{})
+
+	if err != nil {
+		// This is returned when Security Hub hasn't been enabled in the
+		// region, in which case there just isn't a hub
+		var invalidAccess *types.InvalidAccessException
+		if errors.As(err, &invalidAccess) {
+			return []*securityhub.DescribeHubOutput{}, nil
+		}
+
+		return nil, err
+	}
+
+	return []*securityhub.DescribeHubOutput{out}, nil
+}
+
+func securityhubHubItemMapper(_, scope string, awsItem *securityhub.DescribeHubOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "securityhub-hub",
+		UniqueAttribute: "HubArn",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	return &item, nil
+}
+
+func NewSecurityHubHubAdapter(client SecurityHubClient, accountID string, region string) *adapterhelpers.GetListAdapter[*securityhub.DescribeHubOutput, SecurityHubClient, *securityhub.Options] {
+	return &adapterhelpers.GetListAdapter[*securityhub.DescribeHubOutput, SecurityHubClient, *securityhub.Options]{
+		ItemType:        "securityhub-hub",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: securityhubHubAdapterMetadata,
+		GetFunc:         securityhubHubGetFunc,
+		ListFunc:        securityhubHubListFunc,
+		ItemMapper:      securityhubHubItemMapper,
+	}
+}
+
+var securityhubHubAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "securityhub-hub",
+	DescriptiveName: "Security Hub",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get the Security Hub hub for the region by ARN or \"default\"",
+		ListDescription:   "List the Security Hub hub for the region",
+		SearchDescription: "Search for the Security Hub hub by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_securityhub_account.arn",
+		},
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
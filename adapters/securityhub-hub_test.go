@@ -0,0 +1,110 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+	"github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+var testSecurityHubHub = &securityhub.DescribeHubOutput{
+	AutoEnableControls:      adapterhelpers.PtrBool(true),
+	ControlFindingGenerator: types.ControlFindingGeneratorSecurityControl,
+	HubArn:                  adapterhelpers.PtrString("arn:aws:securityhub:eu-west-2:123456789012:hub/default"),
+	SubscribedAt:            adapterhelpers.PtrString("2024-03-01T09:30:00.000Z"),
+}
+
+func TestSecurityHubHubItemMapper(t *testing.T) {
+	item, err := securityhubHubItemMapper("", "123456789012.eu-west-2", testSecurityHubHub)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSecurityHubHubGetFunc(t *testing.T) {
+	client := SecurityHubTestClient{
+		DescribeHubOutput: testSecurityHubHub,
+	}
+
+	tests := []struct {
+		Query       string
+		ExpectError bool
+	}{
+		{
+			Query: "arn:aws:securityhub:eu-west-2:123456789012:hub/default",
+		},
+		{
+			Query: "default",
+		},
+		{
+			Query:       "other",
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			hub, err := securityhubHubGetFunc(context.Background(), client, "123456789012.eu-west-2", test.Query)
+
+			if test.ExpectError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if hub == nil {
+				t.Error("expected a hub")
+			}
+		})
+	}
+}
+
+func TestSecurityHubHubListFunc(t *testing.T) {
+	t.Run("enabled", func(t *testing.T) {
+		client := SecurityHubTestClient{
+			DescribeHubOutput: testSecurityHubHub,
+		}
+
+		hubs, err := securityhubHubListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(hubs) != 1 {
+			t.Errorf("expected 1 hub, got %v", len(hubs))
+		}
+	})
+
+	t.Run("not enabled", func(t *testing.T) {
+		client := SecurityHubTestClient{
+			DescribeHubError: &types.InvalidAccessException{
+				Message: adapterhelpers.PtrString("Account 123456789012 is not subscribed to AWS Security Hub"),
+			},
+		}
+
+		hubs, err := securityhubHubListFunc(context.Background(), client, "123456789012.eu-west-2")
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(hubs) != 0 {
+			t.Errorf("expected no hubs, got %v", len(hubs))
+		}
+	})
+}
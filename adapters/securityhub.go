@@ -0,0 +1,13 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+)
+
+type SecurityHubClient interface {
+	DescribeHub(ctx context.Context, params *securityhub.DescribeHubInput, optFns ...func(*securityhub.Options)) (*securityhub.DescribeHubOutput, error)
+
+	securityhub.GetFindingsAPIClient
+}
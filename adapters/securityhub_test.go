@@ -0,0 +1,22 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/securityhub"
+)
+
+type SecurityHubTestClient struct {
+	DescribeHubOutput *securityhub.DescribeHubOutput
+	DescribeHubError  error
+	GetFindingsOutput *securityhub.GetFindingsOutput
+	GetFindingsError  error
+}
+
+func (t SecurityHubTestClient) DescribeHub(context.Context, *securityhub.DescribeHubInput, ...func(*securityhub.Options)) (*securityhub.DescribeHubOutput, error) {
+	return t.DescribeHubOutput, t.DescribeHubError
+}
+
+func (t SecurityHubTestClient) GetFindings(context.Context, *securityhub.GetFindingsInput, ...func(*securityhub.Options)) (*securityhub.GetFindingsOutput, error) {
+	return t.GetFindingsOutput, t.GetFindingsError
+}
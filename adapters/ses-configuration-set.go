@@ -0,0 +1,258 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// sesEventMetricNames The names of the CloudWatch metrics that SES publishes
+// for each event type, see:
+// https://docs.aws.amazon.com/ses/latest/dg/event-publishing-retrieving-cloudwatch.html
+var sesEventMetricNames = map[types.EventType]string{
+	types.EventTypeSend:             "Send",
+	types.EventTypeReject:           "Reject",
+	types.EventTypeBounce:           "Bounce",
+	types.EventTypeComplaint:        "Complaint",
+	types.EventTypeDelivery:         "Delivery",
+	types.EventTypeOpen:             "Open",
+	types.EventTypeClick:            "Click",
+	types.EventTypeRenderingFailure: "Rendering Failures",
+}
+
+func sesConfigurationSetGetFunc(ctx context.Context, client SESClient, scope, query string) (*ses.DescribeConfigurationSetOutput, error) {
+	return client.DescribeConfigurationSet(ctx, &ses.DescribeConfigurationSetInput{
+		ConfigurationSetName: &query,
+		ConfigurationSetAttributeNames: []types.ConfigurationSetAttribute{
+			types.ConfigurationSetAttributeEventDestinations,
+			types.ConfigurationSetAttributeTrackingOptions,
+			types.ConfigurationSetAttributeDeliveryOptions,
+			types.ConfigurationSetAttributeReputationOptions,
+		},
+	})
+}
+
+// sesConfigurationSetListFunc Lists all configuration sets. The list only
+// returns names, and the SDK doesn't provide a paginator for this so we follow
+// the token ourselves
+func sesConfigurationSetListFunc(ctx context.Context, client SESClient, scope string) ([]*ses.DescribeConfigurationSetOutput, error) {
+	input := &ses.ListConfigurationSetsInput{}
+
+	configurationSets := make([]*ses.DescribeConfigurationSetOutput, 0)
+
+	for {
+		out, err := client.ListConfigurationSets(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, configurationSet := range out.ConfigurationSets {
+			if configurationSet.Name == nil {
+				continue
+			}
+
+			details, err := sesConfigurationSetGetFunc(ctx, client, scope, *configurationSet.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			configurationSets = append(configurationSets, details)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return configurationSets, nil
+}
+
+func sesConfigurationSetItemMapper(_, scope string, awsItem *ses.DescribeConfigurationSetOutput) (*sdp.Item, error) {
+	if awsItem.ConfigurationSet == nil || awsItem.ConfigurationSet.Name == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "configuration set was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		Name              *string
+		DeliveryOptions   *types.DeliveryOptions
+		EventDestinations []types.EventDestination
+		ReputationOptions *types.ReputationOptions
+		TrackingOptions   *types.TrackingOptions
+	}{
+		Name:              awsItem.ConfigurationSet.Name,
+		DeliveryOptions:   awsItem.DeliveryOptions,
+		EventDestinations: awsItem.EventDestinations,
+		ReputationOptions: awsItem.ReputationOptions,
+		TrackingOptions:   awsItem.TrackingOptions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ses-configuration-set",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.ReputationOptions != nil {
+		if awsItem.ReputationOptions.SendingEnabled {
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		} else {
+			// Sending is paused, either manually or because of a poor
+			// reputation
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+	}
+
+	for _, destination := range awsItem.EventDestinations {
+		if !destination.Enabled {
+			continue
+		}
+
+		if destination.SNSDestination != nil {
+			if link := sesTopicLink(destination.SNSDestination.TopicARN); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if firehose := destination.KinesisFirehoseDestination; firehose != nil {
+			if firehose.DeliveryStreamARN != nil {
+				if a, err := adapterhelpers.ParseARN(*firehose.DeliveryStreamARN); err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "firehose-delivery-stream",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  *firehose.DeliveryStreamARN,
+							Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// Removing the stream only means that events are
+							// lost
+							In: false,
+							// SES publishes events to the stream
+							Out: true,
+						},
+					})
+				}
+			}
+
+			if firehose.IAMRoleARN != nil {
+				// SES can't deliver events if the role's permissions change
+				item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*firehose.IAMRoleARN, scope))
+			}
+		}
+
+		if cw := destination.CloudWatchDestination; cw != nil {
+			// Events are published as metrics, so link to any alarms on them.
+			// The dimension values come from each message, so we can only
+			// search using the defaults
+			dimensions := make([]cwtypes.Dimension, 0, len(cw.DimensionConfigurations))
+
+			for _, dimension := range cw.DimensionConfigurations {
+				dimensions = append(dimensions, cwtypes.Dimension{
+					Name:  dimension.DimensionName,
+					Value: dimension.DefaultDimensionValue,
+				})
+			}
+
+			for _, eventType := range destination.MatchingEventTypes {
+				metricName, ok := sesEventMetricNames[eventType]
+
+				if !ok {
+					continue
+				}
+
+				query, err := ToQueryString(&cloudwatch.DescribeAlarmsForMetricInput{
+					Namespace:  aws.String("AWS/SES"),
+					MetricName: aws.String(metricName),
+					Dimensions: dimensions,
+				})
+
+				if err == nil {
+					item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+						Query: &sdp.Query{
+							Type:   "cloudwatch-alarm",
+							Method: sdp.QueryMethod_SEARCH,
+							Query:  query,
+							Scope:  scope,
+						},
+						BlastPropagation: &sdp.BlastPropagation{
+							// The alarm can't affect the configuration set
+							In: false,
+							// Changes to the configuration set change the
+							// metrics that the alarm uses
+							Out: true,
+						},
+					})
+				}
+			}
+		}
+	}
+
+	if awsItem.TrackingOptions != nil && awsItem.TrackingOptions.CustomRedirectDomain != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.TrackingOptions.CustomRedirectDomain,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Open and click tracking links go through this domain
+				In: true,
+				// The configuration set can't affect DNS
+				Out: false,
+			},
+		})
+	}
+
+	return &item, nil
+}
+
+func NewSESConfigurationSetAdapter(client SESClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ses.DescribeConfigurationSetOutput, SESClient, *ses.Options] {
+	return &adapterhelpers.GetListAdapter[*ses.DescribeConfigurationSetOutput, SESClient, *ses.Options]{
+		ItemType:        "ses-configuration-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sesConfigurationSetAdapterMetadata,
+		GetFunc:         sesConfigurationSetGetFunc,
+		ListFunc:        sesConfigurationSetListFunc,
+		ItemMapper:      sesConfigurationSetItemMapper,
+	}
+}
+
+var sesConfigurationSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ses-configuration-set",
+	DescriptiveName: "SES Configuration Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a configuration set by name",
+		ListDescription:   "List all configuration sets",
+		SearchDescription: "Search for configuration sets by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ses_configuration_set.name"},
+	},
+	PotentialLinks: []string{"sns-topic", "firehose-delivery-stream", "iam-role", "cloudwatch-alarm", "dns"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
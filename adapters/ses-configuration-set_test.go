@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSESConfigurationSetItemMapper(t *testing.T) {
+	output := &ses.DescribeConfigurationSetOutput{
+		ConfigurationSet: &types.ConfigurationSet{
+			Name: adapterhelpers.PtrString("transactional"),
+		},
+		DeliveryOptions: &types.DeliveryOptions{
+			TlsPolicy: types.TlsPolicyRequire,
+		},
+		ReputationOptions: &types.ReputationOptions{
+			ReputationMetricsEnabled: true,
+			SendingEnabled:           false,
+		},
+		TrackingOptions: &types.TrackingOptions{
+			CustomRedirectDomain: adapterhelpers.PtrString("track.example.com"),
+		},
+		EventDestinations: []types.EventDestination{
+			{
+				Name:               adapterhelpers.PtrString("bounces"),
+				Enabled:            true,
+				MatchingEventTypes: []types.EventType{types.EventTypeBounce, types.EventTypeComplaint},
+				SNSDestination: &types.SNSDestination{
+					TopicARN: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:ses-bounces"),
+				},
+			},
+			{
+				Name:               adapterhelpers.PtrString("archive"),
+				Enabled:            true,
+				MatchingEventTypes: []types.EventType{types.EventTypeSend},
+				KinesisFirehoseDestination: &types.KinesisFirehoseDestination{
+					DeliveryStreamARN: adapterhelpers.PtrString("arn:aws:firehose:eu-west-2:123456789012:deliverystream/ses-events"),
+					IAMRoleARN:        adapterhelpers.PtrString("arn:aws:iam::123456789012:role/ses-firehose"),
+				},
+			},
+			{
+				Name:               adapterhelpers.PtrString("metrics"),
+				Enabled:            true,
+				MatchingEventTypes: []types.EventType{types.EventTypeDelivery},
+				CloudWatchDestination: &types.CloudWatchDestination{
+					DimensionConfigurations: []types.CloudWatchDimensionConfiguration{
+						{
+							DimensionName:         adapterhelpers.PtrString("ses:configuration-set"),
+							DimensionValueSource:  types.DimensionValueSourceMessageTag,
+							DefaultDimensionValue: adapterhelpers.PtrString("transactional"),
+						},
+					},
+				},
+			},
+			{
+				Name:               adapterhelpers.PtrString("disabled"),
+				Enabled:            false,
+				MatchingEventTypes: []types.EventType{types.EventTypeOpen},
+				SNSDestination: &types.SNSDestination{
+					TopicARN: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:ses-opens"),
+				},
+			},
+		},
+	}
+
+	item, err := sesConfigurationSetItemMapper("", "123456789012.eu-west-2", output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	// The disabled destination shouldn't be linked
+	if len(item.GetLinkedItemQueries()) != 5 {
+		t.Errorf("expected 5 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:ses-bounces",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "firehose-delivery-stream",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:firehose:eu-west-2:123456789012:deliverystream/ses-events",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/ses-firehose",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  `{"MetricName":"Delivery","Namespace":"AWS/SES","Dimensions":[{"Name":"ses:configuration-set","Value":"transactional"}],"ExtendedStatistic":null,"Period":null,"Statistic":"","Unit":""}`,
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "track.example.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSESConfigurationSetAdapter(t *testing.T) {
+	client, account, region := sesGetAutoConfig(t)
+
+	adapter := NewSESConfigurationSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
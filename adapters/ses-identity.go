@@ -0,0 +1,296 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// SESIdentityDetails Combines the attributes of an identity, which SES
+// returns from separate calls. None of the embedded structs share field names
+// so they flatten into a single set of attributes
+type SESIdentityDetails struct {
+	Identity     string
+	IdentityType types.IdentityType
+
+	*types.IdentityVerificationAttributes
+	*types.IdentityDkimAttributes
+	*types.IdentityNotificationAttributes
+	*types.IdentityMailFromDomainAttributes
+}
+
+// sesIdentityDetails Gets the details of up to 100 identities. Identities that
+// SES doesn't know about are not returned
+func sesIdentityDetails(ctx context.Context, client SESClient, identities []string) ([]*SESIdentityDetails, error) {
+	verification, err := client.GetIdentityVerificationAttributes(ctx, &ses.GetIdentityVerificationAttributesInput{
+		Identities: identities,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	dkim, err := client.GetIdentityDkimAttributes(ctx, &ses.GetIdentityDkimAttributesInput{
+		Identities: identities,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	notification, err := client.GetIdentityNotificationAttributes(ctx, &ses.GetIdentityNotificationAttributesInput{
+		Identities: identities,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	mailFrom, err := client.GetIdentityMailFromDomainAttributes(ctx, &ses.GetIdentityMailFromDomainAttributesInput{
+		Identities: identities,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := make([]*SESIdentityDetails, 0, len(identities))
+
+	for _, identity := range identities {
+		verificationAttributes, ok := verification.VerificationAttributes[identity]
+
+		if !ok {
+			continue
+		}
+
+		identityType := types.IdentityTypeDomain
+		if strings.Contains(identity, "@") {
+			identityType = types.IdentityTypeEmailAddress
+		}
+
+		d := SESIdentityDetails{
+			Identity:                       identity,
+			IdentityType:                   identityType,
+			IdentityVerificationAttributes: &verificationAttributes,
+		}
+
+		if attributes, ok := dkim.DkimAttributes[identity]; ok {
+			d.IdentityDkimAttributes = &attributes
+		}
+
+		if attributes, ok := notification.NotificationAttributes[identity]; ok {
+			d.IdentityNotificationAttributes = &attributes
+		}
+
+		if attributes, ok := mailFrom.MailFromDomainAttributes[identity]; ok {
+			d.IdentityMailFromDomainAttributes = &attributes
+		}
+
+		details = append(details, &d)
+	}
+
+	return details, nil
+}
+
+func sesIdentityGetFunc(ctx context.Context, client SESClient, scope, query string) (*SESIdentityDetails, error) {
+	details, err := sesIdentityDetails(ctx, client, []string{query})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(details) != 1 {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "identity not found",
+		}
+	}
+
+	return details[0], nil
+}
+
+func sesIdentityListFunc(ctx context.Context, client SESClient, scope string) ([]*SESIdentityDetails, error) {
+	// The attribute calls accept at most 100 identities, so we make sure that
+	// pages are no larger than that
+	paginator := ses.NewListIdentitiesPaginator(client, &ses.ListIdentitiesInput{
+		MaxItems: adapterhelpers.PtrInt32(100),
+	})
+
+	identities := make([]*SESIdentityDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(out.Identities) == 0 {
+			continue
+		}
+
+		details, err := sesIdentityDetails(ctx, client, out.Identities)
+
+		if err != nil {
+			return nil, err
+		}
+
+		identities = append(identities, details...)
+	}
+
+	return identities, nil
+}
+
+func sesIdentityItemMapper(_, scope string, awsItem *SESIdentityDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ses-identity",
+		UniqueAttribute: "Identity",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Mail can't be sent until the identity is verified. Once it is, DKIM and
+	// custom MAIL FROM failures affect deliverability but don't stop sending
+	var verificationStatus types.VerificationStatus
+	if awsItem.IdentityVerificationAttributes != nil {
+		verificationStatus = awsItem.IdentityVerificationAttributes.VerificationStatus
+	}
+
+	switch verificationStatus {
+	case types.VerificationStatusFailed, types.VerificationStatusTemporaryFailure:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	case types.VerificationStatusPending, types.VerificationStatusNotStarted:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.VerificationStatusSuccess:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+
+		if dkim := awsItem.IdentityDkimAttributes; dkim != nil && dkim.DkimEnabled {
+			switch dkim.DkimVerificationStatus {
+			case types.VerificationStatusFailed, types.VerificationStatusTemporaryFailure:
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			case types.VerificationStatusPending:
+				item.Health = sdp.Health_HEALTH_PENDING.Enum()
+			}
+		}
+
+		if mailFrom := awsItem.IdentityMailFromDomainAttributes; mailFrom != nil && mailFrom.MailFromDomain != nil {
+			switch mailFrom.MailFromDomainStatus {
+			case types.CustomMailFromStatusFailed, types.CustomMailFromStatusTemporaryFailure:
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			}
+		}
+	}
+
+	domain := awsItem.Identity
+	if awsItem.IdentityType == types.IdentityTypeEmailAddress {
+		_, domain, _ = strings.Cut(awsItem.Identity, "@")
+	}
+
+	if domain != "" {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  domain,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Domains are verified using DNS records, and mail is
+				// received using the domain's MX records
+				In: true,
+				// The identity can't affect DNS
+				Out: false,
+			},
+		})
+	}
+
+	if dkim := awsItem.IdentityDkimAttributes; dkim != nil && awsItem.IdentityType == types.IdentityTypeDomain {
+		// Each token is published as a CNAME record under the domain
+		for _, token := range dkim.DkimTokens {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  token + "._domainkey." + domain,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Removing the record causes DKIM verification to fail
+					In: true,
+					// The identity can't affect DNS
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if mailFrom := awsItem.IdentityMailFromDomainAttributes; mailFrom != nil && mailFrom.MailFromDomain != nil && *mailFrom.MailFromDomain != "" {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *mailFrom.MailFromDomain,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The MAIL FROM domain needs MX and SPF records
+				In: true,
+				// The identity can't affect DNS
+				Out: false,
+			},
+		})
+	}
+
+	if notification := awsItem.IdentityNotificationAttributes; notification != nil {
+		for _, topic := range []*string{notification.BounceTopic, notification.ComplaintTopic, notification.DeliveryTopic} {
+			if link := sesTopicLink(topic); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSESIdentityAdapter(client SESClient, accountID string, region string) *adapterhelpers.GetListAdapter[*SESIdentityDetails, SESClient, *ses.Options] {
+	return &adapterhelpers.GetListAdapter[*SESIdentityDetails, SESClient, *ses.Options]{
+		ItemType:        "ses-identity",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sesIdentityAdapterMetadata,
+		GetFunc:         sesIdentityGetFunc,
+		ListFunc:        sesIdentityListFunc,
+		ItemMapper:      sesIdentityItemMapper,
+	}
+}
+
+var sesIdentityAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ses-identity",
+	DescriptiveName: "SES Identity",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an identity by domain or email address",
+		ListDescription:   "List all identities",
+		SearchDescription: "Search for identities by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ses_domain_identity.domain"},
+		{TerraformQueryMap: "aws_ses_email_identity.email"},
+	},
+	PotentialLinks: []string{"dns", "sns-topic"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
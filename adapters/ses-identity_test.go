@@ -0,0 +1,168 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSESIdentityGetFunc(t *testing.T) {
+	client := SESTestClient{
+		GetIdentityVerificationAttributesOutput: &ses.GetIdentityVerificationAttributesOutput{
+			VerificationAttributes: map[string]types.IdentityVerificationAttributes{
+				"example.com": {
+					VerificationStatus: types.VerificationStatusSuccess,
+					VerificationToken:  adapterhelpers.PtrString("pmBGN/7MjnfhTKUZ06Enqq1PeGUaOkw8lGhcfwefcHU="),
+				},
+			},
+		},
+		GetIdentityDkimAttributesOutput: &ses.GetIdentityDkimAttributesOutput{
+			DkimAttributes: map[string]types.IdentityDkimAttributes{
+				"example.com": {
+					DkimEnabled:            true,
+					DkimVerificationStatus: types.VerificationStatusPending,
+					DkimTokens:             []string{"vvjuipp74whm76gqoni7qmwwn4w4qusjiainivf6f"},
+				},
+			},
+		},
+		GetIdentityNotificationAttributesOutput: &ses.GetIdentityNotificationAttributesOutput{
+			NotificationAttributes: map[string]types.IdentityNotificationAttributes{
+				"example.com": {
+					BounceTopic:       adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:ses-bounces"),
+					ComplaintTopic:    adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:ses-complaints"),
+					ForwardingEnabled: true,
+				},
+			},
+		},
+		GetIdentityMailFromDomainAttributesOutput: &ses.GetIdentityMailFromDomainAttributesOutput{
+			MailFromDomainAttributes: map[string]types.IdentityMailFromDomainAttributes{
+				"example.com": {
+					BehaviorOnMXFailure:  types.BehaviorOnMXFailureUseDefaultValue,
+					MailFromDomain:       adapterhelpers.PtrString("mail.example.com"),
+					MailFromDomainStatus: types.CustomMailFromStatusSuccess,
+				},
+			},
+		},
+	}
+
+	identity, err := sesIdentityGetFunc(context.Background(), client, "123456789012.eu-west-2", "example.com")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := sesIdentityItemMapper("", "123456789012.eu-west-2", identity)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	// DKIM is still being verified
+	if item.GetHealth() != sdp.Health_HEALTH_PENDING {
+		t.Errorf("expected health to be PENDING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "vvjuipp74whm76gqoni7qmwwn4w4qusjiainivf6f._domainkey.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "mail.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:ses-bounces",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:ses-complaints",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSESIdentityGetFuncNotFound(t *testing.T) {
+	client := SESTestClient{
+		GetIdentityVerificationAttributesOutput:   &ses.GetIdentityVerificationAttributesOutput{},
+		GetIdentityDkimAttributesOutput:           &ses.GetIdentityDkimAttributesOutput{},
+		GetIdentityNotificationAttributesOutput:   &ses.GetIdentityNotificationAttributesOutput{},
+		GetIdentityMailFromDomainAttributesOutput: &ses.GetIdentityMailFromDomainAttributesOutput{},
+	}
+
+	_, err := sesIdentityGetFunc(context.Background(), client, "123456789012.eu-west-2", "missing.example.com")
+
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+}
+
+func TestSESIdentityItemMapperEmail(t *testing.T) {
+	identity := &SESIdentityDetails{
+		Identity:     "alerts@example.com",
+		IdentityType: types.IdentityTypeEmailAddress,
+		IdentityVerificationAttributes: &types.IdentityVerificationAttributes{
+			VerificationStatus: types.VerificationStatusFailed,
+		},
+	}
+
+	item, err := sesIdentityItemMapper("", "123456789012.eu-west-2", identity)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "example.com",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSESIdentityAdapter(t *testing.T) {
+	client, account, region := sesGetAutoConfig(t)
+
+	adapter := NewSESIdentityAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
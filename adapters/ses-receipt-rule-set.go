@@ -0,0 +1,219 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func sesReceiptRuleSetGetFunc(ctx context.Context, client SESClient, scope, query string) (*ses.DescribeReceiptRuleSetOutput, error) {
+	return client.DescribeReceiptRuleSet(ctx, &ses.DescribeReceiptRuleSetInput{
+		RuleSetName: &query,
+	})
+}
+
+// sesReceiptRuleSetListFunc Lists all receipt rule sets. The list doesn't
+// include the rules, and the SDK doesn't provide a paginator for this so we
+// follow the token ourselves
+func sesReceiptRuleSetListFunc(ctx context.Context, client SESClient, scope string) ([]*ses.DescribeReceiptRuleSetOutput, error) {
+	input := &ses.ListReceiptRuleSetsInput{}
+
+	ruleSets := make([]*ses.DescribeReceiptRuleSetOutput, 0)
+
+	for {
+		out, err := client.ListReceiptRuleSets(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ruleSet := range out.RuleSets {
+			if ruleSet.Name == nil {
+				continue
+			}
+
+			details, err := sesReceiptRuleSetGetFunc(ctx, client, scope, *ruleSet.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			ruleSets = append(ruleSets, details)
+		}
+
+		if out.NextToken == nil || *out.NextToken == "" {
+			break
+		}
+
+		input.NextToken = out.NextToken
+	}
+
+	return ruleSets, nil
+}
+
+// sesReceiptActionLinks Links to the resources that a receipt rule's action
+// delivers mail or notifications to
+func sesReceiptActionLinks(action types.ReceiptAction, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+	topics := make([]*string, 0)
+
+	if s3 := action.S3Action; s3 != nil {
+		if s3.BucketName != nil {
+			accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+			links = append(links, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "s3-bucket",
+					Method: sdp.QueryMethod_GET,
+					Query:  *s3.BucketName,
+					Scope:  adapterhelpers.FormatScope(accountID, ""),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Mail can't be stored if the bucket is removed or its
+					// policy changes
+					In: true,
+					// Received mail is written to the bucket
+					Out: true,
+				},
+			})
+		}
+
+		if s3.KmsKeyArn != nil {
+			links = append(links, kmsKeyLink(*s3.KmsKeyArn, scope))
+		}
+
+		if s3.IamRoleArn != nil {
+			links = append(links, iamRoleLink(*s3.IamRoleArn, scope))
+		}
+
+		topics = append(topics, s3.TopicArn)
+	}
+
+	if lambda := action.LambdaAction; lambda != nil {
+		if lambda.FunctionArn != nil {
+			if a, err := adapterhelpers.ParseARN(*lambda.FunctionArn); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *lambda.FunctionArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Synchronous invocations can stop later rules from
+						// running
+						In: lambda.InvocationType == types.InvocationTypeRequestResponse,
+						// The function is invoked for each message
+						Out: true,
+					},
+				})
+			}
+		}
+
+		topics = append(topics, lambda.TopicArn)
+	}
+
+	if action.SNSAction != nil {
+		topics = append(topics, action.SNSAction.TopicArn)
+	}
+
+	if action.BounceAction != nil {
+		topics = append(topics, action.BounceAction.TopicArn)
+	}
+
+	if action.StopAction != nil {
+		topics = append(topics, action.StopAction.TopicArn)
+	}
+
+	if action.WorkmailAction != nil {
+		topics = append(topics, action.WorkmailAction.TopicArn)
+	}
+
+	if action.ConnectAction != nil && action.ConnectAction.IAMRoleARN != nil {
+		links = append(links, iamRoleLink(*action.ConnectAction.IAMRoleARN, scope))
+	}
+
+	for _, topic := range topics {
+		if link := sesTopicLink(topic); link != nil {
+			links = append(links, link)
+		}
+	}
+
+	return links
+}
+
+func sesReceiptRuleSetItemMapper(_, scope string, awsItem *ses.DescribeReceiptRuleSetOutput) (*sdp.Item, error) {
+	if awsItem.Metadata == nil || awsItem.Metadata.Name == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "receipt rule set metadata was nil",
+		}
+	}
+
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*types.ReceiptRuleSetMetadata
+		Rules []types.ReceiptRule
+	}{
+		ReceiptRuleSetMetadata: awsItem.Metadata,
+		Rules:                  awsItem.Rules,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ses-receipt-rule-set",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	for _, rule := range awsItem.Rules {
+		if !rule.Enabled {
+			continue
+		}
+
+		for _, action := range rule.Actions {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, sesReceiptActionLinks(action, scope)...)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSESReceiptRuleSetAdapter(client SESClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ses.DescribeReceiptRuleSetOutput, SESClient, *ses.Options] {
+	return &adapterhelpers.GetListAdapter[*ses.DescribeReceiptRuleSetOutput, SESClient, *ses.Options]{
+		ItemType:        "ses-receipt-rule-set",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: sesReceiptRuleSetAdapterMetadata,
+		GetFunc:         sesReceiptRuleSetGetFunc,
+		ListFunc:        sesReceiptRuleSetListFunc,
+		ItemMapper:      sesReceiptRuleSetItemMapper,
+	}
+}
+
+var sesReceiptRuleSetAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ses-receipt-rule-set",
+	DescriptiveName: "SES Receipt Rule Set",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a receipt rule set by name",
+		ListDescription:   "List all receipt rule sets",
+		SearchDescription: "Search for receipt rule sets by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ses_receipt_rule_set.rule_set_name"},
+		{TerraformQueryMap: "aws_ses_receipt_rule.rule_set_name"},
+	},
+	PotentialLinks: []string{"s3-bucket", "kms-key", "iam-role", "lambda-function", "sns-topic"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
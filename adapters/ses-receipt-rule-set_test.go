@@ -0,0 +1,132 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/aws/aws-sdk-go-v2/service/ses/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSESReceiptRuleSetItemMapper(t *testing.T) {
+	output := &ses.DescribeReceiptRuleSetOutput{
+		Metadata: &types.ReceiptRuleSetMetadata{
+			Name:             adapterhelpers.PtrString("inbound"),
+			CreatedTimestamp: adapterhelpers.PtrTime(time.Now()),
+		},
+		Rules: []types.ReceiptRule{
+			{
+				Name:        adapterhelpers.PtrString("store-and-process"),
+				Enabled:     true,
+				Recipients:  []string{"support@example.com"},
+				ScanEnabled: true,
+				TlsPolicy:   types.TlsPolicyRequire,
+				Actions: []types.ReceiptAction{
+					{
+						S3Action: &types.S3Action{
+							BucketName:      adapterhelpers.PtrString("inbound-mail"),
+							ObjectKeyPrefix: adapterhelpers.PtrString("support/"),
+							KmsKeyArn:       adapterhelpers.PtrString("arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab"),
+							TopicArn:        adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:inbound-stored"),
+						},
+					},
+					{
+						LambdaAction: &types.LambdaAction{
+							FunctionArn:    adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:process-support"),
+							InvocationType: types.InvocationTypeRequestResponse,
+						},
+					},
+				},
+			},
+			{
+				Name:    adapterhelpers.PtrString("notify"),
+				Enabled: true,
+				Actions: []types.ReceiptAction{
+					{
+						SNSAction: &types.SNSAction{
+							TopicArn: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:inbound"),
+							Encoding: types.SNSActionEncodingUtf8,
+						},
+					},
+				},
+			},
+			{
+				Name:    adapterhelpers.PtrString("disabled"),
+				Enabled: false,
+				Actions: []types.ReceiptAction{
+					{
+						SNSAction: &types.SNSAction{
+							TopicArn: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:unused"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	item, err := sesReceiptRuleSetItemMapper("", "123456789012.eu-west-2", output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	// Actions in the disabled rule shouldn't be linked
+	if len(item.GetLinkedItemQueries()) != 5 {
+		t.Errorf("expected 5 linked item queries, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "inbound-mail",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "kms-key",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:kms:eu-west-2:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:inbound-stored",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:process-support",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:inbound",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSESReceiptRuleSetAdapter(t *testing.T) {
+	client, account, region := sesGetAutoConfig(t)
+
+	adapter := NewSESReceiptRuleSetAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,53 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type SESClient interface {
+	GetIdentityVerificationAttributes(ctx context.Context, params *ses.GetIdentityVerificationAttributesInput, optFns ...func(*ses.Options)) (*ses.GetIdentityVerificationAttributesOutput, error)
+	GetIdentityDkimAttributes(ctx context.Context, params *ses.GetIdentityDkimAttributesInput, optFns ...func(*ses.Options)) (*ses.GetIdentityDkimAttributesOutput, error)
+	GetIdentityNotificationAttributes(ctx context.Context, params *ses.GetIdentityNotificationAttributesInput, optFns ...func(*ses.Options)) (*ses.GetIdentityNotificationAttributesOutput, error)
+	GetIdentityMailFromDomainAttributes(ctx context.Context, params *ses.GetIdentityMailFromDomainAttributesInput, optFns ...func(*ses.Options)) (*ses.GetIdentityMailFromDomainAttributesOutput, error)
+	DescribeConfigurationSet(ctx context.Context, params *ses.DescribeConfigurationSetInput, optFns ...func(*ses.Options)) (*ses.DescribeConfigurationSetOutput, error)
+	ListConfigurationSets(ctx context.Context, params *ses.ListConfigurationSetsInput, optFns ...func(*ses.Options)) (*ses.ListConfigurationSetsOutput, error)
+	DescribeReceiptRuleSet(ctx context.Context, params *ses.DescribeReceiptRuleSetInput, optFns ...func(*ses.Options)) (*ses.DescribeReceiptRuleSetOutput, error)
+	ListReceiptRuleSets(ctx context.Context, params *ses.ListReceiptRuleSetsInput, optFns ...func(*ses.Options)) (*ses.ListReceiptRuleSetsOutput, error)
+
+	ses.ListIdentitiesAPIClient
+}
+
+// sesTopicLink Links to an SNS topic that SES publishes notifications to.
+// Returns nil if the ARN can't be parsed
+func sesTopicLink(topicArn *string) *sdp.LinkedItemQuery {
+	if topicArn == nil {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(*topicArn)
+
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "sns-topic",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *topicArn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Removing the topic only means that notifications are lost, SES
+			// still sends and receives mail
+			In: false,
+			// SES publishes notifications to the topic
+			Out: true,
+		},
+	}
+}
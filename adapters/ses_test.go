@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ses"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type SESTestClient struct {
+	GetIdentityVerificationAttributesOutput   *ses.GetIdentityVerificationAttributesOutput
+	GetIdentityDkimAttributesOutput           *ses.GetIdentityDkimAttributesOutput
+	GetIdentityNotificationAttributesOutput   *ses.GetIdentityNotificationAttributesOutput
+	GetIdentityMailFromDomainAttributesOutput *ses.GetIdentityMailFromDomainAttributesOutput
+	DescribeConfigurationSetOutput            *ses.DescribeConfigurationSetOutput
+	ListConfigurationSetsOutput               *ses.ListConfigurationSetsOutput
+	DescribeReceiptRuleSetOutput              *ses.DescribeReceiptRuleSetOutput
+	ListReceiptRuleSetsOutput                 *ses.ListReceiptRuleSetsOutput
+	ListIdentitiesOutput                      *ses.ListIdentitiesOutput
+}
+
+func (t SESTestClient) GetIdentityVerificationAttributes(context.Context, *ses.GetIdentityVerificationAttributesInput, ...func(*ses.Options)) (*ses.GetIdentityVerificationAttributesOutput, error) {
+	return t.GetIdentityVerificationAttributesOutput, nil
+}
+
+func (t SESTestClient) GetIdentityDkimAttributes(context.Context, *ses.GetIdentityDkimAttributesInput, ...func(*ses.Options)) (*ses.GetIdentityDkimAttributesOutput, error) {
+	return t.GetIdentityDkimAttributesOutput, nil
+}
+
+func (t SESTestClient) GetIdentityNotificationAttributes(context.Context, *ses.GetIdentityNotificationAttributesInput, ...func(*ses.Options)) (*ses.GetIdentityNotificationAttributesOutput, error) {
+	return t.GetIdentityNotificationAttributesOutput, nil
+}
+
+func (t SESTestClient) GetIdentityMailFromDomainAttributes(context.Context, *ses.GetIdentityMailFromDomainAttributesInput, ...func(*ses.Options)) (*ses.GetIdentityMailFromDomainAttributesOutput, error) {
+	return t.GetIdentityMailFromDomainAttributesOutput, nil
+}
+
+func (t SESTestClient) DescribeConfigurationSet(context.Context, *ses.DescribeConfigurationSetInput, ...func(*ses.Options)) (*ses.DescribeConfigurationSetOutput, error) {
+	return t.DescribeConfigurationSetOutput, nil
+}
+
+func (t SESTestClient) ListConfigurationSets(context.Context, *ses.ListConfigurationSetsInput, ...func(*ses.Options)) (*ses.ListConfigurationSetsOutput, error) {
+	return t.ListConfigurationSetsOutput, nil
+}
+
+func (t SESTestClient) DescribeReceiptRuleSet(context.Context, *ses.DescribeReceiptRuleSetInput, ...func(*ses.Options)) (*ses.DescribeReceiptRuleSetOutput, error) {
+	return t.DescribeReceiptRuleSetOutput, nil
+}
+
+func (t SESTestClient) ListReceiptRuleSets(context.Context, *ses.ListReceiptRuleSetsInput, ...func(*ses.Options)) (*ses.ListReceiptRuleSetsOutput, error) {
+	return t.ListReceiptRuleSetsOutput, nil
+}
+
+func (t SESTestClient) ListIdentities(context.Context, *ses.ListIdentitiesInput, ...func(*ses.Options)) (*ses.ListIdentitiesOutput, error) {
+	return t.ListIdentitiesOutput, nil
+}
+
+func sesGetAutoConfig(t *testing.T) (*ses.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ses.NewFromConfig(config)
+
+	return client, account, region
+}
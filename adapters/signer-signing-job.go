@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func signerSigningJobGetFunc(ctx context.Context, client SignerClient, scope, query string) (*signer.DescribeSigningJobOutput, error) {
+	return client.DescribeSigningJob(ctx, &signer.DescribeSigningJobInput{
+		JobId: &query,
+	})
+}
+
+func signerSigningJobListFunc(ctx context.Context, client SignerClient, scope string) ([]*signer.DescribeSigningJobOutput, error) {
+	paginator := signer.NewListSigningJobsPaginator(client, &signer.ListSigningJobsInput{})
+
+	jobs := make([]*signer.DescribeSigningJobOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the revocation record or status reason
+		for _, summary := range out.Jobs {
+			if summary.JobId == nil {
+				continue
+			}
+
+			job, err := signerSigningJobGetFunc(ctx, client, scope, *summary.JobId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			jobs = append(jobs, job)
+		}
+	}
+
+	return jobs, nil
+}
+
+// signerSigningJobSearchFunc Searches for a job by ARN. These are in the format
+// arn:aws:signer:{region}:{account}:/signing-jobs/{jobId}
+func signerSigningJobSearchFunc(ctx context.Context, client SignerClient, scope, query string) ([]*signer.DescribeSigningJobOutput, error) {
+	sections, err := signerResourceSections(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+		}
+	}
+
+	if len(sections) != 2 || sections[0] != "signing-jobs" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "ARN is not a Signer signing job",
+		}
+	}
+
+	job, err := signerSigningJobGetFunc(ctx, client, scope, sections[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*signer.DescribeSigningJobOutput{job}, nil
+}
+
+func signerSigningJobItemMapper(_, scope string, awsItem *signer.DescribeSigningJobOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "signer-signing-job",
+		UniqueAttribute: "JobId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.SigningStatusSucceeded:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.SigningStatusInProgress:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.SigningStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.RevocationRecord != nil {
+		// The signature is no longer trusted, so deployments that check it
+		// will fail
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.ProfileName != nil {
+		// Jobs can only use profiles in the same account
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "signer-signing-profile",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.ProfileName,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Revoking the profile invalidates the job's signature
+				In: true,
+				// The job can't affect the profile
+				Out: false,
+			},
+		})
+	}
+
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	if awsItem.Source != nil && awsItem.Source.S3 != nil && awsItem.Source.S3.BucketName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.Source.S3.BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The unsigned code is read from this bucket
+				In: true,
+				// Signing doesn't change the source object
+				Out: false,
+			},
+		})
+	}
+
+	if awsItem.SignedObject != nil && awsItem.SignedObject.S3 != nil && awsItem.SignedObject.S3.BucketName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.SignedObject.S3.BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Signed code is lost if the bucket is removed
+				In: true,
+				// The signed code is written to this bucket
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.SigningMaterial != nil {
+		if link := signerCertificateLink(awsItem.SigningMaterial.CertificateArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSignerSigningJobAdapter(client SignerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*signer.DescribeSigningJobOutput, SignerClient, *signer.Options] {
+	return &adapterhelpers.GetListAdapter[*signer.DescribeSigningJobOutput, SignerClient, *signer.Options]{
+		ItemType:        "signer-signing-job",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: signerSigningJobAdapterMetadata,
+		GetFunc:         signerSigningJobGetFunc,
+		ListFunc:        signerSigningJobListFunc,
+		SearchFunc:      signerSigningJobSearchFunc,
+		ItemMapper:      signerSigningJobItemMapper,
+	}
+}
+
+var signerSigningJobAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "signer-signing-job",
+	DescriptiveName: "Signer Signing Job",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a signing job by ID",
+		ListDescription:   "List all signing jobs",
+		SearchDescription: "Search for signing jobs by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_signer_signing_job.job_id"},
+	},
+	PotentialLinks: []string{"signer-signing-profile", "s3-bucket", "acm-certificate"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,136 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testSigningJob = &signer.DescribeSigningJobOutput{
+	JobId:          adapterhelpers.PtrString("2a9f3e1c-6b7d-4e8f-9a0b-1c2d3e4f5a6b"),
+	JobOwner:       adapterhelpers.PtrString("123456789012"),
+	PlatformId:     adapterhelpers.PtrString("AWSLambda-SHA384-ECDSA"),
+	ProfileName:    adapterhelpers.PtrString("ReleaseSigning"),
+	ProfileVersion: adapterhelpers.PtrString("Ab12Cd34Ef"),
+	SignedObject: &types.SignedObject{
+		S3: &types.S3SignedObject{
+			BucketName: adapterhelpers.PtrString("release-artifacts-signed"),
+			Key:        adapterhelpers.PtrString("signed/process-orders.zip"),
+		},
+	},
+	SigningMaterial: &types.SigningMaterial{
+		CertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/8f2a6c1e-3b4d-4e5f-a6b7-c8d9e0f1a2b3"),
+	},
+	Source: &types.Source{
+		S3: &types.S3Source{
+			BucketName: adapterhelpers.PtrString("release-artifacts"),
+			Key:        adapterhelpers.PtrString("process-orders.zip"),
+			Version:    adapterhelpers.PtrString("3HL4kqtJlcpXroDTDmJ.rmSpXd3dIbrHY"),
+		},
+	},
+	Status: types.SigningStatusSucceeded,
+}
+
+func TestSignerSigningJobItemMapper(t *testing.T) {
+	item, err := signerSigningJobItemMapper("", "123456789012.eu-west-2", testSigningJob)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "signer-signing-profile",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "ReleaseSigning",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "release-artifacts",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "release-artifacts-signed",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/8f2a6c1e-3b4d-4e5f-a6b7-c8d9e0f1a2b3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSignerSigningJobItemMapperRevoked(t *testing.T) {
+	job := *testSigningJob
+	job.RevocationRecord = &types.SigningJobRevocationRecord{
+		Reason:    adapterhelpers.PtrString("Key compromised"),
+		RevokedBy: adapterhelpers.PtrString("arn:aws:iam::123456789012:user/security"),
+	}
+
+	item, err := signerSigningJobItemMapper("", "123456789012.eu-west-2", &job)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+}
+
+func TestSignerSigningJobSearchFunc(t *testing.T) {
+	client := SignerTestClient{
+		DescribeSigningJobOutput: testSigningJob,
+	}
+
+	jobs, err := signerSigningJobSearchFunc(context.Background(), client, "123456789012.eu-west-2", "arn:aws:signer:eu-west-2:123456789012:/signing-jobs/2a9f3e1c-6b7d-4e8f-9a0b-1c2d3e4f5a6b")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(jobs) != 1 {
+		t.Errorf("expected 1 job, got %v", len(jobs))
+	}
+
+	_, err = signerSigningJobSearchFunc(context.Background(), client, "123456789012.eu-west-2", "arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning")
+
+	if err == nil {
+		t.Error("expected an error for a profile ARN")
+	}
+}
+
+func TestNewSignerSigningJobAdapter(t *testing.T) {
+	client, account, region := signerGetAutoConfig(t)
+
+	adapter := NewSignerSigningJobAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
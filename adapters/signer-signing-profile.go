@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func signerSigningProfileGetFunc(ctx context.Context, client SignerClient, scope, query string) (*signer.GetSigningProfileOutput, error) {
+	return client.GetSigningProfile(ctx, &signer.GetSigningProfileInput{
+		ProfileName: &query,
+	})
+}
+
+func signerSigningProfileListFunc(ctx context.Context, client SignerClient, scope string) ([]*signer.GetSigningProfileOutput, error) {
+	// Canceled profiles are still returned by GetSigningProfile and can still
+	// be referenced by code that was signed before they were canceled
+	paginator := signer.NewListSigningProfilesPaginator(client, &signer.ListSigningProfilesInput{
+		IncludeCanceled: true,
+	})
+
+	profiles := make([]*signer.GetSigningProfileOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the revocation record or status reason
+		for _, summary := range out.Profiles {
+			if summary.ProfileName == nil {
+				continue
+			}
+
+			profile, err := signerSigningProfileGetFunc(ctx, client, scope, *summary.ProfileName)
+
+			if err != nil {
+				return nil, err
+			}
+
+			profiles = append(profiles, profile)
+		}
+	}
+
+	return profiles, nil
+}
+
+// signerSigningProfileSearchFunc Searches for a profile by either its ARN or
+// the ARN of one of its versions, since the version ARN is what Lambda
+// references
+func signerSigningProfileSearchFunc(ctx context.Context, client SignerClient, scope, query string) ([]*signer.GetSigningProfileOutput, error) {
+	sections, err := signerResourceSections(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+		}
+	}
+
+	if len(sections) < 2 || sections[0] != "signing-profiles" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "ARN is not a Signer signing profile",
+		}
+	}
+
+	profile, err := signerSigningProfileGetFunc(ctx, client, scope, sections[1])
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*signer.GetSigningProfileOutput{profile}, nil
+}
+
+func signerSigningProfileItemMapper(_, scope string, awsItem *signer.GetSigningProfileOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "Tags", "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "signer-signing-profile",
+		UniqueAttribute: "ProfileName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            awsItem.Tags,
+	}
+
+	switch awsItem.Status {
+	case types.SigningProfileStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.SigningProfileStatusCanceled:
+		// Existing signatures are still valid, but nothing new can be signed
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.SigningProfileStatusRevoked:
+		// Signatures made after the revocation date are no longer trusted
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.SigningMaterial != nil {
+		if link := signerCertificateLink(awsItem.SigningMaterial.CertificateArn); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSignerSigningProfileAdapter(client SignerClient, accountID string, region string) *adapterhelpers.GetListAdapter[*signer.GetSigningProfileOutput, SignerClient, *signer.Options] {
+	return &adapterhelpers.GetListAdapter[*signer.GetSigningProfileOutput, SignerClient, *signer.Options]{
+		ItemType:        "signer-signing-profile",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: signerSigningProfileAdapterMetadata,
+		GetFunc:         signerSigningProfileGetFunc,
+		ListFunc:        signerSigningProfileListFunc,
+		SearchFunc:      signerSigningProfileSearchFunc,
+		ItemMapper:      signerSigningProfileItemMapper,
+	}
+}
+
+var signerSigningProfileAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "signer-signing-profile",
+	DescriptiveName: "Signer Signing Profile",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a signing profile by name",
+		ListDescription:   "List all signing profiles",
+		SearchDescription: "Search for signing profiles by profile or profile version ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_signer_signing_profile.name"},
+	},
+	PotentialLinks: []string{"acm-certificate"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
@@ -0,0 +1,124 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/aws/aws-sdk-go-v2/service/signer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testSigningProfile = &signer.GetSigningProfileOutput{
+	Arn:               adapterhelpers.PtrString("arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning"),
+	PlatformId:        adapterhelpers.PtrString("AWSLambda-SHA384-ECDSA"),
+	ProfileName:       adapterhelpers.PtrString("ReleaseSigning"),
+	ProfileVersion:    adapterhelpers.PtrString("Ab12Cd34Ef"),
+	ProfileVersionArn: adapterhelpers.PtrString("arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning/Ab12Cd34Ef"),
+	SignatureValidityPeriod: &types.SignatureValidityPeriod{
+		Type:  types.ValidityTypeMonths,
+		Value: 135,
+	},
+	SigningMaterial: &types.SigningMaterial{
+		CertificateArn: adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/8f2a6c1e-3b4d-4e5f-a6b7-c8d9e0f1a2b3"),
+	},
+	Status: types.SigningProfileStatusActive,
+	Tags: map[string]string{
+		"team": "platform",
+	},
+}
+
+func TestSignerSigningProfileItemMapper(t *testing.T) {
+	item, err := signerSigningProfileItemMapper("", "123456789012.eu-west-2", testSigningProfile)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected tag team=platform, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/8f2a6c1e-3b4d-4e5f-a6b7-c8d9e0f1a2b3",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSignerSigningProfileSearchFunc(t *testing.T) {
+	client := SignerTestClient{
+		GetSigningProfileOutput: testSigningProfile,
+	}
+
+	tests := []struct {
+		Query       string
+		ExpectError bool
+	}{
+		{
+			Query: "arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning",
+		},
+		{
+			Query: "arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning/Ab12Cd34Ef",
+		},
+		{
+			Query:       "arn:aws:signer:eu-west-2:123456789012:/signing-jobs/2a9f3e1c-6b7d-4e8f-9a0b-1c2d3e4f5a6b",
+			ExpectError: true,
+		},
+		{
+			Query:       "ReleaseSigning",
+			ExpectError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.Query, func(t *testing.T) {
+			profiles, err := signerSigningProfileSearchFunc(context.Background(), client, "123456789012.eu-west-2", test.Query)
+
+			if test.ExpectError {
+				if err == nil {
+					t.Error("expected an error")
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(profiles) != 1 {
+				t.Errorf("expected 1 profile, got %v", len(profiles))
+			}
+		})
+	}
+}
+
+func TestNewSignerSigningProfileAdapter(t *testing.T) {
+	client, account, region := signerGetAutoConfig(t)
+
+	adapter := NewSignerSigningProfileAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
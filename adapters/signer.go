@@ -0,0 +1,61 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type SignerClient interface {
+	DescribeSigningJob(ctx context.Context, params *signer.DescribeSigningJobInput, optFns ...func(*signer.Options)) (*signer.DescribeSigningJobOutput, error)
+	GetSigningProfile(ctx context.Context, params *signer.GetSigningProfileInput, optFns ...func(*signer.Options)) (*signer.GetSigningProfileOutput, error)
+
+	signer.ListSigningJobsAPIClient
+	signer.ListSigningProfilesAPIClient
+}
+
+// signerResourceSections Splits the resource part of a Signer ARN into its
+// sections. Signer ARNs have a leading slash unlike most services e.g.
+// arn:aws:signer:eu-west-2:123456789012:/signing-profiles/MyProfile/Ab12Cd34Ef
+// returns ["signing-profiles", "MyProfile", "Ab12Cd34Ef"]
+func signerResourceSections(arn string) ([]string, error) {
+	a, err := adapterhelpers.ParseARN(arn)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.Split(strings.TrimPrefix(a.Resource, "/"), "/"), nil
+}
+
+// signerCertificateLink Links to the ACM certificate that is used to sign code
+func signerCertificateLink(certificateArn *string) *sdp.LinkedItemQuery {
+	if certificateArn == nil {
+		return nil
+	}
+
+	a, err := adapterhelpers.ParseARN(*certificateArn)
+
+	if err != nil {
+		return nil
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "acm-certificate",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  *certificateArn,
+			Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+		},
+		BlastPropagation: &sdp.BlastPropagation{
+			// Signatures can't be created if the certificate expires
+			In: true,
+			// Signing doesn't affect the certificate
+			Out: false,
+		},
+	}
+}
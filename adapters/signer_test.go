@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/signer"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type SignerTestClient struct {
+	DescribeSigningJobOutput  *signer.DescribeSigningJobOutput
+	GetSigningProfileOutput   *signer.GetSigningProfileOutput
+	ListSigningJobsOutput     *signer.ListSigningJobsOutput
+	ListSigningProfilesOutput *signer.ListSigningProfilesOutput
+}
+
+func (t SignerTestClient) DescribeSigningJob(context.Context, *signer.DescribeSigningJobInput, ...func(*signer.Options)) (*signer.DescribeSigningJobOutput, error) {
+	return t.DescribeSigningJobOutput, nil
+}
+
+func (t SignerTestClient) GetSigningProfile(context.Context, *signer.GetSigningProfileInput, ...func(*signer.Options)) (*signer.GetSigningProfileOutput, error) {
+	return t.GetSigningProfileOutput, nil
+}
+
+func (t SignerTestClient) ListSigningJobs(context.Context, *signer.ListSigningJobsInput, ...func(*signer.Options)) (*signer.ListSigningJobsOutput, error) {
+	return t.ListSigningJobsOutput, nil
+}
+
+func (t SignerTestClient) ListSigningProfiles(context.Context, *signer.ListSigningProfilesInput, ...func(*signer.Options)) (*signer.ListSigningProfilesOutput, error) {
+	return t.ListSigningProfilesOutput, nil
+}
+
+func signerGetAutoConfig(t *testing.T) (*signer.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := signer.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestSignerResourceSections(t *testing.T) {
+	tests := map[string][]string{
+		"arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning":                   {"signing-profiles", "ReleaseSigning"},
+		"arn:aws:signer:eu-west-2:123456789012:/signing-profiles/ReleaseSigning/Ab12Cd34Ef":        {"signing-profiles", "ReleaseSigning", "Ab12Cd34Ef"},
+		"arn:aws:signer:eu-west-2:123456789012:/signing-jobs/2a9f3e1c-6b7d-4e8f-9a0b-1c2d3e4f5a6b": {"signing-jobs", "2a9f3e1c-6b7d-4e8f-9a0b-1c2d3e4f5a6b"},
+	}
+
+	for arn, expected := range tests {
+		sections, err := signerResourceSections(arn)
+
+		if err != nil {
+			t.Errorf("unexpected error for %v: %v", arn, err)
+			continue
+		}
+
+		if len(sections) != len(expected) {
+			t.Errorf("expected %v for %v, got %v", expected, arn, sections)
+			continue
+		}
+
+		for i := range expected {
+			if sections[i] != expected[i] {
+				t.Errorf("expected %v for %v, got %v", expected, arn, sections)
+				break
+			}
+		}
+	}
+
+	if _, err := signerResourceSections("ReleaseSigning"); err == nil {
+		t.Error("expected an error for a non-ARN")
+	}
+}
@@ -47,7 +47,7 @@ func getFunc(ctx context.Context, client sqsClient, scope string, input *sqs.Get
 		}
 	}
 
-	return &sdp.Item{
+	item := &sdp.Item{
 		Type:            "sqs-queue",
 		UniqueAttribute: "QueueURL",
 		Attributes:      attributes,
@@ -67,7 +67,26 @@ func getFunc(ctx context.Context, client sqsClient, scope string, input *sqs.Get
 				},
 			},
 		},
-	}, nil
+	}
+
+	if queueARN, ok := output.Attributes["QueueArn"]; ok {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "lambda-event-source-mapping",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  queueARN,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Changes to the mapping change how the queue is consumed
+				In: true,
+				// Changes to the queue affect the functions that consume it
+				Out: true,
+			},
+		})
+	}
+
+	return item, nil
 }
 
 func NewSQSQueueAdapter(client sqsClient, accountID string, region string) *adapterhelpers.AlwaysGetAdapter[*sqs.ListQueuesInput, *sqs.ListQueuesOutput, *sqs.GetQueueAttributesInput, *sqs.GetQueueAttributesOutput, sqsClient, *sqs.Options] {
@@ -116,5 +135,6 @@ var sqsQueueAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
 	TerraformMappings: []*sdp.TerraformMapping{
 		{TerraformQueryMap: "aws_sqs_queue.id"},
 	},
-	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+	PotentialLinks: []string{"http", "lambda-event-source-mapping"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
 })
@@ -7,6 +7,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
 )
 
 type testClient struct{}
@@ -62,6 +63,23 @@ func TestGetFunc(t *testing.T) {
 	if err = item.Validate(); err != nil {
 		t.Error(err)
 	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "http",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "https://sqs.us-west-2.amazonaws.com/123456789012/MyQueue",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "lambda-event-source-mapping",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sqs:us-west-2:123456789012:MyQueue",
+			ExpectedScope:  "scope",
+		},
+	}
+
+	tests.Execute(t, item)
 }
 
 func TestNewQueueAdapter(t *testing.T) {
@@ -0,0 +1,210 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func ssmAssociationGetFunc(ctx context.Context, client SSMClient, scope, query string) (*types.AssociationDescription, error) {
+	out, err := client.DescribeAssociation(ctx, &ssm.DescribeAssociationInput{
+		AssociationId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.AssociationDescription == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "association description was nil",
+		}
+	}
+
+	return out.AssociationDescription, nil
+}
+
+func ssmAssociationList(ctx context.Context, client SSMClient, scope string, input *ssm.ListAssociationsInput) ([]*types.AssociationDescription, error) {
+	paginator := ssm.NewListAssociationsPaginator(client, input)
+
+	associations := make([]*types.AssociationDescription, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the output location, alarms or
+		// calendars
+		for _, summary := range out.Associations {
+			if summary.AssociationId == nil {
+				continue
+			}
+
+			association, err := ssmAssociationGetFunc(ctx, client, scope, *summary.AssociationId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			associations = append(associations, association)
+		}
+	}
+
+	return associations, nil
+}
+
+func ssmAssociationListFunc(ctx context.Context, client SSMClient, scope string) ([]*types.AssociationDescription, error) {
+	return ssmAssociationList(ctx, client, scope, &ssm.ListAssociationsInput{})
+}
+
+// ssmAssociationSearchFunc Searches for associations by ARN, or by the name of
+// the document that they run
+func ssmAssociationSearchFunc(ctx context.Context, client SSMClient, scope, query string) ([]*types.AssociationDescription, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		association, err := ssmAssociationGetFunc(ctx, client, scope, a.ResourceID())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.AssociationDescription{association}, nil
+	}
+
+	return ssmAssociationList(ctx, client, scope, &ssm.ListAssociationsInput{
+		AssociationFilterList: []types.AssociationFilter{
+			{
+				Key:   types.AssociationFilterKeyName,
+				Value: &query,
+			},
+		},
+	})
+}
+
+func ssmAssociationItemMapper(_, scope string, awsItem *types.AssociationDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssm-association",
+		UniqueAttribute: "AssociationId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	if awsItem.Overview != nil && awsItem.Overview.Status != nil {
+		switch types.AssociationStatusName(*awsItem.Overview.Status) {
+		case types.AssociationStatusNameSuccess:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+		case types.AssociationStatusNamePending:
+			item.Health = sdp.Health_HEALTH_PENDING.Enum()
+		case types.AssociationStatusNameFailed:
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		}
+	}
+
+	if awsItem.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssmDocumentLink(*awsItem.Name, scope, &sdp.BlastPropagation{
+			// The document defines what the association does
+			In: true,
+			// The association can't affect the document
+			Out: false,
+		}))
+	}
+
+	targets := make([]types.Target, 0, len(awsItem.Targets)+1)
+	targets = append(targets, awsItem.Targets...)
+
+	if awsItem.InstanceId != nil {
+		// Older associations target a single instance rather than using
+		// targets
+		targets = append(targets, types.Target{
+			Key:    adapterhelpers.PtrString("InstanceIds"),
+			Values: []string{*awsItem.InstanceId},
+		})
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, ssmTargetLinks(targets, scope, &sdp.BlastPropagation{
+		// The instances can't affect the association
+		In: false,
+		// The association changes the configuration of the instances
+		Out: true,
+	})...)
+
+	if awsItem.OutputLocation != nil && awsItem.OutputLocation.S3Location != nil && awsItem.OutputLocation.S3Location.OutputS3BucketName != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *awsItem.OutputLocation.S3Location.OutputS3BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Output can't be written if the bucket is removed
+				In: true,
+				// The association writes output to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	for _, calendar := range awsItem.CalendarNames {
+		// Change calendars are documents, and stop the association running
+		// when they are closed
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssmDocumentLink(calendar, scope, &sdp.BlastPropagation{
+			In:  true,
+			Out: false,
+		}))
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, ssmAlarmLinks(awsItem.AlarmConfiguration, scope)...)
+
+	return &item, nil
+}
+
+func NewSSMAssociationAdapter(client SSMClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.AssociationDescription, SSMClient, *ssm.Options] {
+	return &adapterhelpers.GetListAdapter[*types.AssociationDescription, SSMClient, *ssm.Options]{
+		ItemType:        "ssm-association",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmAssociationAdapterMetadata,
+		GetFunc:         ssmAssociationGetFunc,
+		ListFunc:        ssmAssociationListFunc,
+		SearchFunc:      ssmAssociationSearchFunc,
+		ItemMapper:      ssmAssociationItemMapper,
+		ListTagsFunc: func(ctx context.Context, association *types.AssociationDescription, client SSMClient) (map[string]string, error) {
+			return ssmListTags(ctx, client, types.ResourceTypeForTaggingAssociation, association.AssociationId)
+		},
+	}
+}
+
+var ssmAssociationAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-association",
+	DescriptiveName: "SSM Association",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an association by ID",
+		ListDescription:   "List all associations",
+		SearchDescription: "Search for associations by ARN, or by the name of the document that they run",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssm_association.association_id"},
+	},
+	PotentialLinks: []string{"ssm-document", "ec2-instance", "ssm-managed-instance", "s3-bucket", "cloudwatch-alarm"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
@@ -0,0 +1,144 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testSSMAssociation = &types.AssociationDescription{
+	AssociationId:   adapterhelpers.PtrString("8dfe3659-4309-493a-8755-0123456789ab"),
+	AssociationName: adapterhelpers.PtrString("patch-web-servers"),
+	Name:            adapterhelpers.PtrString("AWS-RunPatchBaseline"),
+	InstanceId:      adapterhelpers.PtrString("i-0a1b2c3d4e5f6a7b8"),
+	Targets: []types.Target{
+		{
+			Key:    adapterhelpers.PtrString("tag:Role"),
+			Values: []string{"web"},
+		},
+	},
+	Overview: &types.AssociationOverview{
+		Status: adapterhelpers.PtrString("Failed"),
+	},
+	OutputLocation: &types.InstanceAssociationOutputLocation{
+		S3Location: &types.S3OutputLocation{
+			OutputS3BucketName: adapterhelpers.PtrString("patch-logs"),
+		},
+	},
+	CalendarNames: []string{"arn:aws:ssm:eu-west-2:123456789012:document/change-freeze"},
+	AlarmConfiguration: &types.AlarmConfiguration{
+		Alarms: []types.Alarm{
+			{
+				Name: adapterhelpers.PtrString("web-5xx"),
+			},
+		},
+	},
+}
+
+func TestSSMAssociationItemMapper(t *testing.T) {
+	item, err := ssmAssociationItemMapper("", "123456789012.eu-west-2", testSSMAssociation)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	if len(testSSMAssociation.Targets) != 1 {
+		t.Errorf("expected the association's targets not to be modified, got %v", testSSMAssociation.Targets)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssm-document",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "AWS-RunPatchBaseline",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-managed-instance",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tag:Role=web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "patch-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "ssm-document",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:ssm:eu-west-2:123456789012:document/change-freeze",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web-5xx",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSSMAssociationSearchFunc(t *testing.T) {
+	client := SSMTestClient{
+		DescribeAssociationOutput: &ssm.DescribeAssociationOutput{
+			AssociationDescription: testSSMAssociation,
+		},
+		ListAssociationsOutput: &ssm.ListAssociationsOutput{
+			Associations: []types.Association{
+				{
+					AssociationId: testSSMAssociation.AssociationId,
+					Name:          testSSMAssociation.Name,
+				},
+			},
+		},
+	}
+
+	for _, query := range []string{"AWS-RunPatchBaseline", "arn:aws:ssm:eu-west-2:123456789012:association/8dfe3659-4309-493a-8755-0123456789ab"} {
+		associations, err := ssmAssociationSearchFunc(context.Background(), client, "123456789012.eu-west-2", query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(associations) != 1 {
+			t.Errorf("expected 1 association for %v, got %v", query, len(associations))
+		}
+	}
+}
+
+func TestNewSSMAssociationAdapter(t *testing.T) {
+	client, account, region := ssmGetAutoConfig(t)
+
+	adapter := NewSSMAssociationAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
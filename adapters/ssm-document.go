@@ -0,0 +1,161 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func ssmDocumentGetFunc(ctx context.Context, client SSMClient, scope, query string) (*types.DocumentDescription, error) {
+	out, err := client.DescribeDocument(ctx, &ssm.DescribeDocumentInput{
+		Name: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Document == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "document was nil",
+		}
+	}
+
+	return out.Document, nil
+}
+
+// ssmDocumentListFunc Lists the documents owned by this account. There are
+// thousands of documents owned by AWS which are only returned when something
+// links to them
+func ssmDocumentListFunc(ctx context.Context, client SSMClient, scope string) ([]*types.DocumentDescription, error) {
+	paginator := ssm.NewListDocumentsPaginator(client, &ssm.ListDocumentsInput{
+		Filters: []types.DocumentKeyValuesFilter{
+			{
+				Key:    adapterhelpers.PtrString("Owner"),
+				Values: []string{"Self"},
+			},
+		},
+	})
+
+	documents := make([]*types.DocumentDescription, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The identifiers don't include the status or parameters
+		for _, identifier := range out.DocumentIdentifiers {
+			if identifier.Name == nil {
+				continue
+			}
+
+			document, err := ssmDocumentGetFunc(ctx, client, scope, *identifier.Name)
+
+			if err != nil {
+				return nil, err
+			}
+
+			documents = append(documents, document)
+		}
+	}
+
+	return documents, nil
+}
+
+func ssmDocumentItemMapper(_, scope string, awsItem *types.DocumentDescription) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "Tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssm-document",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            ssmTagsToMap(awsItem.Tags),
+	}
+
+	switch awsItem.Status {
+	case types.DocumentStatusActive:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.DocumentStatusCreating, types.DocumentStatusUpdating, types.DocumentStatusDeleting:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.DocumentStatusFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.Name != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ssm-association",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Associations can't affect the document
+				In: false,
+				// Changing the document changes what associations do
+				Out: true,
+			},
+		})
+	}
+
+	// Documents can depend on other documents, for example AppConfig
+	// configurations that are validated by a schema document
+	for _, requires := range awsItem.Requires {
+		if requires.Name == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssmDocumentLink(*requires.Name, scope, &sdp.BlastPropagation{
+			// The document can't be used if the one it requires changes
+			In: true,
+			// The required document isn't affected by this one
+			Out: false,
+		}))
+	}
+
+	return &item, nil
+}
+
+func NewSSMDocumentAdapter(client SSMClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DocumentDescription, SSMClient, *ssm.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DocumentDescription, SSMClient, *ssm.Options]{
+		ItemType:        "ssm-document",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmDocumentAdapterMetadata,
+		GetFunc:         ssmDocumentGetFunc,
+		ListFunc:        ssmDocumentListFunc,
+		ItemMapper:      ssmDocumentItemMapper,
+	}
+}
+
+var ssmDocumentAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-document",
+	DescriptiveName: "SSM Document",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an SSM document by name",
+		ListDescription:   "List all SSM documents owned by this account",
+		SearchDescription: "Search for SSM documents by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssm_document.name"},
+	},
+	PotentialLinks: []string{"ssm-association", "ssm-document"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
@@ -0,0 +1,83 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSSMDocumentItemMapper(t *testing.T) {
+	document := &types.DocumentDescription{
+		Name:            adapterhelpers.PtrString("PatchWebServers"),
+		DocumentType:    types.DocumentTypeCommand,
+		DocumentFormat:  types.DocumentFormatYaml,
+		DocumentVersion: adapterhelpers.PtrString("3"),
+		Owner:           adapterhelpers.PtrString("123456789012"),
+		SchemaVersion:   adapterhelpers.PtrString("2.2"),
+		Status:          types.DocumentStatusActive,
+		Requires: []types.DocumentRequires{
+			{
+				Name:    adapterhelpers.PtrString("AWS-RunPatchBaseline"),
+				Version: adapterhelpers.PtrString("1"),
+			},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("team"),
+				Value: adapterhelpers.PtrString("platform"),
+			},
+		},
+	}
+
+	item, err := ssmDocumentItemMapper("", "123456789012.eu-west-2", document)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "platform" {
+		t.Errorf("expected tag team=platform, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssm-association",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "PatchWebServers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-document",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "AWS-RunPatchBaseline",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSSMDocumentAdapter(t *testing.T) {
+	client, account, region := ssmGetAutoConfig(t)
+
+	adapter := NewSSMDocumentAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
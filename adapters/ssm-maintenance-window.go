@@ -0,0 +1,239 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// SSMMaintenanceWindowDetails A maintenance window along with its registered
+// targets and tasks
+type SSMMaintenanceWindowDetails struct {
+	Window  *ssm.GetMaintenanceWindowOutput
+	Targets []types.MaintenanceWindowTarget
+	Tasks   []types.MaintenanceWindowTask
+}
+
+func ssmMaintenanceWindowGetFunc(ctx context.Context, client SSMClient, scope, query string) (*SSMMaintenanceWindowDetails, error) {
+	out, err := client.GetMaintenanceWindow(ctx, &ssm.GetMaintenanceWindowInput{
+		WindowId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := SSMMaintenanceWindowDetails{
+		Window: out,
+	}
+
+	targetPaginator := ssm.NewDescribeMaintenanceWindowTargetsPaginator(client, &ssm.DescribeMaintenanceWindowTargetsInput{
+		WindowId: out.WindowId,
+	})
+
+	for targetPaginator.HasMorePages() {
+		page, err := targetPaginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Targets = append(details.Targets, page.Targets...)
+	}
+
+	taskPaginator := ssm.NewDescribeMaintenanceWindowTasksPaginator(client, &ssm.DescribeMaintenanceWindowTasksInput{
+		WindowId: out.WindowId,
+	})
+
+	for taskPaginator.HasMorePages() {
+		page, err := taskPaginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Tasks = append(details.Tasks, page.Tasks...)
+	}
+
+	return &details, nil
+}
+
+func ssmMaintenanceWindowListFunc(ctx context.Context, client SSMClient, scope string) ([]*SSMMaintenanceWindowDetails, error) {
+	paginator := ssm.NewDescribeMaintenanceWindowsPaginator(client, &ssm.DescribeMaintenanceWindowsInput{})
+
+	windows := make([]*SSMMaintenanceWindowDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, identity := range out.WindowIdentities {
+			if identity.WindowId == nil {
+				continue
+			}
+
+			details, err := ssmMaintenanceWindowGetFunc(ctx, client, scope, *identity.WindowId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			windows = append(windows, details)
+		}
+	}
+
+	return windows, nil
+}
+
+// ssmMaintenanceWindowTaskLinks Links to the things that a task runs and
+// depends on. What the task ARN refers to depends on the type of the task
+func ssmMaintenanceWindowTaskLinks(task types.MaintenanceWindowTask, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if task.TaskArn != nil {
+		switch task.Type {
+		case types.MaintenanceWindowTaskTypeRunCommand, types.MaintenanceWindowTaskTypeAutomation:
+			links = append(links, ssmDocumentLink(*task.TaskArn, scope, &sdp.BlastPropagation{
+				// The document defines what the task does
+				In: true,
+				// The task can't affect the document
+				Out: false,
+			}))
+		case types.MaintenanceWindowTaskTypeLambda:
+			if a, err := adapterhelpers.ParseARN(*task.TaskArn); err == nil {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *task.TaskArn,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// The task fails if the function breaks
+						In: true,
+						// The window invokes the function
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	// Tasks usually target the window's registered targets, but can also
+	// target instances directly
+	links = append(links, ssmTargetLinks(task.Targets, scope, &sdp.BlastPropagation{
+		// The instances can't affect the task
+		In: false,
+		// The task changes the instances, for example by patching them
+		Out: true,
+	})...)
+
+	if task.ServiceRoleArn != nil {
+		// The task runs with the role's permissions
+		links = append(links, iamRoleLink(*task.ServiceRoleArn, scope))
+	}
+
+	if task.LoggingInfo != nil && task.LoggingInfo.S3BucketName != nil {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "s3-bucket",
+				Method: sdp.QueryMethod_GET,
+				Query:  *task.LoggingInfo.S3BucketName,
+				Scope:  adapterhelpers.FormatScope(accountID, ""),
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Logs can't be written if the bucket is removed
+				In: true,
+				// The task writes logs to the bucket
+				Out: true,
+			},
+		})
+	}
+
+	links = append(links, ssmAlarmLinks(task.AlarmConfiguration, scope)...)
+
+	return links
+}
+
+func ssmMaintenanceWindowItemMapper(_, scope string, awsItem *SSMMaintenanceWindowDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*ssm.GetMaintenanceWindowOutput
+		Targets []types.MaintenanceWindowTarget
+		Tasks   []types.MaintenanceWindowTask
+	}{
+		GetMaintenanceWindowOutput: awsItem.Window,
+		Targets:                    awsItem.Targets,
+		Tasks:                      awsItem.Tasks,
+	}, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssm-maintenance-window",
+		UniqueAttribute: "WindowId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	for _, target := range awsItem.Targets {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssmTargetLinks(target.Targets, scope, &sdp.BlastPropagation{
+			// The instances can't affect the window
+			In: false,
+			// The window's tasks change the instances
+			Out: true,
+		})...)
+	}
+
+	for _, task := range awsItem.Tasks {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, ssmMaintenanceWindowTaskLinks(task, scope)...)
+	}
+
+	return &item, nil
+}
+
+func NewSSMMaintenanceWindowAdapter(client SSMClient, accountID string, region string) *adapterhelpers.GetListAdapter[*SSMMaintenanceWindowDetails, SSMClient, *ssm.Options] {
+	return &adapterhelpers.GetListAdapter[*SSMMaintenanceWindowDetails, SSMClient, *ssm.Options]{
+		ItemType:        "ssm-maintenance-window",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmMaintenanceWindowAdapterMetadata,
+		GetFunc:         ssmMaintenanceWindowGetFunc,
+		ListFunc:        ssmMaintenanceWindowListFunc,
+		ItemMapper:      ssmMaintenanceWindowItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *SSMMaintenanceWindowDetails, client SSMClient) (map[string]string, error) {
+			return ssmListTags(ctx, client, types.ResourceTypeForTaggingMaintenanceWindow, details.Window.WindowId)
+		},
+	}
+}
+
+var ssmMaintenanceWindowAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-maintenance-window",
+	DescriptiveName: "SSM Maintenance Window",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a maintenance window by ID",
+		ListDescription:   "List all maintenance windows",
+		SearchDescription: "Search for maintenance windows by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssm_maintenance_window.id"},
+		{TerraformQueryMap: "aws_ssm_maintenance_window_target.window_id"},
+		{TerraformQueryMap: "aws_ssm_maintenance_window_task.window_id"},
+	},
+	PotentialLinks: []string{"ec2-instance", "ssm-managed-instance", "ssm-document", "lambda-function", "iam-role", "s3-bucket", "cloudwatch-alarm"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_CONFIGURATION,
+})
@@ -0,0 +1,149 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSSMMaintenanceWindowGetFunc(t *testing.T) {
+	client := SSMTestClient{
+		GetMaintenanceWindowOutput: &ssm.GetMaintenanceWindowOutput{
+			WindowId: adapterhelpers.PtrString("mw-0c50858d01EXAMPLE"),
+			Name:     adapterhelpers.PtrString("weekly-patching"),
+			Schedule: adapterhelpers.PtrString("cron(0 2 ? * SUN *)"),
+			Duration: adapterhelpers.PtrInt32(3),
+			Cutoff:   1,
+			Enabled:  true,
+		},
+		DescribeMaintenanceWindowTargetsOutput: &ssm.DescribeMaintenanceWindowTargetsOutput{
+			Targets: []types.MaintenanceWindowTarget{
+				{
+					WindowId:       adapterhelpers.PtrString("mw-0c50858d01EXAMPLE"),
+					WindowTargetId: adapterhelpers.PtrString("e32eecb2-646c-4f4b-8ed1-205fbEXAMPLE"),
+					ResourceType:   types.MaintenanceWindowResourceTypeInstance,
+					Targets: []types.Target{
+						{
+							Key:    adapterhelpers.PtrString("tag:PatchGroup"),
+							Values: []string{"web"},
+						},
+					},
+				},
+			},
+		},
+		DescribeMaintenanceWindowTasksOutput: &ssm.DescribeMaintenanceWindowTasksOutput{
+			Tasks: []types.MaintenanceWindowTask{
+				{
+					WindowId:       adapterhelpers.PtrString("mw-0c50858d01EXAMPLE"),
+					WindowTaskId:   adapterhelpers.PtrString("4f7ca192-7e9a-40fe-9192-5cb15EXAMPLE"),
+					Type:           types.MaintenanceWindowTaskTypeRunCommand,
+					TaskArn:        adapterhelpers.PtrString("AWS-RunPatchBaseline"),
+					ServiceRoleArn: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/MaintenanceWindowRole"),
+					Targets: []types.Target{
+						{
+							Key:    adapterhelpers.PtrString("WindowTargetIds"),
+							Values: []string{"e32eecb2-646c-4f4b-8ed1-205fbEXAMPLE"},
+						},
+					},
+					LoggingInfo: &types.LoggingInfo{
+						S3BucketName: adapterhelpers.PtrString("patch-logs"),
+						S3Region:     adapterhelpers.PtrString("eu-west-2"),
+					},
+				},
+				{
+					WindowId:     adapterhelpers.PtrString("mw-0c50858d01EXAMPLE"),
+					WindowTaskId: adapterhelpers.PtrString("9d2a7c4b-3e1f-4a5b-8c6d-7e8f9EXAMPLE"),
+					Type:         types.MaintenanceWindowTaskTypeLambda,
+					TaskArn:      adapterhelpers.PtrString("arn:aws:lambda:eu-west-2:123456789012:function:drain-nodes"),
+					AlarmConfiguration: &types.AlarmConfiguration{
+						Alarms: []types.Alarm{
+							{
+								Name: adapterhelpers.PtrString("web-5xx"),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	details, err := ssmMaintenanceWindowGetFunc(context.Background(), client, "123456789012.eu-west-2", "mw-0c50858d01EXAMPLE")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(details.Targets) != 1 || len(details.Tasks) != 2 {
+		t.Fatalf("expected 1 target and 2 tasks, got %v and %v", len(details.Targets), len(details.Tasks))
+	}
+
+	item, err := ssmMaintenanceWindowItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssm-managed-instance",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tag:PatchGroup=web",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-document",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "AWS-RunPatchBaseline",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/MaintenanceWindowRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "patch-logs",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-2:123456789012:function:drain-nodes",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "cloudwatch-alarm",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "web-5xx",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewSSMMaintenanceWindowAdapter(t *testing.T) {
+	client, account, region := ssmGetAutoConfig(t)
+
+	adapter := NewSSMMaintenanceWindowAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
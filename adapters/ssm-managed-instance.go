@@ -0,0 +1,182 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ssmManagedInstanceInputMapperSearch Searches for managed instances either by
+// ARN, or by a filter in the format {key}={value}. This allows the tag-based
+// targets of associations and maintenance windows to be resolved e.g.
+// tag:Environment=Production or tag-key=Patch
+func ssmManagedInstanceInputMapperSearch(ctx context.Context, client SSMClient, scope, query string) (*ssm.DescribeInstanceInformationInput, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		return &ssm.DescribeInstanceInformationInput{
+			Filters: []types.InstanceInformationStringFilter{
+				{
+					Key:    adapterhelpers.PtrString("InstanceIds"),
+					Values: []string{a.ResourceID()},
+				},
+			},
+		}, nil
+	}
+
+	key, value, found := strings.Cut(query, "=")
+
+	if !found || key == "" || value == "" {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an ARN or a filter in the format {key}={value}",
+		}
+	}
+
+	return &ssm.DescribeInstanceInformationInput{
+		Filters: []types.InstanceInformationStringFilter{
+			{
+				Key:    &key,
+				Values: []string{value},
+			},
+		},
+	}, nil
+}
+
+func ssmManagedInstanceOutputMapper(ctx context.Context, client SSMClient, scope string, _ *ssm.DescribeInstanceInformationInput, output *ssm.DescribeInstanceInformationOutput) ([]*sdp.Item, error) {
+	items := make([]*sdp.Item, 0)
+
+	for _, instance := range output.InstanceInformationList {
+		attributes, err := adapterhelpers.ToAttributesWithExclude(instance)
+
+		if err != nil {
+			return nil, err
+		}
+
+		item := sdp.Item{
+			Type:            "ssm-managed-instance",
+			UniqueAttribute: "InstanceId",
+			Attributes:      attributes,
+			Scope:           scope,
+		}
+
+		switch instance.PingStatus {
+		case types.PingStatusOnline:
+			item.Health = sdp.Health_HEALTH_OK.Enum()
+
+			if instance.AssociationStatus != nil && *instance.AssociationStatus == string(types.AssociationStatusNameFailed) {
+				// The agent is fine but the instance isn't in the state
+				// that its associations expect
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+			}
+		case types.PingStatusConnectionLost:
+			// The instance can't be patched or managed until the agent
+			// reconnects
+			item.Health = sdp.Health_HEALTH_ERROR.Enum()
+		case types.PingStatusInactive:
+			item.Health = sdp.Health_HEALTH_WARNING.Enum()
+		}
+
+		if instance.ResourceType == types.ResourceTypeManagedInstance {
+			// Tags for EC2 instances are on the instance itself, but hybrid
+			// instances are tagged in SSM
+			item.Tags, err = ssmListTags(ctx, client, types.ResourceTypeForTaggingManagedInstance, instance.InstanceId)
+
+			if err != nil {
+				item.Tags = adapterhelpers.HandleTagsError(ctx, err)
+			}
+		}
+
+		if instance.ResourceType == types.ResourceTypeEc2Instance && instance.InstanceId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-instance",
+					Method: sdp.QueryMethod_GET,
+					Query:  *instance.InstanceId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// This is the same instance as seen by SSM
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if instance.IPAddress != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ip",
+					Method: sdp.QueryMethod_GET,
+					Query:  *instance.IPAddress,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// IPs are always linked
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if instance.IamRole != nil && *instance.IamRole != "" {
+			// This is the role from the instance profile for EC2 instances,
+			// or the service role from the activation for hybrid instances.
+			// The agent can't connect if the role loses its SSM permissions
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*instance.IamRole, scope))
+		}
+
+		items = append(items, &item)
+	}
+
+	return items, nil
+}
+
+func NewSSMManagedInstanceAdapter(client SSMClient, accountID string, region string) *adapterhelpers.DescribeOnlyAdapter[*ssm.DescribeInstanceInformationInput, *ssm.DescribeInstanceInformationOutput, SSMClient, *ssm.Options] {
+	return &adapterhelpers.DescribeOnlyAdapter[*ssm.DescribeInstanceInformationInput, *ssm.DescribeInstanceInformationOutput, SSMClient, *ssm.Options]{
+		ItemType:        "ssm-managed-instance",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmManagedInstanceAdapterMetadata,
+		DescribeFunc: func(ctx context.Context, client SSMClient, input *ssm.DescribeInstanceInformationInput) (*ssm.DescribeInstanceInformationOutput, error) {
+			return client.DescribeInstanceInformation(ctx, input)
+		},
+		InputMapperGet: func(scope, query string) (*ssm.DescribeInstanceInformationInput, error) {
+			return &ssm.DescribeInstanceInformationInput{
+				Filters: []types.InstanceInformationStringFilter{
+					{
+						Key:    adapterhelpers.PtrString("InstanceIds"),
+						Values: []string{query},
+					},
+				},
+			}, nil
+		},
+		InputMapperList: func(scope string) (*ssm.DescribeInstanceInformationInput, error) {
+			return &ssm.DescribeInstanceInformationInput{}, nil
+		},
+		InputMapperSearch: ssmManagedInstanceInputMapperSearch,
+		PaginatorBuilder: func(client SSMClient, params *ssm.DescribeInstanceInformationInput) adapterhelpers.Paginator[*ssm.DescribeInstanceInformationOutput, *ssm.Options] {
+			return ssm.NewDescribeInstanceInformationPaginator(client, params)
+		},
+		OutputMapper: ssmManagedInstanceOutputMapper,
+	}
+}
+
+var ssmManagedInstanceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-managed-instance",
+	DescriptiveName: "SSM Managed Instance",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a managed instance by instance ID",
+		ListDescription:   "List all managed instances",
+		SearchDescription: "Search for managed instances by ARN, or by a filter in the format {key}={value} e.g. tag:Environment=Production",
+	},
+	PotentialLinks: []string{"ec2-instance", "ip", "iam-role"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_COMPUTE_APPLICATION,
+})
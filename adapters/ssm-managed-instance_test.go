@@ -0,0 +1,150 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestSSMManagedInstanceOutputMapper(t *testing.T) {
+	client := SSMTestClient{
+		ListTagsForResourceOutput: &ssm.ListTagsForResourceOutput{
+			TagList: []types.Tag{
+				{
+					Key:   adapterhelpers.PtrString("site"),
+					Value: adapterhelpers.PtrString("datacentre-1"),
+				},
+			},
+		},
+	}
+
+	output := &ssm.DescribeInstanceInformationOutput{
+		InstanceInformationList: []types.InstanceInformation{
+			{
+				InstanceId:        adapterhelpers.PtrString("i-0a1b2c3d4e5f6a7b8"),
+				ResourceType:      types.ResourceTypeEc2Instance,
+				PingStatus:        types.PingStatusOnline,
+				AssociationStatus: adapterhelpers.PtrString("Failed"),
+				IPAddress:         adapterhelpers.PtrString("10.0.1.23"),
+				IamRole:           adapterhelpers.PtrString("WebServerRole"),
+				PlatformType:      types.PlatformTypeLinux,
+			},
+			{
+				InstanceId:   adapterhelpers.PtrString("mi-0471e04240EXAMPLE"),
+				ResourceType: types.ResourceTypeManagedInstance,
+				PingStatus:   types.PingStatusConnectionLost,
+				IPAddress:    adapterhelpers.PtrString("192.168.0.10"),
+				IamRole:      adapterhelpers.PtrString("SSMServiceRole"),
+				PlatformType: types.PlatformTypeWindows,
+			},
+		},
+	}
+
+	items, err := ssmManagedInstanceOutputMapper(context.Background(), client, "123456789012.eu-west-2", nil, output)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(items) != 2 {
+		t.Fatalf("expected 2 items, got %v", len(items))
+	}
+
+	for _, item := range items {
+		if err = item.Validate(); err != nil {
+			t.Error(err)
+		}
+	}
+
+	ec2Instance := items[0]
+
+	if ec2Instance.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", ec2Instance.GetHealth())
+	}
+
+	if len(ec2Instance.GetTags()) != 0 {
+		t.Errorf("expected EC2 instances not to have SSM tags, got %v", ec2Instance.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "10.0.1.23",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "WebServerRole",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, ec2Instance)
+
+	hybridInstance := items[1]
+
+	if hybridInstance.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", hybridInstance.GetHealth())
+	}
+
+	if hybridInstance.GetTags()["site"] != "datacentre-1" {
+		t.Errorf("expected tag site=datacentre-1, got %v", hybridInstance.GetTags())
+	}
+
+	for _, link := range hybridInstance.GetLinkedItemQueries() {
+		if link.GetQuery().GetType() == "ec2-instance" {
+			t.Error("expected hybrid instances not to link to EC2")
+		}
+	}
+}
+
+func TestSSMManagedInstanceInputMapperSearch(t *testing.T) {
+	tests := map[string]string{
+		"tag:Environment=Production": "tag:Environment",
+		"tag-key=Patch":              "tag-key",
+		"arn:aws:ssm:eu-west-2:123456789012:managed-instance/mi-0471e04240EXAMPLE": "InstanceIds",
+	}
+
+	for query, expectedKey := range tests {
+		input, err := ssmManagedInstanceInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(input.Filters) != 1 || *input.Filters[0].Key != expectedKey {
+			t.Errorf("expected a single %v filter for %v, got %v", expectedKey, query, input.Filters)
+		}
+	}
+
+	if _, err := ssmManagedInstanceInputMapperSearch(context.Background(), nil, "123456789012.eu-west-2", "Production"); err == nil {
+		t.Error("expected an error for a query without a value")
+	}
+}
+
+func TestNewSSMManagedInstanceAdapter(t *testing.T) {
+	client, account, region := ssmGetAutoConfig(t)
+
+	adapter := NewSSMManagedInstanceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
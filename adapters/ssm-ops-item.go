@@ -0,0 +1,245 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ssmOpsItemOpenFilter Only OpsItems that are still being worked on are
+// listed, since resolved items are kept for years and don't affect anything
+var ssmOpsItemOpenFilter = types.OpsItemFilter{
+	Key:      types.OpsItemFilterKeyStatus,
+	Operator: types.OpsItemFilterOperatorEqual,
+	Values:   []string{string(types.OpsItemStatusOpen), string(types.OpsItemStatusInProgress)},
+}
+
+func ssmOpsItemGetFunc(ctx context.Context, client SSMClient, scope, query string) (*types.OpsItem, error) {
+	out, err := client.GetOpsItem(ctx, &ssm.GetOpsItemInput{
+		OpsItemId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.OpsItem == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "ops item was nil",
+		}
+	}
+
+	return out.OpsItem, nil
+}
+
+func ssmOpsItemDescribe(ctx context.Context, client SSMClient, scope string, filters []types.OpsItemFilter) ([]*types.OpsItem, error) {
+	paginator := ssm.NewDescribeOpsItemsPaginator(client, &ssm.DescribeOpsItemsInput{
+		OpsItemFilters: filters,
+	})
+
+	opsItems := make([]*types.OpsItem, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the description, notifications or
+		// related items
+		for _, summary := range out.OpsItemSummaries {
+			if summary.OpsItemId == nil {
+				continue
+			}
+
+			opsItem, err := ssmOpsItemGetFunc(ctx, client, scope, *summary.OpsItemId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			opsItems = append(opsItems, opsItem)
+		}
+	}
+
+	return opsItems, nil
+}
+
+func ssmOpsItemListFunc(ctx context.Context, client SSMClient, scope string) ([]*types.OpsItem, error) {
+	return ssmOpsItemDescribe(ctx, client, scope, []types.OpsItemFilter{ssmOpsItemOpenFilter})
+}
+
+// ssmOpsItemSearchFunc Searches for OpsItems by ARN. This is either the ARN of
+// an OpsItem, or the ARN used by an alarm action to create OpsItems, in which
+// case the open OpsItems with that severity and category are returned. These
+// are in the format:
+//
+// * arn:aws:ssm:region:account-id:opsitem/oi-1a2b3c4d5e6f
+//
+// * arn:aws:ssm:region:account-id:opsitem:severity#CATEGORY=category-name
+func ssmOpsItemSearchFunc(ctx context.Context, client SSMClient, scope, query string) ([]*types.OpsItem, error) {
+	a, err := adapterhelpers.ParseARN(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be an ARN",
+		}
+	}
+
+	resourceID := a.ResourceID()
+
+	if strings.HasPrefix(resourceID, "oi-") {
+		opsItem, err := ssmOpsItemGetFunc(ctx, client, scope, resourceID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*types.OpsItem{opsItem}, nil
+	}
+
+	severity, category, _ := strings.Cut(resourceID, "#CATEGORY=")
+
+	filters := []types.OpsItemFilter{
+		ssmOpsItemOpenFilter,
+		{
+			Key:      types.OpsItemFilterKeySeverity,
+			Operator: types.OpsItemFilterOperatorEqual,
+			Values:   []string{severity},
+		},
+	}
+
+	if category != "" {
+		filters = append(filters, types.OpsItemFilter{
+			Key:      types.OpsItemFilterKeyCategory,
+			Operator: types.OpsItemFilterOperatorEqual,
+			Values:   []string{category},
+		})
+	}
+
+	return ssmOpsItemDescribe(ctx, client, scope, filters)
+}
+
+func ssmOpsItemItemMapper(_, scope string, awsItem *types.OpsItem) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem)
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssm-ops-item",
+		UniqueAttribute: "OpsItemId",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	switch awsItem.Status {
+	case types.OpsItemStatusOpen, types.OpsItemStatusInProgress:
+		// An open OpsItem means that something needs attention
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.OpsItemStatusResolved, types.OpsItemStatusClosed, types.OpsItemStatusCompletedWithSuccess, types.OpsItemStatusApproved:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.OpsItemStatusFailed, types.OpsItemStatusCompletedWithFailure, types.OpsItemStatusTimedOut, types.OpsItemStatusRejected, types.OpsItemStatusChangeCalendarOverrideRejected:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	default:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	}
+
+	for _, related := range awsItem.RelatedOpsItems {
+		if related.OpsItemId == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ssm-ops-item",
+				Method: sdp.QueryMethod_GET,
+				Query:  *related.OpsItemId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Related items are usually caused by the same problem
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	for _, notification := range awsItem.Notifications {
+		if notification.Arn == nil {
+			continue
+		}
+
+		if a, err := adapterhelpers.ParseARN(*notification.Arn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sns-topic",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *notification.Arn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The topic can't affect the OpsItem
+					In: false,
+					// Changes to the OpsItem are sent to the topic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	// The resources that the OpsItem is about are stored in the operational
+	// data, usually as a JSON list of ARNs under /aws/resources
+	for _, data := range awsItem.OperationalData {
+		if data.Value == nil {
+			continue
+		}
+
+		if newQueries, err := sdp.ExtractLinksFrom(*data.Value); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, newQueries...)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSSMOpsItemAdapter(client SSMClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.OpsItem, SSMClient, *ssm.Options] {
+	return &adapterhelpers.GetListAdapter[*types.OpsItem, SSMClient, *ssm.Options]{
+		ItemType:        "ssm-ops-item",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmOpsItemAdapterMetadata,
+		GetFunc:         ssmOpsItemGetFunc,
+		ListFunc:        ssmOpsItemListFunc,
+		SearchFunc:      ssmOpsItemSearchFunc,
+		ItemMapper:      ssmOpsItemItemMapper,
+		ListTagsFunc: func(ctx context.Context, opsItem *types.OpsItem, client SSMClient) (map[string]string, error) {
+			return ssmListTags(ctx, client, types.ResourceTypeForTaggingOpsItem, opsItem.OpsItemId)
+		},
+	}
+}
+
+var ssmOpsItemAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-ops-item",
+	DescriptiveName: "SSM OpsItem",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get an OpsItem by ID",
+		ListDescription:   "List all open and in progress OpsItems",
+		SearchDescription: "Search for OpsItems by ARN, or by the ARN of an alarm action that creates OpsItems",
+	},
+	PotentialLinks: []string{"ssm-ops-item", "sns-topic"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
@@ -0,0 +1,122 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testSSMOpsItem = &types.OpsItem{
+	OpsItemId:  adapterhelpers.PtrString("oi-1a2b3c4d5e6f"),
+	OpsItemArn: adapterhelpers.PtrString("arn:aws:ssm:eu-west-2:123456789012:opsitem/oi-1a2b3c4d5e6f"),
+	Title:      adapterhelpers.PtrString("High 5xx rate on web"),
+	Source:     adapterhelpers.PtrString("CloudWatch Alarm"),
+	Category:   adapterhelpers.PtrString("Availability"),
+	Severity:   adapterhelpers.PtrString("2"),
+	Status:     types.OpsItemStatusOpen,
+	Notifications: []types.OpsItemNotification{
+		{
+			Arn: adapterhelpers.PtrString("arn:aws:sns:eu-west-2:123456789012:ops-alerts"),
+		},
+	},
+	RelatedOpsItems: []types.RelatedOpsItem{
+		{
+			OpsItemId: adapterhelpers.PtrString("oi-6f5e4d3c2b1a"),
+		},
+	},
+	OperationalData: map[string]types.OpsItemDataValue{
+		"/aws/resources": {
+			Type:  types.OpsItemDataTypeSearchableString,
+			Value: adapterhelpers.PtrString(`[{"arn":"arn:aws:cloudwatch:eu-west-2:123456789012:alarm:web-5xx"}]`),
+		},
+	},
+}
+
+func TestSSMOpsItemItemMapper(t *testing.T) {
+	item, err := ssmOpsItemItemMapper("", "123456789012.eu-west-2", testSSMOpsItem)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssm-ops-item",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "oi-6f5e4d3c2b1a",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:ops-alerts",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSSMOpsItemSearchFunc(t *testing.T) {
+	client := SSMTestClient{
+		GetOpsItemOutput: &ssm.GetOpsItemOutput{
+			OpsItem: testSSMOpsItem,
+		},
+		DescribeOpsItemsOutput: &ssm.DescribeOpsItemsOutput{
+			OpsItemSummaries: []types.OpsItemSummary{
+				{
+					OpsItemId: testSSMOpsItem.OpsItemId,
+				},
+			},
+		},
+	}
+
+	tests := []string{
+		"arn:aws:ssm:eu-west-2:123456789012:opsitem/oi-1a2b3c4d5e6f",
+		"arn:aws:ssm:eu-west-2:123456789012:opsitem:2#CATEGORY=Availability",
+		"arn:aws:ssm:eu-west-2:123456789012:opsitem:2",
+	}
+
+	for _, query := range tests {
+		opsItems, err := ssmOpsItemSearchFunc(context.Background(), client, "123456789012.eu-west-2", query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(opsItems) != 1 {
+			t.Errorf("expected 1 OpsItem for %v, got %v", query, len(opsItems))
+		}
+	}
+
+	if _, err := ssmOpsItemSearchFunc(context.Background(), client, "123456789012.eu-west-2", "oi-1a2b3c4d5e6f"); err == nil {
+		t.Error("expected an error for a non-ARN")
+	}
+}
+
+func TestNewSSMOpsItemAdapter(t *testing.T) {
+	client, account, region := ssmGetAutoConfig(t)
+
+	adapter := NewSSMOpsItemAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,186 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// SSMClient The client used by the SSM adapters other than parameters, which
+// predate this and use ssmClient
+type SSMClient interface {
+	DescribeAssociation(ctx context.Context, params *ssm.DescribeAssociationInput, optFns ...func(*ssm.Options)) (*ssm.DescribeAssociationOutput, error)
+	DescribeDocument(ctx context.Context, params *ssm.DescribeDocumentInput, optFns ...func(*ssm.Options)) (*ssm.DescribeDocumentOutput, error)
+	GetMaintenanceWindow(ctx context.Context, params *ssm.GetMaintenanceWindowInput, optFns ...func(*ssm.Options)) (*ssm.GetMaintenanceWindowOutput, error)
+	GetOpsItem(ctx context.Context, params *ssm.GetOpsItemInput, optFns ...func(*ssm.Options)) (*ssm.GetOpsItemOutput, error)
+	ListTagsForResource(ctx context.Context, params *ssm.ListTagsForResourceInput, optFns ...func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error)
+
+	ssm.DescribeInstanceInformationAPIClient
+	ssm.DescribeMaintenanceWindowTargetsAPIClient
+	ssm.DescribeMaintenanceWindowTasksAPIClient
+	ssm.DescribeMaintenanceWindowsAPIClient
+	ssm.DescribeOpsItemsAPIClient
+	ssm.ListAssociationsAPIClient
+	ssm.ListDocumentsAPIClient
+}
+
+// Converts a slice of SSM tags to a map
+func ssmTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// ssmListTags Lists the tags for an SSM resource. These are looked up by ID and
+// resource type rather than ARN
+func ssmListTags(ctx context.Context, client SSMClient, resourceType types.ResourceTypeForTagging, resourceID *string) (map[string]string, error) {
+	if resourceID == nil {
+		return nil, nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &ssm.ListTagsForResourceInput{
+		ResourceId:   resourceID,
+		ResourceType: resourceType,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return ssmTagsToMap(out.TagList), nil
+}
+
+// ssmTargetLinks Links to the instances that an association or maintenance
+// window targets. Targets are either a list of instance IDs, or tags that
+// instances must have, which are resolved by searching the managed instances.
+// Targets are in the format:
+//
+// * Key=InstanceIds,Values=i-02573cafcfEXAMPLE,mi-0471e04240EXAMPLE
+//
+// * Key=tag:Environment,Values=Production,Staging
+//
+// * Key=tag-key,Values=Patch
+func ssmTargetLinks(targets []types.Target, scope string, propagation *sdp.BlastPropagation) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, target := range targets {
+		if target.Key == nil {
+			continue
+		}
+
+		key := *target.Key
+
+		switch {
+		case strings.EqualFold(key, "InstanceIds"):
+			for _, id := range target.Values {
+				// Hybrid instances have an mi- prefix and don't exist in EC2
+				itemType := "ec2-instance"
+				if strings.HasPrefix(id, "mi-") {
+					itemType = "ssm-managed-instance"
+				} else if !strings.HasPrefix(id, "i-") {
+					// This is likely a wildcard that targets everything
+					continue
+				}
+
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   itemType,
+						Method: sdp.QueryMethod_GET,
+						Query:  id,
+						Scope:  scope,
+					},
+					BlastPropagation: propagation,
+				})
+			}
+		case strings.HasPrefix(key, "tag:"), key == "tag-key":
+			for _, value := range target.Values {
+				links = append(links, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "ssm-managed-instance",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  key + "=" + value,
+						Scope:  scope,
+					},
+					BlastPropagation: propagation,
+				})
+			}
+		}
+	}
+
+	return links
+}
+
+// ssmDocumentLink Links to an SSM document by name or ARN. Documents owned by
+// AWS don't have an account in their ARN, but can be read from any account so
+// these are looked up by name in the current scope
+func ssmDocumentLink(document string, scope string, propagation *sdp.BlastPropagation) *sdp.LinkedItemQuery {
+	if a, err := adapterhelpers.ParseARN(document); err == nil {
+		if a.AccountID != "" {
+			return &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ssm-document",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  document,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: propagation,
+			}
+		}
+
+		document = a.ResourceID()
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "ssm-document",
+			Method: sdp.QueryMethod_GET,
+			Query:  document,
+			Scope:  scope,
+		},
+		BlastPropagation: propagation,
+	}
+}
+
+// ssmAlarmLinks Links to the CloudWatch alarms that stop an association or
+// maintenance window task when they go into the ALARM state
+func ssmAlarmLinks(config *types.AlarmConfiguration, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	if config == nil {
+		return links
+	}
+
+	for _, alarm := range config.Alarms {
+		if alarm.Name == nil {
+			continue
+		}
+
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "cloudwatch-alarm",
+				Method: sdp.QueryMethod_GET,
+				Query:  *alarm.Name,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The alarm going off stops the execution
+				In: true,
+				// The execution doesn't affect the alarm
+				Out: false,
+			},
+		})
+	}
+
+	return links
+}
@@ -0,0 +1,176 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type SSMTestClient struct {
+	DescribeAssociationOutput              *ssm.DescribeAssociationOutput
+	DescribeDocumentOutput                 *ssm.DescribeDocumentOutput
+	DescribeInstanceInformationOutput      *ssm.DescribeInstanceInformationOutput
+	DescribeMaintenanceWindowTargetsOutput *ssm.DescribeMaintenanceWindowTargetsOutput
+	DescribeMaintenanceWindowTasksOutput   *ssm.DescribeMaintenanceWindowTasksOutput
+	DescribeMaintenanceWindowsOutput       *ssm.DescribeMaintenanceWindowsOutput
+	DescribeOpsItemsOutput                 *ssm.DescribeOpsItemsOutput
+	GetMaintenanceWindowOutput             *ssm.GetMaintenanceWindowOutput
+	GetOpsItemOutput                       *ssm.GetOpsItemOutput
+	ListAssociationsOutput                 *ssm.ListAssociationsOutput
+	ListDocumentsOutput                    *ssm.ListDocumentsOutput
+	ListTagsForResourceOutput              *ssm.ListTagsForResourceOutput
+}
+
+func (t SSMTestClient) DescribeAssociation(context.Context, *ssm.DescribeAssociationInput, ...func(*ssm.Options)) (*ssm.DescribeAssociationOutput, error) {
+	return t.DescribeAssociationOutput, nil
+}
+
+func (t SSMTestClient) DescribeDocument(context.Context, *ssm.DescribeDocumentInput, ...func(*ssm.Options)) (*ssm.DescribeDocumentOutput, error) {
+	return t.DescribeDocumentOutput, nil
+}
+
+func (t SSMTestClient) DescribeInstanceInformation(context.Context, *ssm.DescribeInstanceInformationInput, ...func(*ssm.Options)) (*ssm.DescribeInstanceInformationOutput, error) {
+	return t.DescribeInstanceInformationOutput, nil
+}
+
+func (t SSMTestClient) DescribeMaintenanceWindowTargets(context.Context, *ssm.DescribeMaintenanceWindowTargetsInput, ...func(*ssm.Options)) (*ssm.DescribeMaintenanceWindowTargetsOutput, error) {
+	return t.DescribeMaintenanceWindowTargetsOutput, nil
+}
+
+func (t SSMTestClient) DescribeMaintenanceWindowTasks(context.Context, *ssm.DescribeMaintenanceWindowTasksInput, ...func(*ssm.Options)) (*ssm.DescribeMaintenanceWindowTasksOutput, error) {
+	return t.DescribeMaintenanceWindowTasksOutput, nil
+}
+
+func (t SSMTestClient) DescribeMaintenanceWindows(context.Context, *ssm.DescribeMaintenanceWindowsInput, ...func(*ssm.Options)) (*ssm.DescribeMaintenanceWindowsOutput, error) {
+	return t.DescribeMaintenanceWindowsOutput, nil
+}
+
+func (t SSMTestClient) DescribeOpsItems(context.Context, *ssm.DescribeOpsItemsInput, ...func(*ssm.Options)) (*ssm.DescribeOpsItemsOutput, error) {
+	return t.DescribeOpsItemsOutput, nil
+}
+
+func (t SSMTestClient) GetMaintenanceWindow(context.Context, *ssm.GetMaintenanceWindowInput, ...func(*ssm.Options)) (*ssm.GetMaintenanceWindowOutput, error) {
+	return t.GetMaintenanceWindowOutput, nil
+}
+
+func (t SSMTestClient) GetOpsItem(context.Context, *ssm.GetOpsItemInput, ...func(*ssm.Options)) (*ssm.GetOpsItemOutput, error) {
+	return t.GetOpsItemOutput, nil
+}
+
+func (t SSMTestClient) ListAssociations(context.Context, *ssm.ListAssociationsInput, ...func(*ssm.Options)) (*ssm.ListAssociationsOutput, error) {
+	return t.ListAssociationsOutput, nil
+}
+
+func (t SSMTestClient) ListDocuments(context.Context, *ssm.ListDocumentsInput, ...func(*ssm.Options)) (*ssm.ListDocumentsOutput, error) {
+	return t.ListDocumentsOutput, nil
+}
+
+func (t SSMTestClient) ListTagsForResource(context.Context, *ssm.ListTagsForResourceInput, ...func(*ssm.Options)) (*ssm.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func ssmGetAutoConfig(t *testing.T) (*ssm.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ssm.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestSSMTargetLinks(t *testing.T) {
+	targets := []types.Target{
+		{
+			Key:    adapterhelpers.PtrString("InstanceIds"),
+			Values: []string{"i-02573cafcfEXAMPLE", "mi-0471e04240EXAMPLE", "*"},
+		},
+		{
+			Key:    adapterhelpers.PtrString("tag:Environment"),
+			Values: []string{"Production"},
+		},
+		{
+			Key:    adapterhelpers.PtrString("tag-key"),
+			Values: []string{"Patch"},
+		},
+		{
+			Key:    adapterhelpers.PtrString("resource-groups:Name"),
+			Values: []string{"WebServers"},
+		},
+	}
+
+	item := sdp.Item{
+		LinkedItemQueries: ssmTargetLinks(targets, "123456789012.eu-west-2", &sdp.BlastPropagation{Out: true}),
+	}
+
+	if len(item.GetLinkedItemQueries()) != 4 {
+		t.Errorf("expected 4 links, got %v", len(item.GetLinkedItemQueries()))
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-02573cafcfEXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-managed-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "mi-0471e04240EXAMPLE",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-managed-instance",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tag:Environment=Production",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ssm-managed-instance",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "tag-key=Patch",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, &item)
+}
+
+func TestSSMDocumentLink(t *testing.T) {
+	propagation := &sdp.BlastPropagation{In: true}
+
+	tests := map[string]*sdp.Query{
+		"PatchLinux": {
+			Type:   "ssm-document",
+			Method: sdp.QueryMethod_GET,
+			Query:  "PatchLinux",
+			Scope:  "123456789012.eu-west-2",
+		},
+		"arn:aws:ssm:eu-west-2::document/AWS-RunPatchBaseline": {
+			Type:   "ssm-document",
+			Method: sdp.QueryMethod_GET,
+			Query:  "AWS-RunPatchBaseline",
+			Scope:  "123456789012.eu-west-2",
+		},
+		"arn:aws:ssm:eu-west-1:210987654321:document/SharedPatching": {
+			Type:   "ssm-document",
+			Method: sdp.QueryMethod_SEARCH,
+			Query:  "arn:aws:ssm:eu-west-1:210987654321:document/SharedPatching",
+			Scope:  "210987654321.eu-west-1",
+		},
+	}
+
+	for document, expected := range tests {
+		link := ssmDocumentLink(document, "123456789012.eu-west-2", propagation)
+
+		if link.GetQuery().GetType() != expected.GetType() ||
+			link.GetQuery().GetMethod() != expected.GetMethod() ||
+			link.GetQuery().GetQuery() != expected.GetQuery() ||
+			link.GetQuery().GetScope() != expected.GetScope() {
+			t.Errorf("expected %v for %v, got %v", expected, document, link.GetQuery())
+		}
+	}
+}
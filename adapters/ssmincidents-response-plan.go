@@ -0,0 +1,202 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// ssmIncidentsResponsePlanGetFunc Gets a response plan by name. The API only
+// accepts ARNs, but response plan ARNs don't include a region so can be built
+// from the name and account
+func ssmIncidentsResponsePlanGetFunc(ctx context.Context, client SSMIncidentsClient, scope, query string) (*ssmincidents.GetResponsePlanOutput, error) {
+	accountID, region, err := adapterhelpers.ParseScope(scope)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetResponsePlan(ctx, &ssmincidents.GetResponsePlanInput{
+		Arn: adapterhelpers.PtrString(fmt.Sprintf("arn:%v:ssm-incidents::%v:response-plan/%v", adapterhelpers.PartitionFromRegion(region), accountID, query)),
+	})
+}
+
+func ssmIncidentsResponsePlanListFunc(ctx context.Context, client SSMIncidentsClient, scope string) ([]*ssmincidents.GetResponsePlanOutput, error) {
+	paginator := ssmincidents.NewListResponsePlansPaginator(client, &ssmincidents.ListResponsePlansInput{})
+
+	plans := make([]*ssmincidents.GetResponsePlanOutput, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries only include the name and ARN
+		for _, summary := range out.ResponsePlanSummaries {
+			if summary.Arn == nil {
+				continue
+			}
+
+			plan, err := client.GetResponsePlan(ctx, &ssmincidents.GetResponsePlanInput{
+				Arn: summary.Arn,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans, nil
+}
+
+// ssmIncidentsResponsePlanSearchFunc Searches for a response plan by ARN. These
+// don't include a region so the default ARN search would never match the
+// scope. Alarm actions also use a different resource type to the plan itself:
+//
+// * arn:aws:ssm-incidents::account-id:response-plan/response-plan-name
+//
+// * arn:aws:ssm-incidents::account-id:responseplan/response-plan-name
+func ssmIncidentsResponsePlanSearchFunc(ctx context.Context, client SSMIncidentsClient, scope, query string) ([]*ssmincidents.GetResponsePlanOutput, error) {
+	a, err := adapterhelpers.ParseARN(query)
+
+	if err != nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: err.Error(),
+		}
+	}
+
+	plan, err := ssmIncidentsResponsePlanGetFunc(ctx, client, scope, a.ResourceID())
+
+	if err != nil {
+		return nil, err
+	}
+
+	return []*ssmincidents.GetResponsePlanOutput{plan}, nil
+}
+
+func ssmIncidentsResponsePlanItemMapper(_, scope string, awsItem *ssmincidents.GetResponsePlanOutput) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "ssm-incidents-response-plan",
+		UniqueAttribute: "Name",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	// Runbooks that are started when an incident is created
+	for _, action := range awsItem.Actions {
+		automation, ok := action.(*types.ActionMemberSsmAutomation)
+
+		if !ok {
+			continue
+		}
+
+		if automation.Value.DocumentName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, ssmDocumentLink(*automation.Value.DocumentName, scope, &sdp.BlastPropagation{
+				// The runbook can't mitigate the incident if it is broken
+				In: true,
+				// The plan can't affect the document
+				Out: false,
+			}))
+		}
+
+		if automation.Value.RoleArn != nil {
+			// The runbook runs with the role's permissions
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*automation.Value.RoleArn, scope))
+		}
+	}
+
+	topicArns := make([]string, 0)
+
+	if chatbot, ok := awsItem.ChatChannel.(*types.ChatChannelMemberChatbotSns); ok {
+		topicArns = append(topicArns, chatbot.Value...)
+	}
+
+	if awsItem.IncidentTemplate != nil {
+		for _, target := range awsItem.IncidentTemplate.NotificationTargets {
+			if topic, ok := target.(*types.NotificationTargetItemMemberSnsTopicArn); ok {
+				topicArns = append(topicArns, topic.Value)
+			}
+		}
+	}
+
+	for _, topicArn := range topicArns {
+		if a, err := adapterhelpers.ParseARN(topicArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "sns-topic",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  topicArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Responders aren't notified if the topic is removed
+					In: true,
+					// Incidents are published to the topic
+					Out: true,
+				},
+			})
+		}
+	}
+
+	return &item, nil
+}
+
+func NewSSMIncidentsResponsePlanAdapter(client SSMIncidentsClient, accountID string, region string) *adapterhelpers.GetListAdapter[*ssmincidents.GetResponsePlanOutput, SSMIncidentsClient, *ssmincidents.Options] {
+	return &adapterhelpers.GetListAdapter[*ssmincidents.GetResponsePlanOutput, SSMIncidentsClient, *ssmincidents.Options]{
+		ItemType:        "ssm-incidents-response-plan",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: ssmIncidentsResponsePlanAdapterMetadata,
+		GetFunc:         ssmIncidentsResponsePlanGetFunc,
+		ListFunc:        ssmIncidentsResponsePlanListFunc,
+		SearchFunc:      ssmIncidentsResponsePlanSearchFunc,
+		ItemMapper:      ssmIncidentsResponsePlanItemMapper,
+		ListTagsFunc: func(ctx context.Context, plan *ssmincidents.GetResponsePlanOutput, client SSMIncidentsClient) (map[string]string, error) {
+			out, err := client.ListTagsForResource(ctx, &ssmincidents.ListTagsForResourceInput{
+				ResourceArn: plan.Arn,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			return out.Tags, nil
+		},
+	}
+}
+
+var ssmIncidentsResponsePlanAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "ssm-incidents-response-plan",
+	DescriptiveName: "Incident Manager Response Plan",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a response plan by name",
+		ListDescription:   "List all response plans",
+		SearchDescription: "Search for response plans by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_ssmincidents_response_plan.name"},
+	},
+	PotentialLinks: []string{"ssm-document", "iam-role", "sns-topic"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_OBSERVABILITY,
+})
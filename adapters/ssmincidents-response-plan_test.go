@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var testResponsePlan = &ssmincidents.GetResponsePlanOutput{
+	Arn:         adapterhelpers.PtrString("arn:aws:ssm-incidents::123456789012:response-plan/web-outage"),
+	Name:        adapterhelpers.PtrString("web-outage"),
+	DisplayName: adapterhelpers.PtrString("Web outage"),
+	IncidentTemplate: &types.IncidentTemplate{
+		Title:  adapterhelpers.PtrString("Web outage"),
+		Impact: adapterhelpers.PtrInt32(2),
+		NotificationTargets: []types.NotificationTargetItem{
+			&types.NotificationTargetItemMemberSnsTopicArn{
+				Value: "arn:aws:sns:eu-west-2:123456789012:incident-updates",
+			},
+		},
+	},
+	ChatChannel: &types.ChatChannelMemberChatbotSns{
+		Value: []string{"arn:aws:sns:eu-west-2:123456789012:incident-chat"},
+	},
+	Actions: []types.Action{
+		&types.ActionMemberSsmAutomation{
+			Value: types.SsmAutomation{
+				DocumentName: adapterhelpers.PtrString("RestartWebServers"),
+				RoleArn:      adapterhelpers.PtrString("arn:aws:iam::123456789012:role/IncidentAutomationRole"),
+			},
+		},
+	},
+}
+
+func TestSSMIncidentsResponsePlanItemMapper(t *testing.T) {
+	item, err := ssmIncidentsResponsePlanItemMapper("", "123456789012.eu-west-2", testResponsePlan)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ssm-document",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "RestartWebServers",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/IncidentAutomationRole",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:incident-updates",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "sns-topic",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:sns:eu-west-2:123456789012:incident-chat",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestSSMIncidentsResponsePlanSearchFunc(t *testing.T) {
+	client := SSMIncidentsTestClient{
+		GetResponsePlanOutput: testResponsePlan,
+	}
+
+	// Alarm actions use responseplan rather than response-plan
+	for _, query := range []string{"arn:aws:ssm-incidents::123456789012:response-plan/web-outage", "arn:aws:ssm-incidents::123456789012:responseplan/web-outage"} {
+		plans, err := ssmIncidentsResponsePlanSearchFunc(context.Background(), client, "123456789012.eu-west-2", query)
+
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(plans) != 1 {
+			t.Errorf("expected 1 response plan for %v, got %v", query, len(plans))
+		}
+	}
+}
+
+func TestNewSSMIncidentsResponsePlanAdapter(t *testing.T) {
+	client, account, region := ssmincidentsGetAutoConfig(t)
+
+	adapter := NewSSMIncidentsResponsePlanAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
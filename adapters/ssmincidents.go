@@ -0,0 +1,14 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+)
+
+type SSMIncidentsClient interface {
+	GetResponsePlan(ctx context.Context, params *ssmincidents.GetResponsePlanInput, optFns ...func(*ssmincidents.Options)) (*ssmincidents.GetResponsePlanOutput, error)
+	ListTagsForResource(ctx context.Context, params *ssmincidents.ListTagsForResourceInput, optFns ...func(*ssmincidents.Options)) (*ssmincidents.ListTagsForResourceOutput, error)
+
+	ssmincidents.ListResponsePlansAPIClient
+}
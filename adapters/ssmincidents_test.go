@@ -0,0 +1,34 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type SSMIncidentsTestClient struct {
+	GetResponsePlanOutput     *ssmincidents.GetResponsePlanOutput
+	ListResponsePlansOutput   *ssmincidents.ListResponsePlansOutput
+	ListTagsForResourceOutput *ssmincidents.ListTagsForResourceOutput
+}
+
+func (t SSMIncidentsTestClient) GetResponsePlan(context.Context, *ssmincidents.GetResponsePlanInput, ...func(*ssmincidents.Options)) (*ssmincidents.GetResponsePlanOutput, error) {
+	return t.GetResponsePlanOutput, nil
+}
+
+func (t SSMIncidentsTestClient) ListResponsePlans(context.Context, *ssmincidents.ListResponsePlansInput, ...func(*ssmincidents.Options)) (*ssmincidents.ListResponsePlansOutput, error) {
+	return t.ListResponsePlansOutput, nil
+}
+
+func (t SSMIncidentsTestClient) ListTagsForResource(context.Context, *ssmincidents.ListTagsForResourceInput, ...func(*ssmincidents.Options)) (*ssmincidents.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func ssmincidentsGetAutoConfig(t *testing.T) (*ssmincidents.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := ssmincidents.NewFromConfig(config)
+
+	return client, account, region
+}
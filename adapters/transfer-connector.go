@@ -0,0 +1,157 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transferConnectorGetFunc(ctx context.Context, client TransferClient, scope, query string) (*types.DescribedConnector, error) {
+	out, err := client.DescribeConnector(ctx, &transfer.DescribeConnectorInput{
+		ConnectorId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Connector == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "connector was nil",
+		}
+	}
+
+	return out.Connector, nil
+}
+
+func transferConnectorListFunc(ctx context.Context, client TransferClient, scope string) ([]*types.DescribedConnector, error) {
+	paginator := transfer.NewListConnectorsPaginator(client, &transfer.ListConnectorsInput{})
+
+	connectors := make([]*types.DescribedConnector, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the roles or credentials
+		for _, summary := range out.Connectors {
+			if summary.ConnectorId == nil {
+				continue
+			}
+
+			connector, err := transferConnectorGetFunc(ctx, client, scope, *summary.ConnectorId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			connectors = append(connectors, connector)
+		}
+	}
+
+	return connectors, nil
+}
+
+func transferConnectorItemMapper(_, scope string, awsItem *types.DescribedConnector) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "transfer-connector",
+		UniqueAttribute: "ConnectorId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            transferTagsToMap(awsItem.Tags),
+	}
+
+	// The partner's server, e.g. sftp://partner.example.com or
+	// https://partner.example.com/as2
+	if awsItem.Url != nil {
+		if u, err := url.Parse(*awsItem.Url); err == nil && u.Hostname() != "" {
+			query := &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  u.Hostname(),
+				Scope:  "global",
+			}
+
+			if net.ParseIP(u.Hostname()) != nil {
+				query.Type = "ip"
+				query.Method = sdp.QueryMethod_GET
+			}
+
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: query,
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS and IPs always link
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if awsItem.AccessRole != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.AccessRole, scope))
+	}
+
+	if awsItem.LoggingRole != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.LoggingRole, scope))
+	}
+
+	if awsItem.SftpConfig != nil && awsItem.SftpConfig.UserSecretId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, transferSecretLink(*awsItem.SftpConfig.UserSecretId, scope))
+	}
+
+	if awsItem.As2Config != nil && awsItem.As2Config.BasicAuthSecretId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, transferSecretLink(*awsItem.As2Config.BasicAuthSecretId, scope))
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, transferEgressIPLinks(awsItem.ServiceManagedEgressIpAddresses)...)
+
+	return &item, nil
+}
+
+func NewTransferConnectorAdapter(client TransferClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DescribedConnector, TransferClient, *transfer.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DescribedConnector, TransferClient, *transfer.Options]{
+		ItemType:        "transfer-connector",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: transferConnectorAdapterMetadata,
+		GetFunc:         transferConnectorGetFunc,
+		ListFunc:        transferConnectorListFunc,
+		ItemMapper:      transferConnectorItemMapper,
+	}
+}
+
+var transferConnectorAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "transfer-connector",
+	DescriptiveName: "Transfer Family Connector",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Transfer Family connector by ID",
+		ListDescription:   "List all Transfer Family connectors",
+		SearchDescription: "Search for Transfer Family connectors by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_transfer_connector.id"},
+	},
+	PotentialLinks: []string{"dns", "ip", "iam-role", "secretsmanager-secret"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
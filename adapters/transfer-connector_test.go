@@ -0,0 +1,133 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestTransferConnectorItemMapper(t *testing.T) {
+	connector := types.DescribedConnector{
+		Arn:                             adapterhelpers.PtrString("arn:aws:transfer:eu-west-1:123456789012:connector/c-01234567890abcdef"),
+		ConnectorId:                     adapterhelpers.PtrString("c-01234567890abcdef"),
+		Url:                             adapterhelpers.PtrString("sftp://sftp.partner.example.com:22"),
+		AccessRole:                      adapterhelpers.PtrString("arn:aws:iam::123456789012:role/transfer-connector"),
+		LoggingRole:                     adapterhelpers.PtrString("arn:aws:iam::123456789012:role/transfer-logging"),
+		ServiceManagedEgressIpAddresses: []string{"203.0.113.10", "203.0.113.11"},
+		SftpConfig: &types.SftpConnectorConfig{
+			UserSecretId:    adapterhelpers.PtrString("arn:aws:secretsmanager:eu-west-1:123456789012:secret:aws/transfer/c-01234567890abcdef-AbCdEf"),
+			TrustedHostKeys: []string{"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExample"},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("partner"),
+				Value: adapterhelpers.PtrString("acme"),
+			},
+		},
+	}
+
+	item, err := transferConnectorItemMapper("", "123456789012.eu-west-1", &connector)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetTags()["partner"] != "acme" {
+		t.Errorf("expected tag partner=acme, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "sftp.partner.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/transfer-connector",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/transfer-logging",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:secretsmanager:eu-west-1:123456789012:secret:aws/transfer/c-01234567890abcdef-AbCdEf",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.10",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "203.0.113.11",
+			ExpectedScope:  "global",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestTransferConnectorItemMapperAS2(t *testing.T) {
+	connector := types.DescribedConnector{
+		ConnectorId: adapterhelpers.PtrString("c-01234567890abcdef"),
+		Url:         adapterhelpers.PtrString("http://198.51.100.20:8080/as2"),
+		As2Config: &types.As2ConnectorConfig{
+			BasicAuthSecretId: adapterhelpers.PtrString("as2-partner-basic-auth"),
+		},
+	}
+
+	item, err := transferConnectorItemMapper("", "123456789012.eu-west-1", &connector)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ip",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "198.51.100.20",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "secretsmanager-secret",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "as2-partner-basic-auth",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewTransferConnectorAdapter(t *testing.T) {
+	client, account, region := transferGetAutoConfig(t)
+
+	adapter := NewTransferConnectorAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
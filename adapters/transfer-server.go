@@ -0,0 +1,401 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func transferServerGetFunc(ctx context.Context, client TransferClient, scope, query string) (*types.DescribedServer, error) {
+	out, err := client.DescribeServer(ctx, &transfer.DescribeServerInput{
+		ServerId: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.Server == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "server was nil",
+		}
+	}
+
+	return out.Server, nil
+}
+
+func transferServerListFunc(ctx context.Context, client TransferClient, scope string) ([]*types.DescribedServer, error) {
+	paginator := transfer.NewListServersPaginator(client, &transfer.ListServersInput{})
+
+	servers := make([]*types.DescribedServer, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the endpoint or identity provider
+		for _, summary := range out.Servers {
+			if summary.ServerId == nil {
+				continue
+			}
+
+			server, err := transferServerGetFunc(ctx, client, scope, *summary.ServerId)
+
+			if err != nil {
+				return nil, err
+			}
+
+			servers = append(servers, server)
+		}
+	}
+
+	return servers, nil
+}
+
+// transferLogGroupName Extracts the log group name from a log group ARN in the
+// format arn:aws:logs:{region}:{account}:log-group:{name}[:*]
+func transferLogGroupName(resource string) (string, bool) {
+	name, ok := strings.CutPrefix(resource, "log-group:")
+
+	if !ok {
+		return "", false
+	}
+
+	name = strings.TrimSuffix(name, ":*")
+
+	return name, name != ""
+}
+
+func transferServerItemMapper(_, scope string, awsItem *types.DescribedServer) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(awsItem, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "transfer-server",
+		UniqueAttribute: "ServerId",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            transferTagsToMap(awsItem.Tags),
+	}
+
+	switch awsItem.State {
+	case types.StateOnline:
+		item.Health = sdp.Health_HEALTH_OK.Enum()
+	case types.StateStarting, types.StateStopping:
+		item.Health = sdp.Health_HEALTH_PENDING.Enum()
+	case types.StateOffline:
+		// Partners can't connect while the server is stopped
+		item.Health = sdp.Health_HEALTH_WARNING.Enum()
+	case types.StateStartFailed, types.StateStopFailed:
+		item.Health = sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	if awsItem.ServerId != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "transfer-user",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.ServerId,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Users can't affect the server
+				In: false,
+				// Users can't connect if the server changes
+				Out: true,
+			},
+		})
+
+		// Every server gets a hostname, even when it's only reachable
+		// through a VPC endpoint
+		if _, region, err := adapterhelpers.ParseScope(scope); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  fmt.Sprintf("%v.server.transfer.%v.amazonaws.com", *awsItem.ServerId, region),
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// DNS always links
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+	}
+
+	if endpoint := awsItem.EndpointDetails; endpoint != nil {
+		if endpoint.VpcEndpointId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc-endpoint",
+					Method: sdp.QueryMethod_GET,
+					Query:  *endpoint.VpcEndpointId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Partners connect through the VPC endpoint
+					In: true,
+					// The server can't affect the VPC endpoint
+					Out: false,
+				},
+			})
+		}
+
+		if endpoint.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *endpoint.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the VPC will affect the server
+					In: true,
+					// The server can't affect the VPC
+					Out: false,
+				},
+			})
+		}
+
+		for _, subnet := range endpoint.SubnetIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-subnet",
+					Method: sdp.QueryMethod_GET,
+					Query:  subnet,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the subnet will affect the server
+					In: true,
+					// The server shouldn't affect the subnet
+					Out: false,
+				},
+			})
+		}
+
+		for _, sg := range endpoint.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  sg,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Changing the security group will affect which partners
+					// can connect
+					In: true,
+					// The server shouldn't affect the security group
+					Out: false,
+				},
+			})
+		}
+
+		// Elastic IPs make a VPC server reachable from the internet
+		for _, allocation := range endpoint.AddressAllocationIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-address",
+					Method: sdp.QueryMethod_GET,
+					Query:  allocation,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Partners connect to the IP, releasing it will break
+					// their uploads
+					In: true,
+					// The server can't affect the address
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.LoggingRole != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.LoggingRole, scope))
+	}
+
+	for _, destination := range awsItem.StructuredLogDestinations {
+		if a, err := adapterhelpers.ParseARN(destination); err == nil {
+			if name, ok := transferLogGroupName(a.Resource); ok {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "logs-log-group",
+						Method: sdp.QueryMethod_GET,
+						Query:  name,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Deleting the log group loses the transfer logs
+						In: true,
+						// The server writes logs to the group
+						Out: true,
+					},
+				})
+			}
+		}
+	}
+
+	if awsItem.Certificate != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.Certificate); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.Certificate,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// FTPS connections fail if the certificate expires or is
+					// removed
+					In: true,
+					// The server can't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if idp := awsItem.IdentityProviderDetails; idp != nil {
+		if idp.Function != nil {
+			if a, err := adapterhelpers.ParseARN(*idp.Function); err == nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+					Query: &sdp.Query{
+						Type:   "lambda-function",
+						Method: sdp.QueryMethod_SEARCH,
+						Query:  *idp.Function,
+						Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+					},
+					BlastPropagation: &sdp.BlastPropagation{
+						// Users can't log in if the function breaks
+						In: true,
+						// The server invokes the function but can't change it
+						Out: false,
+					},
+				})
+			}
+		}
+
+		if idp.Url != nil {
+			if link := transferIdentityProviderURLLink(*idp.Url, scope); link != nil {
+				item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+			}
+		}
+
+		if idp.InvocationRole != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*idp.InvocationRole, scope))
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, transferEgressIPLinks(awsItem.As2ServiceManagedEgressIpAddresses)...)
+
+	return &item, nil
+}
+
+// transferIdentityProviderURLLink Links to the API Gateway REST API that
+// authenticates users. The URL is in the format
+// https://{restApiId}.execute-api.{region}.amazonaws.com/{stage}
+func transferIdentityProviderURLLink(rawURL string, scope string) *sdp.LinkedItemQuery {
+	u, err := url.Parse(rawURL)
+
+	if err != nil {
+		return nil
+	}
+
+	blastPropagation := &sdp.BlastPropagation{
+		// Users can't log in if the API breaks
+		In: true,
+		// The server calls the API but can't change it
+		Out: false,
+	}
+
+	hostParts := strings.Split(u.Hostname(), ".")
+
+	if len(hostParts) == 5 && hostParts[1] == "execute-api" {
+		accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "apigateway-rest-api",
+				Method: sdp.QueryMethod_GET,
+				Query:  hostParts[0],
+				Scope:  adapterhelpers.FormatScope(accountID, hostParts[2]),
+			},
+			BlastPropagation: blastPropagation,
+		}
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "http",
+			Method: sdp.QueryMethod_GET,
+			Query:  rawURL,
+			Scope:  "global",
+		},
+		BlastPropagation: blastPropagation,
+	}
+}
+
+func NewTransferServerAdapter(client TransferClient, accountID string, region string) *adapterhelpers.GetListAdapter[*types.DescribedServer, TransferClient, *transfer.Options] {
+	return &adapterhelpers.GetListAdapter[*types.DescribedServer, TransferClient, *transfer.Options]{
+		ItemType:        "transfer-server",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: transferServerAdapterMetadata,
+		GetFunc:         transferServerGetFunc,
+		ListFunc:        transferServerListFunc,
+		ItemMapper:      transferServerItemMapper,
+	}
+}
+
+var transferServerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "transfer-server",
+	DescriptiveName: "Transfer Family Server",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a Transfer Family server by ID",
+		ListDescription:   "List all Transfer Family servers",
+		SearchDescription: "Search for Transfer Family servers by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_transfer_server.id"},
+	},
+	PotentialLinks: []string{
+		"transfer-user",
+		"dns",
+		"ec2-vpc-endpoint",
+		"ec2-vpc",
+		"ec2-subnet",
+		"ec2-security-group",
+		"ec2-address",
+		"iam-role",
+		"logs-log-group",
+		"acm-certificate",
+		"lambda-function",
+		"apigateway-rest-api",
+		"http",
+		"ip",
+	},
+	Category: sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
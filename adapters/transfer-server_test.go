@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var transferDescribeServerOutput = transfer.DescribeServerOutput{
+	Server: &types.DescribedServer{
+		Arn:                  adapterhelpers.PtrString("arn:aws:transfer:eu-west-1:123456789012:server/s-01234567890abcdef"),
+		ServerId:             adapterhelpers.PtrString("s-01234567890abcdef"),
+		Domain:               types.DomainS3,
+		EndpointType:         types.EndpointTypeVpc,
+		IdentityProviderType: types.IdentityProviderTypeApiGateway,
+		Protocols:            []types.Protocol{types.ProtocolSftp, types.ProtocolFtps},
+		State:                types.StateOnline,
+		Certificate:          adapterhelpers.PtrString("arn:aws:acm:eu-west-1:123456789012:certificate/9b2f1c6e-3a4d-4f5e-8a7b-1c2d3e4f5a6b"),
+		LoggingRole:          adapterhelpers.PtrString("arn:aws:iam::123456789012:role/transfer-logging"),
+		UserCount:            adapterhelpers.PtrInt32(3),
+		EndpointDetails: &types.EndpointDetails{
+			AddressAllocationIds: []string{"eipalloc-0a1b2c3d4e5f6a7b8"},
+			SecurityGroupIds:     []string{"sg-0a1b2c3d"},
+			SubnetIds:            []string{"subnet-0a1b2c3d"},
+			VpcEndpointId:        adapterhelpers.PtrString("vpce-0a1b2c3d4e5f6a7b8"),
+			VpcId:                adapterhelpers.PtrString("vpc-0a1b2c3d"),
+		},
+		IdentityProviderDetails: &types.IdentityProviderDetails{
+			Url:            adapterhelpers.PtrString("https://abc123defg.execute-api.eu-west-1.amazonaws.com/prod"),
+			InvocationRole: adapterhelpers.PtrString("arn:aws:iam::123456789012:role/transfer-idp"),
+		},
+		StructuredLogDestinations: []string{
+			"arn:aws:logs:eu-west-1:123456789012:log-group:/aws/transfer/s-01234567890abcdef:*",
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("team"),
+				Value: adapterhelpers.PtrString("partners"),
+			},
+		},
+	},
+}
+
+func TestTransferServerItemMapper(t *testing.T) {
+	item, err := transferServerItemMapper("", "123456789012.eu-west-1", transferDescribeServerOutput.Server)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_OK {
+		t.Errorf("expected health to be OK, got %v", item.GetHealth())
+	}
+
+	if item.GetTags()["team"] != "partners" {
+		t.Errorf("expected tag team=partners, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "transfer-user",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "s-01234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "s-01234567890abcdef.server.transfer.eu-west-1.amazonaws.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "ec2-vpc-endpoint",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpce-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-subnet",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "subnet-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "ec2-address",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "eipalloc-0a1b2c3d4e5f6a7b8",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/transfer-logging",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "logs-log-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "/aws/transfer/s-01234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-1:123456789012:certificate/9b2f1c6e-3a4d-4f5e-8a7b-1c2d3e4f5a6b",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "apigateway-rest-api",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "abc123defg",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/transfer-idp",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestTransferServerItemMapperLambdaIdentityProvider(t *testing.T) {
+	server := types.DescribedServer{
+		ServerId:             adapterhelpers.PtrString("s-01234567890abcdef"),
+		IdentityProviderType: types.IdentityProviderTypeAwsLambda,
+		State:                types.StateStartFailed,
+		IdentityProviderDetails: &types.IdentityProviderDetails{
+			Function: adapterhelpers.PtrString("arn:aws:lambda:eu-west-1:123456789012:function:transfer-auth"),
+		},
+	}
+
+	item, err := transferServerItemMapper("", "123456789012.eu-west-1", &server)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "lambda-function",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:lambda:eu-west-1:123456789012:function:transfer-auth",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestTransferServerListFunc(t *testing.T) {
+	client := TransferTestClient{
+		ListServersOutput: &transfer.ListServersOutput{
+			Servers: []types.ListedServer{
+				{ServerId: adapterhelpers.PtrString("s-01234567890abcdef")},
+			},
+		},
+		DescribeServerOutput: &transferDescribeServerOutput,
+	}
+
+	servers, err := transferServerListFunc(context.Background(), client, "123456789012.eu-west-1")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(servers) != 1 {
+		t.Fatalf("expected 1 server, got %v", len(servers))
+	}
+
+	// The full description should be used rather than the summary
+	if servers[0].EndpointDetails == nil {
+		t.Error("expected endpoint details to be populated")
+	}
+}
+
+func TestNewTransferServerAdapter(t *testing.T) {
+	client, account, region := transferGetAutoConfig(t)
+
+	adapter := NewTransferServerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,253 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// TransferUserDetails A user along with the server it belongs to. The server's
+// domain is needed to know whether home directories are in S3 or EFS
+type TransferUserDetails struct {
+	ServerId string
+	Domain   types.Domain
+	User     types.DescribedUser
+}
+
+// transferServerDomain Gets the storage domain of a server
+func transferServerDomain(ctx context.Context, client TransferClient, serverID string) (types.Domain, error) {
+	out, err := client.DescribeServer(ctx, &transfer.DescribeServerInput{
+		ServerId: &serverID,
+	})
+
+	if err != nil {
+		return "", err
+	}
+
+	if out.Server == nil {
+		return "", &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "server was nil",
+		}
+	}
+
+	return out.Server.Domain, nil
+}
+
+func transferDescribeUser(ctx context.Context, client TransferClient, serverID string, userName string, domain types.Domain) (*TransferUserDetails, error) {
+	out, err := client.DescribeUser(ctx, &transfer.DescribeUserInput{
+		ServerId: &serverID,
+		UserName: &userName,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.User == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "user was nil",
+		}
+	}
+
+	return &TransferUserDetails{
+		ServerId: serverID,
+		Domain:   domain,
+		User:     *out.User,
+	}, nil
+}
+
+// transferUserGetFunc Gets a user by its unique name: {serverId}/{userName}
+func transferUserGetFunc(ctx context.Context, client TransferClient, scope, query string) (*TransferUserDetails, error) {
+	serverID, userName, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {serverId}/{userName}",
+		}
+	}
+
+	domain, err := transferServerDomain(ctx, client, serverID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return transferDescribeUser(ctx, client, serverID, userName, domain)
+}
+
+// transferUserSearchFunc Lists all users on a server, or gets a user by ARN.
+// User ARNs are in the format
+// arn:aws:transfer:{region}:{account}:user/{serverId}/{userName}
+func transferUserSearchFunc(ctx context.Context, client TransferClient, scope, query string) ([]*TransferUserDetails, error) {
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		user, err := transferUserGetFunc(ctx, client, scope, a.ResourceID())
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*TransferUserDetails{user}, nil
+	}
+
+	domain, err := transferServerDomain(ctx, client, query)
+
+	if err != nil {
+		return nil, err
+	}
+
+	paginator := transfer.NewListUsersPaginator(client, &transfer.ListUsersInput{
+		ServerId: &query,
+	})
+
+	users := make([]*TransferUserDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the home directory mappings
+		for _, summary := range out.Users {
+			if summary.UserName == nil {
+				continue
+			}
+
+			user, err := transferDescribeUser(ctx, client, query, *summary.UserName, domain)
+
+			if err != nil {
+				return nil, err
+			}
+
+			users = append(users, user)
+		}
+	}
+
+	return users, nil
+}
+
+func transferUserItemMapper(_, scope string, awsItem *TransferUserDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		types.DescribedUser
+		ServerId string
+		Domain   types.Domain
+	}{
+		DescribedUser: awsItem.User,
+		ServerId:      awsItem.ServerId,
+		Domain:        awsItem.Domain,
+	}, "tags")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.User.UserName == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "user is missing its name",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {serverId}/{userName}
+	if err = attributes.Set("UniqueName", awsItem.ServerId+"/"+*awsItem.User.UserName); err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "transfer-user",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		Tags:            transferTagsToMap(awsItem.User.Tags),
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "transfer-server",
+					Method: sdp.QueryMethod_GET,
+					Query:  awsItem.ServerId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The user can't log in if the server changes
+					In: true,
+					// The user can't affect the server
+					Out: false,
+				},
+			},
+		},
+	}
+
+	if awsItem.User.Role != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, iamRoleLink(*awsItem.User.Role, scope))
+	}
+
+	// Logical home directories map virtual paths to any number of targets,
+	// otherwise the home directory is the path itself
+	paths := make([]string, 0)
+
+	if awsItem.User.HomeDirectory != nil {
+		paths = append(paths, *awsItem.User.HomeDirectory)
+	}
+
+	for _, mapping := range awsItem.User.HomeDirectoryMappings {
+		if mapping.Target != nil {
+			paths = append(paths, *mapping.Target)
+		}
+	}
+
+	seen := make(map[string]bool)
+
+	for _, path := range paths {
+		link := transferStorageLink(path, awsItem.Domain, scope)
+
+		if link == nil || seen[link.GetQuery().GetQuery()] {
+			continue
+		}
+
+		seen[link.GetQuery().GetQuery()] = true
+		item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+	}
+
+	return &item, nil
+}
+
+func NewTransferUserAdapter(client TransferClient, accountID string, region string) *adapterhelpers.GetListAdapter[*TransferUserDetails, TransferClient, *transfer.Options] {
+	return &adapterhelpers.GetListAdapter[*TransferUserDetails, TransferClient, *transfer.Options]{
+		ItemType:        "transfer-user",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: transferUserAdapterMetadata,
+		GetFunc:         transferUserGetFunc,
+		// Users can only be listed per server
+		DisableList: true,
+		SearchFunc:  transferUserSearchFunc,
+		ItemMapper:  transferUserItemMapper,
+	}
+}
+
+var transferUserAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "transfer-user",
+	DescriptiveName: "Transfer Family User",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		Search:            true,
+		GetDescription:    "Get a Transfer Family user by {serverId}/{userName}",
+		SearchDescription: "Search for Transfer Family users by server ID or ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_transfer_user.id"},
+	},
+	PotentialLinks: []string{"transfer-server", "iam-role", "s3-bucket", "efs-file-system"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_SECURITY,
+})
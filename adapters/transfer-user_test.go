@@ -0,0 +1,194 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+var transferDescribeUserOutput = transfer.DescribeUserOutput{
+	ServerId: adapterhelpers.PtrString("s-01234567890abcdef"),
+	User: &types.DescribedUser{
+		Arn:               adapterhelpers.PtrString("arn:aws:transfer:eu-west-1:123456789012:user/s-01234567890abcdef/acme"),
+		UserName:          adapterhelpers.PtrString("acme"),
+		Role:              adapterhelpers.PtrString("arn:aws:iam::123456789012:role/transfer-acme"),
+		HomeDirectoryType: types.HomeDirectoryTypeLogical,
+		HomeDirectoryMappings: []types.HomeDirectoryMapEntry{
+			{
+				Entry:  adapterhelpers.PtrString("/inbound"),
+				Target: adapterhelpers.PtrString("/partner-uploads/acme/inbound"),
+			},
+			{
+				Entry:  adapterhelpers.PtrString("/outbound"),
+				Target: adapterhelpers.PtrString("/partner-uploads/acme/outbound"),
+			},
+			{
+				Entry:  adapterhelpers.PtrString("/reports"),
+				Target: adapterhelpers.PtrString("/partner-reports/acme"),
+			},
+		},
+		SshPublicKeys: []types.SshPublicKey{
+			{
+				SshPublicKeyId:   adapterhelpers.PtrString("key-0123456789abcdef0"),
+				SshPublicKeyBody: adapterhelpers.PtrString("ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIExample acme"),
+				DateImported:     adapterhelpers.PtrTime(time.Now()),
+			},
+		},
+		Tags: []types.Tag{
+			{
+				Key:   adapterhelpers.PtrString("partner"),
+				Value: adapterhelpers.PtrString("acme"),
+			},
+		},
+	},
+}
+
+func TestTransferUserItemMapper(t *testing.T) {
+	item, err := transferUserItemMapper("", "123456789012.eu-west-1", &TransferUserDetails{
+		ServerId: "s-01234567890abcdef",
+		Domain:   types.DomainS3,
+		User:     *transferDescribeUserOutput.User,
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.UniqueAttributeValue() != "s-01234567890abcdef/acme" {
+		t.Errorf("expected unique attribute value s-01234567890abcdef/acme, got %v", item.UniqueAttributeValue())
+	}
+
+	if item.GetTags()["partner"] != "acme" {
+		t.Errorf("expected tag partner=acme, got %v", item.GetTags())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "transfer-server",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "s-01234567890abcdef",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/transfer-acme",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "partner-uploads",
+			ExpectedScope:  "123456789012",
+		},
+		{
+			ExpectedType:   "s3-bucket",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "partner-reports",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+
+	// The bucket that is mapped twice should only be linked once
+	if len(item.GetLinkedItemQueries()) != len(tests) {
+		t.Errorf("expected %v linked items, got %v", len(tests), len(item.GetLinkedItemQueries()))
+	}
+}
+
+func TestTransferUserItemMapperEFS(t *testing.T) {
+	item, err := transferUserItemMapper("", "123456789012.eu-west-1", &TransferUserDetails{
+		ServerId: "s-01234567890abcdef",
+		Domain:   types.DomainEfs,
+		User: types.DescribedUser{
+			UserName:          adapterhelpers.PtrString("globex"),
+			HomeDirectoryType: types.HomeDirectoryTypePath,
+			HomeDirectory:     adapterhelpers.PtrString("/fs-0123456789abcdef0/home/globex"),
+		},
+	})
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "efs-file-system",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "fs-0123456789abcdef0",
+			ExpectedScope:  "123456789012.eu-west-1",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestTransferUserSearchFunc(t *testing.T) {
+	client := TransferTestClient{
+		DescribeServerOutput: &transferDescribeServerOutput,
+		DescribeUserOutput:   &transferDescribeUserOutput,
+		ListUsersOutput: &transfer.ListUsersOutput{
+			ServerId: adapterhelpers.PtrString("s-01234567890abcdef"),
+			Users: []types.ListedUser{
+				{UserName: adapterhelpers.PtrString("acme")},
+			},
+		},
+	}
+
+	users, err := transferUserSearchFunc(context.Background(), client, "123456789012.eu-west-1", "s-01234567890abcdef")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %v", len(users))
+	}
+
+	if users[0].ServerId != "s-01234567890abcdef" {
+		t.Errorf("expected server ID s-01234567890abcdef, got %v", users[0].ServerId)
+	}
+
+	if users[0].Domain != types.DomainS3 {
+		t.Errorf("expected domain S3, got %v", users[0].Domain)
+	}
+
+	users, err = transferUserSearchFunc(context.Background(), client, "123456789012.eu-west-1", "arn:aws:transfer:eu-west-1:123456789012:user/s-01234567890abcdef/acme")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(users) != 1 {
+		t.Fatalf("expected 1 user, got %v", len(users))
+	}
+
+	if _, err = transferUserGetFunc(context.Background(), client, "123456789012.eu-west-1", "acme"); err == nil {
+		t.Error("expected an error for a query without a server ID")
+	}
+}
+
+func TestNewTransferUserAdapter(t *testing.T) {
+	client, account, region := transferGetAutoConfig(t)
+
+	adapter := NewTransferUserAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter:  adapter,
+		Timeout:  10 * time.Second,
+		SkipList: true,
+	}
+
+	test.Run(t)
+}
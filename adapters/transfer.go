@@ -0,0 +1,129 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type TransferClient interface {
+	DescribeServer(ctx context.Context, params *transfer.DescribeServerInput, optFns ...func(*transfer.Options)) (*transfer.DescribeServerOutput, error)
+	DescribeUser(ctx context.Context, params *transfer.DescribeUserInput, optFns ...func(*transfer.Options)) (*transfer.DescribeUserOutput, error)
+	DescribeConnector(ctx context.Context, params *transfer.DescribeConnectorInput, optFns ...func(*transfer.Options)) (*transfer.DescribeConnectorOutput, error)
+
+	transfer.ListServersAPIClient
+	transfer.ListUsersAPIClient
+	transfer.ListConnectorsAPIClient
+}
+
+func transferTagsToMap(tags []types.Tag) map[string]string {
+	tagsMap := make(map[string]string)
+
+	for _, tag := range tags {
+		if tag.Key != nil && tag.Value != nil {
+			tagsMap[*tag.Key] = *tag.Value
+		}
+	}
+
+	return tagsMap
+}
+
+// transferStorageLink Links to the S3 bucket or EFS file system that a path
+// is in. Paths are in the format /{bucket}/{prefix} or /{fileSystemId}/{path}
+// depending on the domain of the server
+func transferStorageLink(path string, domain types.Domain, scope string) *sdp.LinkedItemQuery {
+	root, _, _ := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+
+	if root == "" {
+		return nil
+	}
+
+	blastPropagation := &sdp.BlastPropagation{
+		// Changing the bucket or file system will affect what the user can
+		// access
+		In: true,
+		// Users can upload and delete files
+		Out: true,
+	}
+
+	if domain == types.DomainEfs {
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "efs-file-system",
+				Method: sdp.QueryMethod_GET,
+				Query:  root,
+				Scope:  scope,
+			},
+			BlastPropagation: blastPropagation,
+		}
+	}
+
+	// Buckets are global so are in the account scope
+	accountID, _, _ := adapterhelpers.ParseScope(scope)
+
+	return &sdp.LinkedItemQuery{
+		Query: &sdp.Query{
+			Type:   "s3-bucket",
+			Method: sdp.QueryMethod_GET,
+			Query:  root,
+			Scope:  adapterhelpers.FormatScope(accountID, ""),
+		},
+		BlastPropagation: blastPropagation,
+	}
+}
+
+// transferSecretLink Links to a Secrets Manager secret which can be referenced
+// by either name or ARN
+func transferSecretLink(secret string, scope string) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   "secretsmanager-secret",
+		Method: sdp.QueryMethod_GET,
+		Query:  secret,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(secret); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query: query,
+		BlastPropagation: &sdp.BlastPropagation{
+			// Changing the credentials will stop the connector from
+			// authenticating with the partner
+			In: true,
+			// The connector can't affect the secret
+			Out: false,
+		},
+	}
+}
+
+// transferEgressIPLinks Links to the static IPs that Transfer Family uses to
+// connect to partners, which partners often allowlist
+func transferEgressIPLinks(ips []string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	for _, ip := range ips {
+		links = append(links, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ip",
+				Method: sdp.QueryMethod_GET,
+				Query:  ip,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// IPs are always linked
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	return links
+}
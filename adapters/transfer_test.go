@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/transfer"
+	"github.com/aws/aws-sdk-go-v2/service/transfer/types"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type TransferTestClient struct {
+	DescribeServerOutput    *transfer.DescribeServerOutput
+	DescribeUserOutput      *transfer.DescribeUserOutput
+	DescribeConnectorOutput *transfer.DescribeConnectorOutput
+	ListServersOutput       *transfer.ListServersOutput
+	ListUsersOutput         *transfer.ListUsersOutput
+	ListConnectorsOutput    *transfer.ListConnectorsOutput
+}
+
+func (t TransferTestClient) DescribeServer(context.Context, *transfer.DescribeServerInput, ...func(*transfer.Options)) (*transfer.DescribeServerOutput, error) {
+	return t.DescribeServerOutput, nil
+}
+
+func (t TransferTestClient) DescribeUser(context.Context, *transfer.DescribeUserInput, ...func(*transfer.Options)) (*transfer.DescribeUserOutput, error) {
+	return t.DescribeUserOutput, nil
+}
+
+func (t TransferTestClient) DescribeConnector(context.Context, *transfer.DescribeConnectorInput, ...func(*transfer.Options)) (*transfer.DescribeConnectorOutput, error) {
+	return t.DescribeConnectorOutput, nil
+}
+
+func (t TransferTestClient) ListServers(context.Context, *transfer.ListServersInput, ...func(*transfer.Options)) (*transfer.ListServersOutput, error) {
+	return t.ListServersOutput, nil
+}
+
+func (t TransferTestClient) ListUsers(context.Context, *transfer.ListUsersInput, ...func(*transfer.Options)) (*transfer.ListUsersOutput, error) {
+	return t.ListUsersOutput, nil
+}
+
+func (t TransferTestClient) ListConnectors(context.Context, *transfer.ListConnectorsInput, ...func(*transfer.Options)) (*transfer.ListConnectorsOutput, error) {
+	return t.ListConnectorsOutput, nil
+}
+
+func transferGetAutoConfig(t *testing.T) (*transfer.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := transfer.NewFromConfig(config)
+
+	return client, account, region
+}
+
+func TestTransferStorageLink(t *testing.T) {
+	tests := []struct {
+		Path          string
+		Domain        types.Domain
+		ExpectedType  string
+		ExpectedQuery string
+		ExpectedScope string
+	}{
+		{
+			Path:          "/partner-uploads/acme",
+			Domain:        types.DomainS3,
+			ExpectedType:  "s3-bucket",
+			ExpectedQuery: "partner-uploads",
+			ExpectedScope: "123456789012",
+		},
+		{
+			Path:          "/partner-uploads",
+			Domain:        types.DomainS3,
+			ExpectedType:  "s3-bucket",
+			ExpectedQuery: "partner-uploads",
+			ExpectedScope: "123456789012",
+		},
+		{
+			Path:          "/fs-0123456789abcdef0/home/acme",
+			Domain:        types.DomainEfs,
+			ExpectedType:  "efs-file-system",
+			ExpectedQuery: "fs-0123456789abcdef0",
+			ExpectedScope: "123456789012.eu-west-1",
+		},
+	}
+
+	for _, test := range tests {
+		link := transferStorageLink(test.Path, test.Domain, "123456789012.eu-west-1")
+
+		if link == nil {
+			t.Fatalf("expected a link for %v", test.Path)
+		}
+
+		if link.GetQuery().GetType() != test.ExpectedType {
+			t.Errorf("expected type %v for %v, got %v", test.ExpectedType, test.Path, link.GetQuery().GetType())
+		}
+
+		if link.GetQuery().GetQuery() != test.ExpectedQuery {
+			t.Errorf("expected query %v for %v, got %v", test.ExpectedQuery, test.Path, link.GetQuery().GetQuery())
+		}
+
+		if link.GetQuery().GetScope() != test.ExpectedScope {
+			t.Errorf("expected scope %v for %v, got %v", test.ExpectedScope, test.Path, link.GetQuery().GetScope())
+		}
+	}
+
+	if link := transferStorageLink("/", types.DomainS3, "123456789012.eu-west-1"); link != nil {
+		t.Errorf("expected no link for the root path, got %v", link)
+	}
+}
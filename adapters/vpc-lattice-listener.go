@@ -0,0 +1,266 @@
+package adapters
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// VPCLatticeListenerDetails A listener along with its rules. The default rule
+// isn't included since it's the same as the listener's default action
+type VPCLatticeListenerDetails struct {
+	Listener *vpclattice.GetListenerOutput
+	Rules    []*vpclattice.GetRuleOutput
+}
+
+// vpcLatticeListenerGetFunc Gets a listener by its unique name:
+// {serviceId}/{listenerId}
+func vpcLatticeListenerGetFunc(ctx context.Context, client VPCLatticeClient, scope, query string) (*VPCLatticeListenerDetails, error) {
+	serviceID, listenerID, found := strings.Cut(query, "/")
+
+	if !found {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_NOTFOUND,
+			ErrorString: "query must be in the format {serviceId}/{listenerId}",
+		}
+	}
+
+	out, err := client.GetListener(ctx, &vpclattice.GetListenerInput{
+		ServiceIdentifier:  &serviceID,
+		ListenerIdentifier: &listenerID,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := VPCLatticeListenerDetails{
+		Listener: out,
+	}
+
+	paginator := vpclattice.NewListRulesPaginator(client, &vpclattice.ListRulesInput{
+		ServiceIdentifier:  &serviceID,
+		ListenerIdentifier: &listenerID,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the match conditions or actions
+		for _, summary := range page.Items {
+			if summary.Id == nil || (summary.IsDefault != nil && *summary.IsDefault) {
+				continue
+			}
+
+			rule, err := client.GetRule(ctx, &vpclattice.GetRuleInput{
+				ServiceIdentifier:  &serviceID,
+				ListenerIdentifier: &listenerID,
+				RuleIdentifier:     summary.Id,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			details.Rules = append(details.Rules, rule)
+		}
+	}
+
+	return &details, nil
+}
+
+// vpcLatticeListenerListFunc Lists all listeners. These are listed
+// per-service so we need to list the services first
+func vpcLatticeListenerListFunc(ctx context.Context, client VPCLatticeClient, scope string) ([]*VPCLatticeListenerDetails, error) {
+	paginator := vpclattice.NewListServicesPaginator(client, &vpclattice.ListServicesInput{})
+
+	listeners := make([]*VPCLatticeListenerDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, service := range out.Items {
+			if service.Id == nil {
+				continue
+			}
+
+			serviceListeners, err := vpcLatticeListenerListByService(ctx, client, scope, *service.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			listeners = append(listeners, serviceListeners...)
+		}
+	}
+
+	return listeners, nil
+}
+
+func vpcLatticeListenerListByService(ctx context.Context, client VPCLatticeClient, scope, serviceID string) ([]*VPCLatticeListenerDetails, error) {
+	paginator := vpclattice.NewListListenersPaginator(client, &vpclattice.ListListenersInput{
+		ServiceIdentifier: &serviceID,
+	})
+
+	listeners := make([]*VPCLatticeListenerDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Items {
+			if summary.Id == nil {
+				continue
+			}
+
+			details, err := vpcLatticeListenerGetFunc(ctx, client, scope, serviceID+"/"+*summary.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			listeners = append(listeners, details)
+		}
+	}
+
+	return listeners, nil
+}
+
+// vpcLatticeListenerSearchFunc Searches for listeners either by ARN, or by the
+// ID of the service that they belong to
+func vpcLatticeListenerSearchFunc(ctx context.Context, client VPCLatticeClient, scope, query string) ([]*VPCLatticeListenerDetails, error) {
+	// The ARN is in the format
+	// arn:aws:vpc-lattice:region:account:service/{serviceId}/listener/{listenerId}
+	if a, err := adapterhelpers.ParseARN(query); err == nil {
+		sections := strings.Split(a.ResourceID(), "/")
+
+		if len(sections) != 3 || sections[1] != "listener" {
+			return nil, &sdp.QueryError{
+				ErrorType:   sdp.QueryError_NOTFOUND,
+				ErrorString: "ARN is not a VPC Lattice listener",
+			}
+		}
+
+		listener, err := vpcLatticeListenerGetFunc(ctx, client, scope, sections[0]+"/"+sections[2])
+
+		if err != nil {
+			return nil, err
+		}
+
+		return []*VPCLatticeListenerDetails{listener}, nil
+	}
+
+	return vpcLatticeListenerListByService(ctx, client, scope, query)
+}
+
+func vpcLatticeListenerItemMapper(_, scope string, awsItem *VPCLatticeListenerDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*vpclattice.GetListenerOutput
+		Rules []*vpclattice.GetRuleOutput
+	}{
+		GetListenerOutput: awsItem.Listener,
+		Rules:             awsItem.Rules,
+	}, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if awsItem.Listener.ServiceId == nil || awsItem.Listener.Id == nil {
+		return nil, &sdp.QueryError{
+			ErrorType:   sdp.QueryError_OTHER,
+			ErrorString: "listener is missing its ID or service ID",
+		}
+	}
+
+	// The uniqueAttributeValue for this is a custom field:
+	// {serviceId}/{listenerId}
+	attributes.Set("UniqueName", *awsItem.Listener.ServiceId+"/"+*awsItem.Listener.Id)
+
+	item := sdp.Item{
+		Type:            "vpc-lattice-listener",
+		UniqueAttribute: "UniqueName",
+		Attributes:      attributes,
+		Scope:           scope,
+		LinkedItemQueries: []*sdp.LinkedItemQuery{
+			{
+				Query: &sdp.Query{
+					Type:   "vpc-lattice-service",
+					Method: sdp.QueryMethod_GET,
+					Query:  *awsItem.Listener.ServiceId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The listener is part of the service
+					In:  true,
+					Out: true,
+				},
+			},
+		},
+	}
+
+	if awsItem.Listener.DefaultAction != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, vpcLatticeRuleActionLinks(awsItem.Listener.DefaultAction, scope)...)
+	}
+
+	for _, rule := range awsItem.Rules {
+		if rule.Action != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, vpcLatticeRuleActionLinks(rule.Action, scope)...)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewVPCLatticeListenerAdapter(client VPCLatticeClient, accountID string, region string) *adapterhelpers.GetListAdapter[*VPCLatticeListenerDetails, VPCLatticeClient, *vpclattice.Options] {
+	return &adapterhelpers.GetListAdapter[*VPCLatticeListenerDetails, VPCLatticeClient, *vpclattice.Options]{
+		ItemType:        "vpc-lattice-listener",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: vpcLatticeListenerAdapterMetadata,
+		GetFunc:         vpcLatticeListenerGetFunc,
+		ListFunc:        vpcLatticeListenerListFunc,
+		SearchFunc:      vpcLatticeListenerSearchFunc,
+		ItemMapper:      vpcLatticeListenerItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *VPCLatticeListenerDetails, client VPCLatticeClient) (map[string]string, error) {
+			return vpcLatticeListTags(ctx, client, details.Listener.Arn)
+		},
+	}
+}
+
+var vpcLatticeListenerAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "vpc-lattice-listener",
+	DescriptiveName: "VPC Lattice Listener",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a listener by {serviceId}/{listenerId}",
+		ListDescription:   "List all listeners",
+		SearchDescription: "Search for listeners by ARN or service ID",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{
+			TerraformMethod:   sdp.QueryMethod_SEARCH,
+			TerraformQueryMap: "aws_vpclattice_listener.arn",
+		},
+	},
+	PotentialLinks: []string{"vpc-lattice-service", "vpc-lattice-target-group"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
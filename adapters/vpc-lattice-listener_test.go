@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVPCLatticeListenerGetFunc(t *testing.T) {
+	client := VPCLatticeTestClient{
+		GetListenerOutput: &vpclattice.GetListenerOutput{
+			Arn: adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:service/svc-0a1b2c3d4e5f67890/listener/listener-0a1b2c3d4e5f67890"),
+			DefaultAction: &types.RuleActionMemberForward{
+				Value: types.ForwardAction{
+					TargetGroups: []types.WeightedTargetGroup{
+						{
+							TargetGroupIdentifier: adapterhelpers.PtrString("tg-0a1b2c3d4e5f67890"),
+							Weight:                adapterhelpers.PtrInt32(100),
+						},
+					},
+				},
+			},
+			Id:         adapterhelpers.PtrString("listener-0a1b2c3d4e5f67890"),
+			Name:       adapterhelpers.PtrString("https"),
+			Port:       adapterhelpers.PtrInt32(443),
+			Protocol:   types.ListenerProtocolHttps,
+			ServiceArn: adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:service/svc-0a1b2c3d4e5f67890"),
+			ServiceId:  adapterhelpers.PtrString("svc-0a1b2c3d4e5f67890"),
+		},
+		ListRulesOutput: &vpclattice.ListRulesOutput{
+			Items: []types.RuleSummary{
+				{
+					Id:        adapterhelpers.PtrString("rule-0a1b2c3d4e5f67890"),
+					IsDefault: adapterhelpers.PtrBool(false),
+				},
+				{
+					Id:        adapterhelpers.PtrString("rule-default"),
+					IsDefault: adapterhelpers.PtrBool(true),
+				},
+			},
+		},
+		GetRuleOutput: &vpclattice.GetRuleOutput{
+			Action: &types.RuleActionMemberForward{
+				Value: types.ForwardAction{
+					TargetGroups: []types.WeightedTargetGroup{
+						{
+							TargetGroupIdentifier: adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:targetgroup/tg-1b2c3d4e5f678901a"),
+							Weight:                adapterhelpers.PtrInt32(100),
+						},
+					},
+				},
+			},
+			Id:       adapterhelpers.PtrString("rule-0a1b2c3d4e5f67890"),
+			Priority: adapterhelpers.PtrInt32(10),
+		},
+	}
+
+	details, err := vpcLatticeListenerGetFunc(context.Background(), client, "123456789012.eu-west-2", "svc-0a1b2c3d4e5f67890/listener-0a1b2c3d4e5f67890")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The default rule should be skipped
+	if len(details.Rules) != 1 {
+		t.Errorf("expected 1 rule, got %v", len(details.Rules))
+	}
+
+	item, err := vpcLatticeListenerItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "vpc-lattice-service",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "svc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "vpc-lattice-target-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "tg-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "vpc-lattice-target-group",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:vpc-lattice:eu-west-2:123456789012:targetgroup/tg-1b2c3d4e5f678901a",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestVPCLatticeListenerGetFuncBadQuery(t *testing.T) {
+	_, err := vpcLatticeListenerGetFunc(context.Background(), VPCLatticeTestClient{}, "123456789012.eu-west-2", "listener-0a1b2c3d4e5f67890")
+
+	if err == nil {
+		t.Error("expected an error for a query without a service ID")
+	}
+}
+
+func TestNewVPCLatticeListenerAdapter(t *testing.T) {
+	client, account, region := vpclatticeGetAutoConfig(t)
+
+	adapter := NewVPCLatticeListenerAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
@@ -0,0 +1,229 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// VPCLatticeServiceNetworkDetails A service network along with the VPCs and
+// services that are associated with it, and its parsed auth policy
+type VPCLatticeServiceNetworkDetails struct {
+	ServiceNetwork      *vpclattice.GetServiceNetworkOutput
+	VpcAssociations     []*vpclattice.GetServiceNetworkVpcAssociationOutput
+	ServiceAssociations []types.ServiceNetworkServiceAssociationSummary
+	AuthPolicy          *policy.Policy
+}
+
+func vpcLatticeServiceNetworkGetFunc(ctx context.Context, client VPCLatticeClient, scope, query string) (*VPCLatticeServiceNetworkDetails, error) {
+	out, err := client.GetServiceNetwork(ctx, &vpclattice.GetServiceNetworkInput{
+		ServiceNetworkIdentifier: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := VPCLatticeServiceNetworkDetails{
+		ServiceNetwork: out,
+		AuthPolicy:     vpcLatticeAuthPolicy(ctx, client, out.AuthType, out.Id),
+	}
+
+	vpcPaginator := vpclattice.NewListServiceNetworkVpcAssociationsPaginator(client, &vpclattice.ListServiceNetworkVpcAssociationsInput{
+		ServiceNetworkIdentifier: out.Id,
+	})
+
+	for vpcPaginator.HasMorePages() {
+		page, err := vpcPaginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the security groups, so we need to get
+		// each association
+		for _, summary := range page.Items {
+			if summary.Id == nil {
+				continue
+			}
+
+			association, err := client.GetServiceNetworkVpcAssociation(ctx, &vpclattice.GetServiceNetworkVpcAssociationInput{
+				ServiceNetworkVpcAssociationIdentifier: summary.Id,
+			})
+
+			if err != nil {
+				return nil, err
+			}
+
+			details.VpcAssociations = append(details.VpcAssociations, association)
+		}
+	}
+
+	servicePaginator := vpclattice.NewListServiceNetworkServiceAssociationsPaginator(client, &vpclattice.ListServiceNetworkServiceAssociationsInput{
+		ServiceNetworkIdentifier: out.Id,
+	})
+
+	for servicePaginator.HasMorePages() {
+		page, err := servicePaginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.ServiceAssociations = append(details.ServiceAssociations, page.Items...)
+	}
+
+	return &details, nil
+}
+
+func vpcLatticeServiceNetworkListFunc(ctx context.Context, client VPCLatticeClient, scope string) ([]*VPCLatticeServiceNetworkDetails, error) {
+	paginator := vpclattice.NewListServiceNetworksPaginator(client, &vpclattice.ListServiceNetworksInput{})
+
+	serviceNetworks := make([]*VPCLatticeServiceNetworkDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Items {
+			if summary.Id == nil {
+				continue
+			}
+
+			details, err := vpcLatticeServiceNetworkGetFunc(ctx, client, scope, *summary.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			serviceNetworks = append(serviceNetworks, details)
+		}
+	}
+
+	return serviceNetworks, nil
+}
+
+func vpcLatticeServiceNetworkItemMapper(_, scope string, awsItem *VPCLatticeServiceNetworkDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*vpclattice.GetServiceNetworkOutput
+		VpcAssociations     []*vpclattice.GetServiceNetworkVpcAssociationOutput
+		ServiceAssociations []types.ServiceNetworkServiceAssociationSummary
+		AuthPolicy          *policy.Policy
+	}{
+		GetServiceNetworkOutput: awsItem.ServiceNetwork,
+		VpcAssociations:         awsItem.VpcAssociations,
+		ServiceAssociations:     awsItem.ServiceAssociations,
+		AuthPolicy:              awsItem.AuthPolicy,
+	}, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "vpc-lattice-service-network",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+	}
+
+	for _, association := range awsItem.VpcAssociations {
+		if association.VpcId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-vpc",
+					Method: sdp.QueryMethod_GET,
+					Query:  *association.VpcId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// Clients in the VPC reach services through the network
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		for _, securityGroupID := range association.SecurityGroupIds {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "ec2-security-group",
+					Method: sdp.QueryMethod_GET,
+					Query:  securityGroupID,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The security group controls which clients in the VPC
+					// can reach the network
+					In: true,
+					// The network can't affect the security group
+					Out: false,
+				},
+			})
+		}
+	}
+
+	for _, association := range awsItem.ServiceAssociations {
+		identifier := association.ServiceArn
+		if identifier == nil {
+			identifier = association.ServiceId
+		}
+
+		if identifier == nil {
+			continue
+		}
+
+		item.LinkedItemQueries = append(item.LinkedItemQueries, vpcLatticeIdentifierLink("vpc-lattice-service", *identifier, scope, &sdp.BlastPropagation{
+			// Clients can't use the network if the service breaks
+			In: true,
+			// Changes to the network affect how the service is reached
+			Out: true,
+		}))
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.AuthPolicy)...)
+
+	return &item, nil
+}
+
+func NewVPCLatticeServiceNetworkAdapter(client VPCLatticeClient, accountID string, region string) *adapterhelpers.GetListAdapter[*VPCLatticeServiceNetworkDetails, VPCLatticeClient, *vpclattice.Options] {
+	return &adapterhelpers.GetListAdapter[*VPCLatticeServiceNetworkDetails, VPCLatticeClient, *vpclattice.Options]{
+		ItemType:        "vpc-lattice-service-network",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: vpcLatticeServiceNetworkAdapterMetadata,
+		GetFunc:         vpcLatticeServiceNetworkGetFunc,
+		ListFunc:        vpcLatticeServiceNetworkListFunc,
+		ItemMapper:      vpcLatticeServiceNetworkItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *VPCLatticeServiceNetworkDetails, client VPCLatticeClient) (map[string]string, error) {
+			return vpcLatticeListTags(ctx, client, details.ServiceNetwork.Arn)
+		},
+	}
+}
+
+var vpcLatticeServiceNetworkAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "vpc-lattice-service-network",
+	DescriptiveName: "VPC Lattice Service Network",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a service network by ID",
+		ListDescription:   "List all service networks",
+		SearchDescription: "Search for service networks by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpclattice_service_network.id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "ec2-security-group", "vpc-lattice-service", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVPCLatticeServiceNetworkGetFunc(t *testing.T) {
+	client := VPCLatticeTestClient{
+		GetServiceNetworkOutput: &vpclattice.GetServiceNetworkOutput{
+			Arn:                        adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:servicenetwork/sn-0a1b2c3d4e5f67890"),
+			AuthType:                   types.AuthTypeAwsIam,
+			Id:                         adapterhelpers.PtrString("sn-0a1b2c3d4e5f67890"),
+			Name:                       adapterhelpers.PtrString("internal"),
+			NumberOfAssociatedServices: adapterhelpers.PtrInt64(1),
+			NumberOfAssociatedVPCs:     adapterhelpers.PtrInt64(1),
+		},
+		GetAuthPolicyOutput: &vpclattice.GetAuthPolicyOutput{
+			State: types.AuthPolicyStateActive,
+			Policy: adapterhelpers.PtrString(`{
+				"Version": "2012-10-17",
+				"Statement": [
+					{
+						"Effect": "Allow",
+						"Principal": {"AWS": "arn:aws:iam::123456789012:role/billing"},
+						"Action": "vpc-lattice-svcs:Invoke",
+						"Resource": "*"
+					}
+				]
+			}`),
+		},
+		ListServiceNetworkVpcAssociationsOutput: &vpclattice.ListServiceNetworkVpcAssociationsOutput{
+			Items: []types.ServiceNetworkVpcAssociationSummary{
+				{Id: adapterhelpers.PtrString("snva-0a1b2c3d4e5f67890")},
+			},
+		},
+		GetServiceNetworkVpcAssociationOutput: &vpclattice.GetServiceNetworkVpcAssociationOutput{
+			Id:               adapterhelpers.PtrString("snva-0a1b2c3d4e5f67890"),
+			SecurityGroupIds: []string{"sg-0a1b2c3d4e5f67890"},
+			Status:           types.ServiceNetworkVpcAssociationStatusActive,
+			VpcId:            adapterhelpers.PtrString("vpc-0a1b2c3d4e5f67890"),
+		},
+		ListServiceNetworkServiceAssociationsOutput: &vpclattice.ListServiceNetworkServiceAssociationsOutput{
+			Items: []types.ServiceNetworkServiceAssociationSummary{
+				{
+					ServiceArn: adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:210987654321:service/svc-0a1b2c3d4e5f67890"),
+					ServiceId:  adapterhelpers.PtrString("svc-0a1b2c3d4e5f67890"),
+				},
+			},
+		},
+	}
+
+	details, err := vpcLatticeServiceNetworkGetFunc(context.Background(), client, "123456789012.eu-west-2", "sn-0a1b2c3d4e5f67890")
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if details.AuthPolicy == nil {
+		t.Error("expected the auth policy to be parsed")
+	}
+
+	item, err := vpcLatticeServiceNetworkItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-security-group",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "sg-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "vpc-lattice-service",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:vpc-lattice:eu-west-2:210987654321:service/svc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "210987654321.eu-west-2",
+		},
+		{
+			ExpectedType:   "iam-role",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:iam::123456789012:role/billing",
+			ExpectedScope:  "123456789012",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewVPCLatticeServiceNetworkAdapter(t *testing.T) {
+	client, account, region := vpclatticeGetAutoConfig(t)
+
+	adapter := NewVPCLatticeServiceNetworkAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
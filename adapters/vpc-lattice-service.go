@@ -0,0 +1,210 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// VPCLatticeServiceDetails A service along with its parsed auth policy
+type VPCLatticeServiceDetails struct {
+	Service    *vpclattice.GetServiceOutput
+	AuthPolicy *policy.Policy
+}
+
+func vpcLatticeServiceGetFunc(ctx context.Context, client VPCLatticeClient, scope, query string) (*VPCLatticeServiceDetails, error) {
+	out, err := client.GetService(ctx, &vpclattice.GetServiceInput{
+		ServiceIdentifier: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &VPCLatticeServiceDetails{
+		Service:    out,
+		AuthPolicy: vpcLatticeAuthPolicy(ctx, client, out.AuthType, out.Id),
+	}, nil
+}
+
+func vpcLatticeServiceListFunc(ctx context.Context, client VPCLatticeClient, scope string) ([]*VPCLatticeServiceDetails, error) {
+	paginator := vpclattice.NewListServicesPaginator(client, &vpclattice.ListServicesInput{})
+
+	services := make([]*VPCLatticeServiceDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		// The summaries don't include the certificate or auth type
+		for _, summary := range out.Items {
+			if summary.Id == nil {
+				continue
+			}
+
+			details, err := vpcLatticeServiceGetFunc(ctx, client, scope, *summary.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			services = append(services, details)
+		}
+	}
+
+	return services, nil
+}
+
+func vpcLatticeServiceItemMapper(_, scope string, awsItem *VPCLatticeServiceDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*vpclattice.GetServiceOutput
+		AuthPolicy *policy.Policy
+	}{
+		GetServiceOutput: awsItem.Service,
+		AuthPolicy:       awsItem.AuthPolicy,
+	}, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "vpc-lattice-service",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          vpcLatticeStatusHealth(string(awsItem.Service.Status)),
+	}
+
+	if awsItem.Service.Id != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "vpc-lattice-listener",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Service.Id,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Listeners are deleted with the service, and route all of
+				// its traffic
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if awsItem.Service.CertificateArn != nil {
+		if a, err := adapterhelpers.ParseARN(*awsItem.Service.CertificateArn); err == nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "acm-certificate",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *awsItem.Service.CertificateArn,
+					Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// HTTPS listeners stop working if the certificate expires
+					In: true,
+					// The service can't affect the certificate
+					Out: false,
+				},
+			})
+		}
+	}
+
+	if awsItem.Service.CustomDomainName != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "dns",
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  *awsItem.Service.CustomDomainName,
+				Scope:  "global",
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// Clients can't find the service if the record changes
+				In:  true,
+				Out: true,
+			},
+		})
+	}
+
+	if dnsEntry := awsItem.Service.DnsEntry; dnsEntry != nil {
+		if dnsEntry.DomainName != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "dns",
+					Method: sdp.QueryMethod_SEARCH,
+					Query:  *dnsEntry.DomainName,
+					Scope:  "global",
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The generated name points at the service
+					In:  true,
+					Out: true,
+				},
+			})
+		}
+
+		if dnsEntry.HostedZoneId != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+				Query: &sdp.Query{
+					Type:   "route53-hosted-zone",
+					Method: sdp.QueryMethod_GET,
+					Query:  *dnsEntry.HostedZoneId,
+					Scope:  scope,
+				},
+				BlastPropagation: &sdp.BlastPropagation{
+					// The zone contains the service's records
+					In: true,
+					// The service can't affect the zone
+					Out: false,
+				},
+			})
+		}
+	}
+
+	item.LinkedItemQueries = append(item.LinkedItemQueries, LinksFromPolicy(awsItem.AuthPolicy)...)
+
+	return &item, nil
+}
+
+func NewVPCLatticeServiceAdapter(client VPCLatticeClient, accountID string, region string) *adapterhelpers.GetListAdapter[*VPCLatticeServiceDetails, VPCLatticeClient, *vpclattice.Options] {
+	return &adapterhelpers.GetListAdapter[*VPCLatticeServiceDetails, VPCLatticeClient, *vpclattice.Options]{
+		ItemType:        "vpc-lattice-service",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: vpcLatticeServiceAdapterMetadata,
+		GetFunc:         vpcLatticeServiceGetFunc,
+		ListFunc:        vpcLatticeServiceListFunc,
+		ItemMapper:      vpcLatticeServiceItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *VPCLatticeServiceDetails, client VPCLatticeClient) (map[string]string, error) {
+			return vpcLatticeListTags(ctx, client, details.Service.Arn)
+		},
+	}
+}
+
+var vpcLatticeServiceAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "vpc-lattice-service",
+	DescriptiveName: "VPC Lattice Service",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a service by ID",
+		ListDescription:   "List all services",
+		SearchDescription: "Search for services by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpclattice_service.id"},
+	},
+	PotentialLinks: []string{"vpc-lattice-listener", "acm-certificate", "dns", "route53-hosted-zone", "iam-role", "iam-user"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,92 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVPCLatticeServiceItemMapper(t *testing.T) {
+	details := &VPCLatticeServiceDetails{
+		Service: &vpclattice.GetServiceOutput{
+			Arn:              adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:service/svc-0a1b2c3d4e5f67890"),
+			AuthType:         types.AuthTypeNone,
+			CertificateArn:   adapterhelpers.PtrString("arn:aws:acm:eu-west-2:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111"),
+			CustomDomainName: adapterhelpers.PtrString("billing.internal.example.com"),
+			DnsEntry: &types.DnsEntry{
+				DomainName:   adapterhelpers.PtrString("billing-0a1b2c3d4e5f67890.7d67968.vpc-lattice-svcs.eu-west-2.on.aws"),
+				HostedZoneId: adapterhelpers.PtrString("Z0123456789ABCDEFGHIJ"),
+			},
+			Id:     adapterhelpers.PtrString("svc-0a1b2c3d4e5f67890"),
+			Name:   adapterhelpers.PtrString("billing"),
+			Status: types.ServiceStatusCreateFailed,
+		},
+	}
+
+	item, err := vpcLatticeServiceItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_ERROR {
+		t.Errorf("expected health to be ERROR, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "vpc-lattice-listener",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "svc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "acm-certificate",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:acm:eu-west-2:123456789012:certificate/a1b2c3d4-5678-90ab-cdef-EXAMPLE11111",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing.internal.example.com",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "dns",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "billing-0a1b2c3d4e5f67890.7d67968.vpc-lattice-svcs.eu-west-2.on.aws",
+			ExpectedScope:  "global",
+		},
+		{
+			ExpectedType:   "route53-hosted-zone",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "Z0123456789ABCDEFGHIJ",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestNewVPCLatticeServiceAdapter(t *testing.T) {
+	client, account, region := vpclatticeGetAutoConfig(t)
+
+	adapter := NewVPCLatticeServiceAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
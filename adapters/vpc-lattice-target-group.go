@@ -0,0 +1,239 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+// VPCLatticeTargetGroupDetails A target group along with its registered
+// targets and their health
+type VPCLatticeTargetGroupDetails struct {
+	TargetGroup *vpclattice.GetTargetGroupOutput
+	Targets     []types.TargetSummary
+}
+
+func vpcLatticeTargetGroupGetFunc(ctx context.Context, client VPCLatticeClient, scope, query string) (*VPCLatticeTargetGroupDetails, error) {
+	out, err := client.GetTargetGroup(ctx, &vpclattice.GetTargetGroupInput{
+		TargetGroupIdentifier: &query,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	details := VPCLatticeTargetGroupDetails{
+		TargetGroup: out,
+	}
+
+	paginator := vpclattice.NewListTargetsPaginator(client, &vpclattice.ListTargetsInput{
+		TargetGroupIdentifier: out.Id,
+	})
+
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		details.Targets = append(details.Targets, page.Items...)
+	}
+
+	return &details, nil
+}
+
+func vpcLatticeTargetGroupListFunc(ctx context.Context, client VPCLatticeClient, scope string) ([]*VPCLatticeTargetGroupDetails, error) {
+	paginator := vpclattice.NewListTargetGroupsPaginator(client, &vpclattice.ListTargetGroupsInput{})
+
+	targetGroups := make([]*VPCLatticeTargetGroupDetails, 0)
+
+	for paginator.HasMorePages() {
+		out, err := paginator.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, summary := range out.Items {
+			if summary.Id == nil {
+				continue
+			}
+
+			details, err := vpcLatticeTargetGroupGetFunc(ctx, client, scope, *summary.Id)
+
+			if err != nil {
+				return nil, err
+			}
+
+			targetGroups = append(targetGroups, details)
+		}
+	}
+
+	return targetGroups, nil
+}
+
+// vpcLatticeTargetLink Links to a registered target. What the ID refers to
+// depends on the type of the target group
+func vpcLatticeTargetLink(targetGroupType types.TargetGroupType, targetID, scope string) *sdp.LinkedItemQuery {
+	// Targets receive traffic from the target group, and requests fail if
+	// they are unhealthy, so these are tightly coupled
+	propagation := &sdp.BlastPropagation{
+		In:  true,
+		Out: true,
+	}
+
+	switch targetGroupType {
+	case types.TargetGroupTypeInstance:
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-instance",
+				Method: sdp.QueryMethod_GET,
+				Query:  targetID,
+				Scope:  scope,
+			},
+			BlastPropagation: propagation,
+		}
+	case types.TargetGroupTypeIp:
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ip",
+				Method: sdp.QueryMethod_GET,
+				Query:  targetID,
+				Scope:  "global",
+			},
+			BlastPropagation: propagation,
+		}
+	case types.TargetGroupTypeLambda, types.TargetGroupTypeAlb:
+		a, err := adapterhelpers.ParseARN(targetID)
+
+		if err != nil {
+			return nil
+		}
+
+		itemType := "lambda-function"
+		if targetGroupType == types.TargetGroupTypeAlb {
+			itemType = "elbv2-load-balancer"
+		}
+
+		return &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   itemType,
+				Method: sdp.QueryMethod_SEARCH,
+				Query:  targetID,
+				Scope:  adapterhelpers.FormatScope(a.AccountID, a.Region),
+			},
+			BlastPropagation: propagation,
+		}
+	}
+
+	return nil
+}
+
+func vpcLatticeTargetGroupItemMapper(_, scope string, awsItem *VPCLatticeTargetGroupDetails) (*sdp.Item, error) {
+	attributes, err := adapterhelpers.ToAttributesWithExclude(struct {
+		*vpclattice.GetTargetGroupOutput
+		Targets []types.TargetSummary
+	}{
+		GetTargetGroupOutput: awsItem.TargetGroup,
+		Targets:              awsItem.Targets,
+	}, "resultMetadata")
+
+	if err != nil {
+		return nil, err
+	}
+
+	item := sdp.Item{
+		Type:            "vpc-lattice-target-group",
+		UniqueAttribute: "Id",
+		Attributes:      attributes,
+		Scope:           scope,
+		Health:          vpcLatticeStatusHealth(string(awsItem.TargetGroup.Status)),
+	}
+
+	if item.GetHealth() == sdp.Health_HEALTH_OK {
+		// The target group itself is fine, but requests will fail if its
+		// targets can't serve them
+		for _, target := range awsItem.Targets {
+			if target.Status == types.TargetStatusUnhealthy {
+				item.Health = sdp.Health_HEALTH_WARNING.Enum()
+				break
+			}
+		}
+	}
+
+	if config := awsItem.TargetGroup.Config; config != nil && config.VpcIdentifier != nil {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, &sdp.LinkedItemQuery{
+			Query: &sdp.Query{
+				Type:   "ec2-vpc",
+				Method: sdp.QueryMethod_GET,
+				Query:  *config.VpcIdentifier,
+				Scope:  scope,
+			},
+			BlastPropagation: &sdp.BlastPropagation{
+				// The targets are reached through the VPC
+				In: true,
+				// The target group can't affect the VPC
+				Out: false,
+			},
+		})
+	}
+
+	for _, serviceArn := range awsItem.TargetGroup.ServiceArns {
+		item.LinkedItemQueries = append(item.LinkedItemQueries, vpcLatticeIdentifierLink("vpc-lattice-service", serviceArn, scope, &sdp.BlastPropagation{
+			// The service's requests are served by this target group
+			In:  true,
+			Out: true,
+		}))
+	}
+
+	for _, target := range awsItem.Targets {
+		if target.Id == nil {
+			continue
+		}
+
+		if link := vpcLatticeTargetLink(awsItem.TargetGroup.Type, *target.Id, scope); link != nil {
+			item.LinkedItemQueries = append(item.LinkedItemQueries, link)
+		}
+	}
+
+	return &item, nil
+}
+
+func NewVPCLatticeTargetGroupAdapter(client VPCLatticeClient, accountID string, region string) *adapterhelpers.GetListAdapter[*VPCLatticeTargetGroupDetails, VPCLatticeClient, *vpclattice.Options] {
+	return &adapterhelpers.GetListAdapter[*VPCLatticeTargetGroupDetails, VPCLatticeClient, *vpclattice.Options]{
+		ItemType:        "vpc-lattice-target-group",
+		Client:          client,
+		AccountID:       accountID,
+		Region:          region,
+		AdapterMetadata: vpcLatticeTargetGroupAdapterMetadata,
+		GetFunc:         vpcLatticeTargetGroupGetFunc,
+		ListFunc:        vpcLatticeTargetGroupListFunc,
+		ItemMapper:      vpcLatticeTargetGroupItemMapper,
+		ListTagsFunc: func(ctx context.Context, details *VPCLatticeTargetGroupDetails, client VPCLatticeClient) (map[string]string, error) {
+			return vpcLatticeListTags(ctx, client, details.TargetGroup.Arn)
+		},
+	}
+}
+
+var vpcLatticeTargetGroupAdapterMetadata = Metadata.Register(&sdp.AdapterMetadata{
+	Type:            "vpc-lattice-target-group",
+	DescriptiveName: "VPC Lattice Target Group",
+	SupportedQueryMethods: &sdp.AdapterSupportedQueryMethods{
+		Get:               true,
+		List:              true,
+		Search:            true,
+		GetDescription:    "Get a target group by ID",
+		ListDescription:   "List all target groups",
+		SearchDescription: "Search for target groups by ARN",
+	},
+	TerraformMappings: []*sdp.TerraformMapping{
+		{TerraformQueryMap: "aws_vpclattice_target_group.id"},
+	},
+	PotentialLinks: []string{"ec2-vpc", "vpc-lattice-service", "ec2-instance", "ip", "lambda-function", "elbv2-load-balancer"},
+	Category:       sdp.AdapterCategory_ADAPTER_CATEGORY_NETWORK,
+})
@@ -0,0 +1,127 @@
+package adapters
+
+import (
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+func TestVPCLatticeTargetGroupItemMapper(t *testing.T) {
+	details := &VPCLatticeTargetGroupDetails{
+		TargetGroup: &vpclattice.GetTargetGroupOutput{
+			Arn: adapterhelpers.PtrString("arn:aws:vpc-lattice:eu-west-2:123456789012:targetgroup/tg-0a1b2c3d4e5f67890"),
+			Config: &types.TargetGroupConfig{
+				Port:          adapterhelpers.PtrInt32(8080),
+				Protocol:      types.TargetGroupProtocolHttp,
+				VpcIdentifier: adapterhelpers.PtrString("vpc-0a1b2c3d4e5f67890"),
+			},
+			Id:          adapterhelpers.PtrString("tg-0a1b2c3d4e5f67890"),
+			Name:        adapterhelpers.PtrString("billing"),
+			ServiceArns: []string{"arn:aws:vpc-lattice:eu-west-2:123456789012:service/svc-0a1b2c3d4e5f67890"},
+			Status:      types.TargetGroupStatusActive,
+			Type:        types.TargetGroupTypeInstance,
+		},
+		Targets: []types.TargetSummary{
+			{
+				Id:     adapterhelpers.PtrString("i-0a1b2c3d4e5f67890"),
+				Port:   adapterhelpers.PtrInt32(8080),
+				Status: types.TargetStatusHealthy,
+			},
+			{
+				Id:     adapterhelpers.PtrString("i-1b2c3d4e5f678901a"),
+				Port:   adapterhelpers.PtrInt32(8080),
+				Status: types.TargetStatusUnhealthy,
+			},
+		},
+	}
+
+	item, err := vpcLatticeTargetGroupItemMapper("", "123456789012.eu-west-2", details)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = item.Validate(); err != nil {
+		t.Error(err)
+	}
+
+	if item.GetHealth() != sdp.Health_HEALTH_WARNING {
+		t.Errorf("expected health to be WARNING, got %v", item.GetHealth())
+	}
+
+	tests := adapterhelpers.QueryTests{
+		{
+			ExpectedType:   "ec2-vpc",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "vpc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "vpc-lattice-service",
+			ExpectedMethod: sdp.QueryMethod_SEARCH,
+			ExpectedQuery:  "arn:aws:vpc-lattice:eu-west-2:123456789012:service/svc-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-0a1b2c3d4e5f67890",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+		{
+			ExpectedType:   "ec2-instance",
+			ExpectedMethod: sdp.QueryMethod_GET,
+			ExpectedQuery:  "i-1b2c3d4e5f678901a",
+			ExpectedScope:  "123456789012.eu-west-2",
+		},
+	}
+
+	tests.Execute(t, item)
+}
+
+func TestVPCLatticeTargetLink(t *testing.T) {
+	tests := []struct {
+		Type          types.TargetGroupType
+		ID            string
+		ExpectedType  string
+		ExpectedScope string
+	}{
+		{types.TargetGroupTypeIp, "10.0.1.25", "ip", "global"},
+		{types.TargetGroupTypeLambda, "arn:aws:lambda:eu-west-2:123456789012:function:billing", "lambda-function", "123456789012.eu-west-2"},
+		{types.TargetGroupTypeAlb, "arn:aws:elasticloadbalancing:eu-west-2:123456789012:loadbalancer/app/billing/50dc6c495c0c9188", "elbv2-load-balancer", "123456789012.eu-west-2"},
+	}
+
+	for _, test := range tests {
+		link := vpcLatticeTargetLink(test.Type, test.ID, "123456789012.eu-west-2")
+
+		if link == nil {
+			t.Fatalf("expected a link for %v target %v", test.Type, test.ID)
+		}
+
+		if link.GetQuery().GetType() != test.ExpectedType {
+			t.Errorf("expected type %v, got %v", test.ExpectedType, link.GetQuery().GetType())
+		}
+
+		if link.GetQuery().GetScope() != test.ExpectedScope {
+			t.Errorf("expected scope %v, got %v", test.ExpectedScope, link.GetQuery().GetScope())
+		}
+	}
+}
+
+func TestNewVPCLatticeTargetGroupAdapter(t *testing.T) {
+	client, account, region := vpclatticeGetAutoConfig(t)
+
+	adapter := NewVPCLatticeTargetGroupAdapter(client, account, region)
+
+	test := adapterhelpers.E2ETest{
+		Adapter: adapter,
+		Timeout: 10 * time.Second,
+	}
+
+	test.Run(t)
+}
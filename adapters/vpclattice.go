@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice/types"
+	"github.com/micahhausler/aws-iam-policy/policy"
+
+	"github.com/overmindtech/aws-source/adapterhelpers"
+	"github.com/overmindtech/sdp-go"
+)
+
+type VPCLatticeClient interface {
+	GetAuthPolicy(ctx context.Context, params *vpclattice.GetAuthPolicyInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetAuthPolicyOutput, error)
+	GetListener(ctx context.Context, params *vpclattice.GetListenerInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetListenerOutput, error)
+	GetRule(ctx context.Context, params *vpclattice.GetRuleInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetRuleOutput, error)
+	GetService(ctx context.Context, params *vpclattice.GetServiceInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetServiceOutput, error)
+	GetServiceNetwork(ctx context.Context, params *vpclattice.GetServiceNetworkInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetServiceNetworkOutput, error)
+	GetServiceNetworkVpcAssociation(ctx context.Context, params *vpclattice.GetServiceNetworkVpcAssociationInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error)
+	GetTargetGroup(ctx context.Context, params *vpclattice.GetTargetGroupInput, optFns ...func(*vpclattice.Options)) (*vpclattice.GetTargetGroupOutput, error)
+	ListTagsForResource(ctx context.Context, params *vpclattice.ListTagsForResourceInput, optFns ...func(*vpclattice.Options)) (*vpclattice.ListTagsForResourceOutput, error)
+
+	vpclattice.ListListenersAPIClient
+	vpclattice.ListRulesAPIClient
+	vpclattice.ListServiceNetworkServiceAssociationsAPIClient
+	vpclattice.ListServiceNetworkVpcAssociationsAPIClient
+	vpclattice.ListServiceNetworksAPIClient
+	vpclattice.ListServicesAPIClient
+	vpclattice.ListTargetGroupsAPIClient
+	vpclattice.ListTargetsAPIClient
+}
+
+func vpcLatticeListTags(ctx context.Context, client VPCLatticeClient, arn *string) (map[string]string, error) {
+	if arn == nil {
+		return nil, nil
+	}
+
+	out, err := client.ListTagsForResource(ctx, &vpclattice.ListTagsForResourceInput{
+		ResourceArn: arn,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Tags, nil
+}
+
+// vpcLatticeAuthPolicy Gets the parsed auth policy for a service or service
+// network. Returns nil if IAM auth isn't enabled, or if there is no policy or
+// it can't be parsed, since the resource is still valid without one
+func vpcLatticeAuthPolicy(ctx context.Context, client VPCLatticeClient, authType types.AuthType, resourceIdentifier *string) *policy.Policy {
+	if authType != types.AuthTypeAwsIam || resourceIdentifier == nil {
+		return nil
+	}
+
+	out, err := client.GetAuthPolicy(ctx, &vpclattice.GetAuthPolicyInput{
+		ResourceIdentifier: resourceIdentifier,
+	})
+
+	if err != nil || out.Policy == nil {
+		return nil
+	}
+
+	document, err := ParsePolicyDocument(*out.Policy)
+
+	if err != nil {
+		return nil
+	}
+
+	return document
+}
+
+// vpcLatticeStatusHealth Converts the lifecycle status that services and
+// target groups share into a health. Returns nil for unknown statuses
+func vpcLatticeStatusHealth(status string) *sdp.Health {
+	switch status {
+	case "ACTIVE":
+		return sdp.Health_HEALTH_OK.Enum()
+	case "CREATE_IN_PROGRESS", "UPDATE_IN_PROGRESS", "DELETE_IN_PROGRESS":
+		return sdp.Health_HEALTH_PENDING.Enum()
+	case "CREATE_FAILED", "UPDATE_FAILED", "DELETE_FAILED":
+		return sdp.Health_HEALTH_ERROR.Enum()
+	}
+
+	return nil
+}
+
+// vpcLatticeIdentifierLink Links to a VPC Lattice resource that another
+// resource refers to by either its ID or ARN. IDs are looked up in the
+// current scope, whereas ARNs can refer to resources that have been shared
+// from other accounts
+func vpcLatticeIdentifierLink(itemType, identifier, scope string, propagation *sdp.BlastPropagation) *sdp.LinkedItemQuery {
+	query := &sdp.Query{
+		Type:   itemType,
+		Method: sdp.QueryMethod_GET,
+		Query:  identifier,
+		Scope:  scope,
+	}
+
+	if a, err := adapterhelpers.ParseARN(identifier); err == nil {
+		query.Method = sdp.QueryMethod_SEARCH
+		query.Scope = adapterhelpers.FormatScope(a.AccountID, a.Region)
+	}
+
+	return &sdp.LinkedItemQuery{
+		Query:            query,
+		BlastPropagation: propagation,
+	}
+}
+
+// vpcLatticeRuleActionLinks Links to the target groups that a listener or rule
+// forwards requests to. Fixed responses don't link to anything
+func vpcLatticeRuleActionLinks(action types.RuleAction, scope string) []*sdp.LinkedItemQuery {
+	links := make([]*sdp.LinkedItemQuery, 0)
+
+	forward, ok := action.(*types.RuleActionMemberForward)
+
+	if !ok {
+		return links
+	}
+
+	for _, targetGroup := range forward.Value.TargetGroups {
+		if targetGroup.TargetGroupIdentifier == nil {
+			continue
+		}
+
+		links = append(links, vpcLatticeIdentifierLink("vpc-lattice-target-group", *targetGroup.TargetGroupIdentifier, scope, &sdp.BlastPropagation{
+			// Requests fail if the target group can't serve them
+			In: true,
+			// Changing the routing changes the traffic that the target group
+			// receives
+			Out: true,
+		}))
+	}
+
+	return links
+}
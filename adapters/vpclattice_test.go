@@ -0,0 +1,99 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/vpclattice"
+	"github.com/overmindtech/aws-source/adapterhelpers"
+)
+
+type VPCLatticeTestClient struct {
+	GetAuthPolicyOutput                         *vpclattice.GetAuthPolicyOutput
+	GetListenerOutput                           *vpclattice.GetListenerOutput
+	GetRuleOutput                               *vpclattice.GetRuleOutput
+	GetServiceOutput                            *vpclattice.GetServiceOutput
+	GetServiceNetworkOutput                     *vpclattice.GetServiceNetworkOutput
+	GetServiceNetworkVpcAssociationOutput       *vpclattice.GetServiceNetworkVpcAssociationOutput
+	GetTargetGroupOutput                        *vpclattice.GetTargetGroupOutput
+	ListTagsForResourceOutput                   *vpclattice.ListTagsForResourceOutput
+	ListListenersOutput                         *vpclattice.ListListenersOutput
+	ListRulesOutput                             *vpclattice.ListRulesOutput
+	ListServiceNetworkServiceAssociationsOutput *vpclattice.ListServiceNetworkServiceAssociationsOutput
+	ListServiceNetworkVpcAssociationsOutput     *vpclattice.ListServiceNetworkVpcAssociationsOutput
+	ListServiceNetworksOutput                   *vpclattice.ListServiceNetworksOutput
+	ListServicesOutput                          *vpclattice.ListServicesOutput
+	ListTargetGroupsOutput                      *vpclattice.ListTargetGroupsOutput
+	ListTargetsOutput                           *vpclattice.ListTargetsOutput
+}
+
+func (t VPCLatticeTestClient) GetAuthPolicy(context.Context, *vpclattice.GetAuthPolicyInput, ...func(*vpclattice.Options)) (*vpclattice.GetAuthPolicyOutput, error) {
+	return t.GetAuthPolicyOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetListener(context.Context, *vpclattice.GetListenerInput, ...func(*vpclattice.Options)) (*vpclattice.GetListenerOutput, error) {
+	return t.GetListenerOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetRule(context.Context, *vpclattice.GetRuleInput, ...func(*vpclattice.Options)) (*vpclattice.GetRuleOutput, error) {
+	return t.GetRuleOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetService(context.Context, *vpclattice.GetServiceInput, ...func(*vpclattice.Options)) (*vpclattice.GetServiceOutput, error) {
+	return t.GetServiceOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetServiceNetwork(context.Context, *vpclattice.GetServiceNetworkInput, ...func(*vpclattice.Options)) (*vpclattice.GetServiceNetworkOutput, error) {
+	return t.GetServiceNetworkOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetServiceNetworkVpcAssociation(context.Context, *vpclattice.GetServiceNetworkVpcAssociationInput, ...func(*vpclattice.Options)) (*vpclattice.GetServiceNetworkVpcAssociationOutput, error) {
+	return t.GetServiceNetworkVpcAssociationOutput, nil
+}
+
+func (t VPCLatticeTestClient) GetTargetGroup(context.Context, *vpclattice.GetTargetGroupInput, ...func(*vpclattice.Options)) (*vpclattice.GetTargetGroupOutput, error) {
+	return t.GetTargetGroupOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListTagsForResource(context.Context, *vpclattice.ListTagsForResourceInput, ...func(*vpclattice.Options)) (*vpclattice.ListTagsForResourceOutput, error) {
+	return t.ListTagsForResourceOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListListeners(context.Context, *vpclattice.ListListenersInput, ...func(*vpclattice.Options)) (*vpclattice.ListListenersOutput, error) {
+	return t.ListListenersOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListRules(context.Context, *vpclattice.ListRulesInput, ...func(*vpclattice.Options)) (*vpclattice.ListRulesOutput, error) {
+	return t.ListRulesOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListServiceNetworkServiceAssociations(context.Context, *vpclattice.ListServiceNetworkServiceAssociationsInput, ...func(*vpclattice.Options)) (*vpclattice.ListServiceNetworkServiceAssociationsOutput, error) {
+	return t.ListServiceNetworkServiceAssociationsOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListServiceNetworkVpcAssociations(context.Context, *vpclattice.ListServiceNetworkVpcAssociationsInput, ...func(*vpclattice.Options)) (*vpclattice.ListServiceNetworkVpcAssociationsOutput, error) {
+	return t.ListServiceNetworkVpcAssociationsOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListServiceNetworks(context.Context, *vpclattice.ListServiceNetworksInput, ...func(*vpclattice.Options)) (*vpclattice.ListServiceNetworksOutput, error) {
+	return t.ListServiceNetworksOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListServices(context.Context, *vpclattice.ListServicesInput, ...func(*vpclattice.Options)) (*vpclattice.ListServicesOutput, error) {
+	return t.ListServicesOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListTargetGroups(context.Context, *vpclattice.ListTargetGroupsInput, ...func(*vpclattice.Options)) (*vpclattice.ListTargetGroupsOutput, error) {
+	return t.ListTargetGroupsOutput, nil
+}
+
+func (t VPCLatticeTestClient) ListTargets(context.Context, *vpclattice.ListTargetsInput, ...func(*vpclattice.Options)) (*vpclattice.ListTargetsOutput, error) {
+	return t.ListTargetsOutput, nil
+}
+
+func vpclatticeGetAutoConfig(t *testing.T) (*vpclattice.Client, string, string) {
+	config, account, region := adapterhelpers.GetAutoConfig(t)
+	client := vpclattice.NewFromConfig(config)
+
+	return client, account, region
+}
@@ -8,34 +8,65 @@ toolchain go1.23.5
 require (
 	github.com/MrAlias/otel-schema-utils v0.2.1-alpha
 	github.com/aws/aws-sdk-go v1.55.6
-	github.com/aws/aws-sdk-go-v2 v1.33.0
+	github.com/aws/aws-sdk-go-v2 v1.36.3
 	github.com/aws/aws-sdk-go-v2/config v1.29.0
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.53
 	github.com/aws/aws-sdk-go-v2/service/apigateway v1.28.6
+	github.com/aws/aws-sdk-go-v2/service/apprunner v1.33.0
+	github.com/aws/aws-sdk-go-v2/service/appsync v1.46.0
+	github.com/aws/aws-sdk-go-v2/service/athena v1.51.0
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.51.6
+	github.com/aws/aws-sdk-go-v2/service/batch v1.52.4
 	github.com/aws/aws-sdk-go-v2/service/cloudfront v1.44.4
+	github.com/aws/aws-sdk-go-v2/service/cloudhsmv2 v1.30.2
+	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.47.4
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.43.8
+	github.com/aws/aws-sdk-go-v2/service/codebuild v1.58.0
+	github.com/aws/aws-sdk-go-v2/service/codedeploy v1.30.4
+	github.com/aws/aws-sdk-go-v2/service/codepipeline v1.41.0
 	github.com/aws/aws-sdk-go-v2/service/directconnect v1.30.6
 	github.com/aws/aws-sdk-go-v2/service/dynamodb v1.39.4
+	github.com/aws/aws-sdk-go-v2/service/dynamodbstreams v1.25.4
 	github.com/aws/aws-sdk-go-v2/service/ec2 v1.199.2
+	github.com/aws/aws-sdk-go-v2/service/ecr v1.44.0
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.53.7
 	github.com/aws/aws-sdk-go-v2/service/efs v1.34.4
 	github.com/aws/aws-sdk-go-v2/service/eks v1.56.4
+	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing v1.28.11
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.43.6
+	github.com/aws/aws-sdk-go-v2/service/emr v1.48.0
+	github.com/aws/aws-sdk-go-v2/service/emrserverless v1.28.0
+	github.com/aws/aws-sdk-go-v2/service/fsx v1.54.0
+	github.com/aws/aws-sdk-go-v2/service/globalaccelerator v1.30.2
+	github.com/aws/aws-sdk-go-v2/service/glue v1.113.0
+	github.com/aws/aws-sdk-go-v2/service/guardduty v1.54.4
 	github.com/aws/aws-sdk-go-v2/service/iam v1.38.6
+	github.com/aws/aws-sdk-go-v2/service/inspector2 v1.37.0
+	github.com/aws/aws-sdk-go-v2/service/kafka v1.39.3
+	github.com/aws/aws-sdk-go-v2/service/kafkaconnect v1.23.3
 	github.com/aws/aws-sdk-go-v2/service/kms v1.37.12
 	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.6
 	github.com/aws/aws-sdk-go-v2/service/networkfirewall v1.44.9
 	github.com/aws/aws-sdk-go-v2/service/networkmanager v1.32.5
+	github.com/aws/aws-sdk-go-v2/service/organizations v1.38.0
 	github.com/aws/aws-sdk-go-v2/service/rds v1.93.6
 	github.com/aws/aws-sdk-go-v2/service/route53 v1.48.1
+	github.com/aws/aws-sdk-go-v2/service/route53resolver v1.35.2
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.73.1
+	github.com/aws/aws-sdk-go-v2/service/s3control v1.52.6
+	github.com/aws/aws-sdk-go-v2/service/sagemaker v1.195.0
+	github.com/aws/aws-sdk-go-v2/service/securityhub v1.57.4
+	github.com/aws/aws-sdk-go-v2/service/ses v1.30.0
+	github.com/aws/aws-sdk-go-v2/service/signer v1.27.2
 	github.com/aws/aws-sdk-go-v2/service/sns v1.33.12
 	github.com/aws/aws-sdk-go-v2/service/sqs v1.37.8
 	github.com/aws/aws-sdk-go-v2/service/ssm v1.56.6
+	github.com/aws/aws-sdk-go-v2/service/ssmincidents v1.35.2
 	github.com/aws/aws-sdk-go-v2/service/sts v1.33.8
-	github.com/aws/smithy-go v1.22.1
+	github.com/aws/aws-sdk-go-v2/service/transfer v1.60.1
+	github.com/aws/aws-sdk-go-v2/service/vpclattice v1.14.2
+	github.com/aws/smithy-go v1.22.2
 	github.com/getsentry/sentry-go v0.31.1
 	github.com/micahhausler/aws-iam-policy v0.4.2
 	github.com/overmindtech/discovery v0.33.4
@@ -64,8 +95,8 @@ require (
 	github.com/auth0/go-jwt-middleware/v2 v2.2.2 // indirect
 	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.24 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.28 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.28 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.34 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.34 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.1 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.28 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.1 // indirect
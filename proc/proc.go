@@ -11,27 +11,58 @@ import (
 	"time"
 
 	awsapigateway "github.com/aws/aws-sdk-go-v2/service/apigateway"
+	awsapprunner "github.com/aws/aws-sdk-go-v2/service/apprunner"
+	awsappsync "github.com/aws/aws-sdk-go-v2/service/appsync"
+	awsathena "github.com/aws/aws-sdk-go-v2/service/athena"
 	awsautoscaling "github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awsbatch "github.com/aws/aws-sdk-go-v2/service/batch"
 	awscloudfront "github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	awscloudhsmv2 "github.com/aws/aws-sdk-go-v2/service/cloudhsmv2"
+	awscloudtrail "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
 	awscloudwatch "github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	awscodebuild "github.com/aws/aws-sdk-go-v2/service/codebuild"
+	awscodedeploy "github.com/aws/aws-sdk-go-v2/service/codedeploy"
+	awscodepipeline "github.com/aws/aws-sdk-go-v2/service/codepipeline"
 	awsdirectconnect "github.com/aws/aws-sdk-go-v2/service/directconnect"
 	awsdynamodb "github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awsdynamodbstreams "github.com/aws/aws-sdk-go-v2/service/dynamodbstreams"
 	awsec2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awsecr "github.com/aws/aws-sdk-go-v2/service/ecr"
 	awsecs "github.com/aws/aws-sdk-go-v2/service/ecs"
 	awsefs "github.com/aws/aws-sdk-go-v2/service/efs"
 	awseks "github.com/aws/aws-sdk-go-v2/service/eks"
+	awselasticbeanstalk "github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk"
 	awselasticloadbalancing "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancing"
 	awselasticloadbalancingv2 "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awsemr "github.com/aws/aws-sdk-go-v2/service/emr"
+	awsemrserverless "github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	awsfsx "github.com/aws/aws-sdk-go-v2/service/fsx"
+	awsglobalaccelerator "github.com/aws/aws-sdk-go-v2/service/globalaccelerator"
+	awsglue "github.com/aws/aws-sdk-go-v2/service/glue"
+	awsguardduty "github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	awsinspector2 "github.com/aws/aws-sdk-go-v2/service/inspector2"
+	awskafka "github.com/aws/aws-sdk-go-v2/service/kafka"
+	awskafkaconnect "github.com/aws/aws-sdk-go-v2/service/kafkaconnect"
 	awskms "github.com/aws/aws-sdk-go-v2/service/kms"
 	awslambda "github.com/aws/aws-sdk-go-v2/service/lambda"
 	awsnetworkfirewall "github.com/aws/aws-sdk-go-v2/service/networkfirewall"
 	awsnetworkmanager "github.com/aws/aws-sdk-go-v2/service/networkmanager"
+	awsorganizations "github.com/aws/aws-sdk-go-v2/service/organizations"
 	awsrds "github.com/aws/aws-sdk-go-v2/service/rds"
 	awsroute53 "github.com/aws/aws-sdk-go-v2/service/route53"
+	awsroute53resolver "github.com/aws/aws-sdk-go-v2/service/route53resolver"
+	awss3control "github.com/aws/aws-sdk-go-v2/service/s3control"
+	awssagemaker "github.com/aws/aws-sdk-go-v2/service/sagemaker"
+	awssecurityhub "github.com/aws/aws-sdk-go-v2/service/securityhub"
+	awsses "github.com/aws/aws-sdk-go-v2/service/ses"
+	awssigner "github.com/aws/aws-sdk-go-v2/service/signer"
 	awssns "github.com/aws/aws-sdk-go-v2/service/sns"
 	awssqs "github.com/aws/aws-sdk-go-v2/service/sqs"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	awsssmincidents "github.com/aws/aws-sdk-go-v2/service/ssmincidents"
+	awstransfer "github.com/aws/aws-sdk-go-v2/service/transfer"
+	awsvpclattice "github.com/aws/aws-sdk-go-v2/service/vpclattice"
 	"github.com/cenkalti/backoff/v4"
 	"github.com/sourcegraph/conc/pool"
 
@@ -219,6 +250,10 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 	}
 
 	var globalDone atomic.Bool
+
+	// Global resources such as S3 buckets and IAM roles can have findings
+	// in any region, so they are counted across all of them
+	accountFindingsCounter := &adapters.AccountFindingsCounter{}
 	var b backoff.BackOff
 	b = backoff.NewExponentialBackOff(
 		backoff.WithMaxInterval(30*time.Second),
@@ -265,36 +300,90 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 					autoscalingClient := awsautoscaling.NewFromConfig(cfg, func(o *awsautoscaling.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					batchClient := awsbatch.NewFromConfig(cfg, func(o *awsbatch.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					cloudfrontClient := awscloudfront.NewFromConfig(cfg, func(o *awscloudfront.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					cloudhsmv2Client := awscloudhsmv2.NewFromConfig(cfg, func(o *awscloudhsmv2.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					cloudtrailClient := awscloudtrail.NewFromConfig(cfg, func(o *awscloudtrail.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					cloudwatchClient := awscloudwatch.NewFromConfig(cfg, func(o *awscloudwatch.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					codebuildClient := awscodebuild.NewFromConfig(cfg, func(o *awscodebuild.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					codedeployClient := awscodedeploy.NewFromConfig(cfg, func(o *awscodedeploy.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					codepipelineClient := awscodepipeline.NewFromConfig(cfg, func(o *awscodepipeline.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					directconnectClient := awsdirectconnect.NewFromConfig(cfg, func(o *awsdirectconnect.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
 					dynamodbClient := awsdynamodb.NewFromConfig(cfg, func(o *awsdynamodb.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					dynamodbstreamsClient := awsdynamodbstreams.NewFromConfig(cfg, func(o *awsdynamodbstreams.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					ec2Client := awsec2.NewFromConfig(cfg, func(o *awsec2.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					ecrClient := awsecr.NewFromConfig(cfg, func(o *awsecr.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					ecsClient := awsecs.NewFromConfig(cfg, func(o *awsecs.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
 					efsClient := awsefs.NewFromConfig(cfg, func(o *awsefs.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					fsxClient := awsfsx.NewFromConfig(cfg, func(o *awsfsx.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					eksClient := awseks.NewFromConfig(cfg, func(o *awseks.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					elasticbeanstalkClient := awselasticbeanstalk.NewFromConfig(cfg, func(o *awselasticbeanstalk.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					elbClient := awselasticloadbalancing.NewFromConfig(cfg, func(o *awselasticloadbalancing.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
 					elbv2Client := awselasticloadbalancingv2.NewFromConfig(cfg, func(o *awselasticloadbalancingv2.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					emrClient := awsemr.NewFromConfig(cfg, func(o *awsemr.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					emrserverlessClient := awsemrserverless.NewFromConfig(cfg, func(o *awsemrserverless.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					glueClient := awsglue.NewFromConfig(cfg, func(o *awsglue.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					athenaClient := awsathena.NewFromConfig(cfg, func(o *awsathena.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					apprunnerClient := awsapprunner.NewFromConfig(cfg, func(o *awsapprunner.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					appsyncClient := awsappsync.NewFromConfig(cfg, func(o *awsappsync.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					kafkaClient := awskafka.NewFromConfig(cfg, func(o *awskafka.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					kafkaconnectClient := awskafkaconnect.NewFromConfig(cfg, func(o *awskafkaconnect.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					lambdaClient := awslambda.NewFromConfig(cfg, func(o *awslambda.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
@@ -304,6 +393,15 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 					rdsClient := awsrds.NewFromConfig(cfg, func(o *awsrds.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					sagemakerClient := awssagemaker.NewFromConfig(cfg, func(o *awssagemaker.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					sesClient := awsses.NewFromConfig(cfg, func(o *awsses.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					signerClient := awssigner.NewFromConfig(cfg, func(o *awssigner.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					snsClient := awssns.NewFromConfig(cfg, func(o *awssns.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
@@ -313,6 +411,9 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 					route53Client := awsroute53.NewFromConfig(cfg, func(o *awsroute53.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					route53resolverClient := awsroute53resolver.NewFromConfig(cfg, func(o *awsroute53resolver.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
 					networkmanagerClient := awsnetworkmanager.NewFromConfig(cfg, func(o *awsnetworkmanager.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
@@ -321,6 +422,26 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						// Increase this from the default of 3 since IAM as such low rate limits
 						o.RetryMaxAttempts = 5
 					})
+					organizationsClient := awsorganizations.NewFromConfig(cfg, func(o *awsorganizations.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					globalacceleratorClient := awsglobalaccelerator.NewFromConfig(cfg, func(o *awsglobalaccelerator.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+						// The Global Accelerator API is only available in
+						// us-west-2, regardless of where the accelerators route
+						// traffic to
+						o.Region = "us-west-2"
+					})
+					s3controlClient := awss3control.NewFromConfig(cfg, func(o *awss3control.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					s3controlMultiRegionClient := awss3control.NewFromConfig(cfg, func(o *awss3control.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+						// Requests for Multi-Region Access Points have to be
+						// routed to us-west-2, regardless of which regions the
+						// buckets are in
+						o.Region = "us-west-2"
+					})
 					kmsClient := awskms.NewFromConfig(cfg, func(o *awskms.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
@@ -330,22 +451,52 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 					ssmClient := ssm.NewFromConfig(cfg, func(o *ssm.Options) {
 						o.RetryMode = aws.RetryModeAdaptive
 					})
+					ssmincidentsClient := awsssmincidents.NewFromConfig(cfg, func(o *awsssmincidents.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					transferClient := awstransfer.NewFromConfig(cfg, func(o *awstransfer.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					vpclatticeClient := awsvpclattice.NewFromConfig(cfg, func(o *awsvpclattice.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					guarddutyClient := awsguardduty.NewFromConfig(cfg, func(o *awsguardduty.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					securityhubClient := awssecurityhub.NewFromConfig(cfg, func(o *awssecurityhub.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+					inspector2Client := awsinspector2.NewFromConfig(cfg, func(o *awsinspector2.Options) {
+						o.RetryMode = aws.RetryModeAdaptive
+					})
+
+					// Counts the open Security Hub and Inspector findings in this
+					// region so that they can be added to the items they affect
+					findingsCounter := &adapters.FindingsCounter{
+						SecurityHub: securityhubClient,
+						Inspector:   inspector2Client,
+						Region:      cfg.Region,
+					}
+					accountFindingsCounter.Add(cfg.Region, findingsCounter)
 
 					configuredAdapters := []discovery.Adapter{
 						// EC2
 						adapters.NewEC2AddressAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2CapacityReservationFleetAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2CapacityReservationAdapter(ec2Client, *callerID.Account, cfg.Region),
+						adapters.NewEC2DhcpOptionsAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2EgressOnlyInternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
+						adapters.NewEC2FlowLogAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2IamInstanceProfileAssociationAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2ImageAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2InstanceEventWindowAdapter(ec2Client, *callerID.Account, cfg.Region),
-						adapters.NewEC2InstanceAdapter(ec2Client, *callerID.Account, cfg.Region),
+						adapters.NewFindingsAdapter(adapters.NewEC2InstanceAdapter(ec2Client, *callerID.Account, cfg.Region), findingsCounter),
 						adapters.NewEC2InstanceStatusAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2InternetGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2KeyPairAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2LaunchTemplateAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2LaunchTemplateVersionAdapter(ec2Client, *callerID.Account, cfg.Region),
+						adapters.NewEC2ManagedPrefixListAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2NatGatewayAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2NetworkAclAdapter(ec2Client, *callerID.Account, cfg.Region),
 						adapters.NewEC2NetworkInterfacePermissionAdapter(ec2Client, *callerID.Account, cfg.Region),
@@ -370,24 +521,84 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewEFSMountTargetAdapter(efsClient, *callerID.Account, cfg.Region),
 						adapters.NewEFSReplicationConfigurationAdapter(efsClient, *callerID.Account, cfg.Region),
 
+						// FSx
+						adapters.NewFSxBackupAdapter(fsxClient, *callerID.Account, cfg.Region),
+						adapters.NewFSxFileSystemAdapter(fsxClient, *callerID.Account, cfg.Region),
+						adapters.NewFSxStorageVirtualMachineAdapter(fsxClient, *callerID.Account, cfg.Region),
+						adapters.NewFSxVolumeAdapter(fsxClient, *callerID.Account, cfg.Region),
+
+						// MSK
+						adapters.NewKafkaClusterAdapter(kafkaClient, *callerID.Account, cfg.Region),
+						adapters.NewKafkaConfigurationAdapter(kafkaClient, *callerID.Account, cfg.Region),
+						adapters.NewKafkaConnectConnectorAdapter(kafkaconnectClient, *callerID.Account, cfg.Region),
+
+						// Glue
+						adapters.NewGlueConnectionAdapter(glueClient, *callerID.Account, cfg.Region),
+						adapters.NewGlueCrawlerAdapter(glueClient, *callerID.Account, cfg.Region),
+						adapters.NewGlueDatabaseAdapter(glueClient, *callerID.Account, cfg.Region),
+						adapters.NewGlueJobAdapter(glueClient, *callerID.Account, cfg.Region),
+						adapters.NewGlueTableAdapter(glueClient, *callerID.Account, cfg.Region),
+						adapters.NewGlueTriggerAdapter(glueClient, *callerID.Account, cfg.Region),
+
+						// Athena
+						adapters.NewAthenaWorkgroupAdapter(athenaClient, *callerID.Account, cfg.Region),
+
 						// EKS
+						adapters.NewEKSAccessEntryAdapter(eksClient, *callerID.Account, cfg.Region),
 						adapters.NewEKSAddonAdapter(eksClient, *callerID.Account, cfg.Region),
 						adapters.NewEKSClusterAdapter(eksClient, *callerID.Account, cfg.Region),
 						adapters.NewEKSFargateProfileAdapter(eksClient, *callerID.Account, cfg.Region),
+						adapters.NewEKSIdentityProviderConfigAdapter(eksClient, *callerID.Account, cfg.Region),
 						adapters.NewEKSNodegroupAdapter(eksClient, *callerID.Account, cfg.Region),
+						adapters.NewEKSPodIdentityAssociationAdapter(eksClient, *callerID.Account, cfg.Region),
+
+						// Elastic Beanstalk
+						adapters.NewElasticBeanstalkApplicationAdapter(elasticbeanstalkClient, *callerID.Account, cfg.Region),
+						adapters.NewElasticBeanstalkApplicationVersionAdapter(elasticbeanstalkClient, *callerID.Account, cfg.Region),
+						adapters.NewElasticBeanstalkEnvironmentAdapter(elasticbeanstalkClient, *callerID.Account, cfg.Region),
 
 						// Route 53
 						adapters.NewRoute53HealthCheckAdapter(route53Client, *callerID.Account, cfg.Region),
 						adapters.NewRoute53HostedZoneAdapter(route53Client, *callerID.Account, cfg.Region),
 						adapters.NewRoute53ResourceRecordSetAdapter(route53Client, *callerID.Account, cfg.Region),
 
+						// Route 53 Resolver
+						adapters.NewRoute53ResolverEndpointAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+						adapters.NewRoute53ResolverFirewallRuleGroupAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+						adapters.NewRoute53ResolverFirewallRuleGroupAssociationAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+						adapters.NewRoute53ResolverQueryLogConfigAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+						adapters.NewRoute53ResolverRuleAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+						adapters.NewRoute53ResolverRuleAssociationAdapter(route53resolverClient, *callerID.Account, cfg.Region),
+
 						// Cloudwatch
 						adapters.NewCloudwatchAlarmAdapter(cloudwatchClient, *callerID.Account, cfg.Region),
 
+						// CloudTrail
+						adapters.NewCloudTrailTrailAdapter(cloudtrailClient, *callerID.Account, cfg.Region),
+						adapters.NewCloudTrailEventDataStoreAdapter(cloudtrailClient, *callerID.Account, cfg.Region),
+
+						// CodeBuild
+						adapters.NewCodeBuildProjectAdapter(codebuildClient, *callerID.Account, cfg.Region),
+
+						// CodeDeploy
+						adapters.NewCodeDeployApplicationAdapter(codedeployClient, *callerID.Account, cfg.Region),
+						adapters.NewCodeDeployDeploymentGroupAdapter(codedeployClient, *callerID.Account, cfg.Region),
+
+						// CodePipeline
+						adapters.NewCodePipelinePipelineAdapter(codepipelineClient, *callerID.Account, cfg.Region),
+
 						// Lambda
-						adapters.NewLambdaFunctionAdapter(lambdaClient, *callerID.Account, cfg.Region),
+						adapters.NewLambdaAliasAdapter(lambdaClient, *callerID.Account, cfg.Region),
+						adapters.NewLambdaCodeSigningConfigAdapter(lambdaClient, *callerID.Account, cfg.Region),
+						adapters.NewLambdaEventSourceMappingAdapter(lambdaClient, *callerID.Account, cfg.Region),
+						adapters.NewFindingsAdapter(adapters.NewLambdaFunctionAdapter(lambdaClient, *callerID.Account, cfg.Region), findingsCounter),
+						adapters.NewLambdaFunctionURLAdapter(lambdaClient, *callerID.Account, cfg.Region),
 						adapters.NewLambdaLayerAdapter(lambdaClient, *callerID.Account, cfg.Region),
 						adapters.NewLambdaLayerVersionAdapter(lambdaClient, *callerID.Account, cfg.Region),
+						adapters.NewLambdaVersionAdapter(lambdaClient, *callerID.Account, cfg.Region),
+
+						// ECR
+						adapters.NewFindingsAdapter(adapters.NewECRImageAdapter(ecrClient, *callerID.Account, cfg.Region), findingsCounter),
 
 						// ECS
 						adapters.NewECSCapacityProviderAdapter(ecsClient, *callerID.Account, cfg.Region),
@@ -397,9 +608,36 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewECSTaskDefinitionAdapter(ecsClient, *callerID.Account, cfg.Region),
 						adapters.NewECSTaskAdapter(ecsClient, *callerID.Account, cfg.Region),
 
+						// App Runner
+						adapters.NewAppRunnerAutoScalingConfigurationAdapter(apprunnerClient, *callerID.Account, cfg.Region),
+						adapters.NewAppRunnerServiceAdapter(apprunnerClient, *callerID.Account, cfg.Region),
+						adapters.NewAppRunnerVpcConnectorAdapter(apprunnerClient, *callerID.Account, cfg.Region),
+
+						// AppSync
+						adapters.NewAppSyncDataSourceAdapter(appsyncClient, *callerID.Account, cfg.Region),
+						adapters.NewAppSyncDomainNameAdapter(appsyncClient, *callerID.Account, cfg.Region),
+						adapters.NewAppSyncFunctionAdapter(appsyncClient, *callerID.Account, cfg.Region),
+						adapters.NewAppSyncGraphqlAPIAdapter(appsyncClient, *callerID.Account, cfg.Region),
+						adapters.NewAppSyncResolverAdapter(appsyncClient, *callerID.Account, cfg.Region),
+
+						// EMR
+						adapters.NewEMRClusterAdapter(emrClient, *callerID.Account, cfg.Region),
+						adapters.NewEMRInstanceFleetAdapter(emrClient, *callerID.Account, cfg.Region),
+						adapters.NewEMRInstanceGroupAdapter(emrClient, *callerID.Account, cfg.Region),
+						adapters.NewEMRSecurityConfigurationAdapter(emrClient, *callerID.Account, cfg.Region),
+						adapters.NewEMRServerlessApplicationAdapter(emrserverlessClient, *callerID.Account, cfg.Region),
+
+						// Batch
+						adapters.NewBatchComputeEnvironmentAdapter(batchClient, *callerID.Account, cfg.Region),
+						adapters.NewBatchJobDefinitionAdapter(batchClient, *callerID.Account, cfg.Region),
+						adapters.NewBatchJobQueueAdapter(batchClient, *callerID.Account, cfg.Region),
+
 						// DynamoDB
 						adapters.NewDynamoDBBackupAdapter(dynamodbClient, *callerID.Account, cfg.Region),
 						adapters.NewDynamoDBTableAdapter(dynamodbClient, *callerID.Account, cfg.Region),
+						adapters.NewDynamoDBTableReplicaAdapter(dynamodbClient, *callerID.Account, cfg.Region),
+						adapters.NewDynamoDBExportAdapter(dynamodbClient, *callerID.Account, cfg.Region),
+						adapters.NewDynamoDBStreamAdapter(dynamodbstreamsClient, *callerID.Account, cfg.Region),
 
 						// RDS
 						adapters.NewRDSDBClusterParameterGroupAdapter(rdsClient, *callerID.Account, cfg.Region),
@@ -429,6 +667,17 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewNetworkFirewallRuleGroupAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
 						adapters.NewNetworkFirewallTLSInspectionConfigurationAdapter(networkfirewallClient, *callerID.Account, cfg.Region),
 
+						// VPC Lattice
+						adapters.NewVPCLatticeListenerAdapter(vpclatticeClient, *callerID.Account, cfg.Region),
+						adapters.NewVPCLatticeServiceAdapter(vpclatticeClient, *callerID.Account, cfg.Region),
+						adapters.NewVPCLatticeServiceNetworkAdapter(vpclatticeClient, *callerID.Account, cfg.Region),
+						adapters.NewVPCLatticeTargetGroupAdapter(vpclatticeClient, *callerID.Account, cfg.Region),
+
+						// Transfer Family
+						adapters.NewTransferConnectorAdapter(transferClient, *callerID.Account, cfg.Region),
+						adapters.NewTransferServerAdapter(transferClient, *callerID.Account, cfg.Region),
+						adapters.NewTransferUserAdapter(transferClient, *callerID.Account, cfg.Region),
+
 						// Direct Connect
 						adapters.NewDirectConnectGatewayAdapter(directconnectClient, *callerID.Account, cfg.Region),
 						adapters.NewDirectConnectGatewayAssociationAdapter(directconnectClient, *callerID.Account, cfg.Region),
@@ -458,9 +707,25 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewNetworkManagerTransitGatewayRouteTableAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
 						adapters.NewNetworkManagerVPCAttachmentAdapter(networkmanagerClient, *callerID.Account, cfg.Region),
 
+						// S3 Control
+						adapters.NewS3AccessPointAdapter(s3controlClient, *callerID.Account, cfg.Region),
+						adapters.NewS3ObjectLambdaAccessPointAdapter(s3controlClient, *callerID.Account, cfg.Region),
+
 						// SQS
 						adapters.NewSQSQueueAdapter(sqsClient, *callerID.Account, cfg.Region),
 
+						// SageMaker
+						adapters.NewSageMakerDomainAdapter(sagemakerClient, *callerID.Account, cfg.Region),
+						adapters.NewSageMakerEndpointAdapter(sagemakerClient, *callerID.Account, cfg.Region),
+						adapters.NewSageMakerEndpointConfigAdapter(sagemakerClient, *callerID.Account, cfg.Region),
+						adapters.NewSageMakerModelAdapter(sagemakerClient, *callerID.Account, cfg.Region),
+						adapters.NewSageMakerNotebookInstanceAdapter(sagemakerClient, *callerID.Account, cfg.Region),
+
+						// SES
+						adapters.NewSESConfigurationSetAdapter(sesClient, *callerID.Account, cfg.Region),
+						adapters.NewSESIdentityAdapter(sesClient, *callerID.Account, cfg.Region),
+						adapters.NewSESReceiptRuleSetAdapter(sesClient, *callerID.Account, cfg.Region),
+
 						// SNS
 						adapters.NewSNSSubscriptionAdapter(snsClient, *callerID.Account, cfg.Region),
 						adapters.NewSNSTopicAdapter(snsClient, *callerID.Account, cfg.Region),
@@ -468,6 +733,10 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewSNSEndpointAdapter(snsClient, *callerID.Account, cfg.Region),
 						adapters.NewSNSDataProtectionPolicyAdapter(snsClient, *callerID.Account, cfg.Region),
 
+						// Signer
+						adapters.NewSignerSigningJobAdapter(signerClient, *callerID.Account, cfg.Region),
+						adapters.NewSignerSigningProfileAdapter(signerClient, *callerID.Account, cfg.Region),
+
 						// KMS
 						adapters.NewKMSKeyAdapter(kmsClient, *callerID.Account, cfg.Region),
 						adapters.NewKMSCustomKeyStoreAdapter(kmsClient, *callerID.Account, cfg.Region),
@@ -475,6 +744,9 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 						adapters.NewKMSGrantAdapter(kmsClient, *callerID.Account, cfg.Region),
 						adapters.NewKMSKeyPolicyAdapter(kmsClient, *callerID.Account, cfg.Region),
 
+						// CloudHSM
+						adapters.NewCloudHSMV2ClusterAdapter(cloudhsmv2Client, *callerID.Account, cfg.Region),
+
 						// ApiGateway
 						adapters.NewAPIGatewayRestApiAdapter(apigatewayClient, *callerID.Account, cfg.Region),
 						adapters.NewAPIGatewayResourceAdapter(apigatewayClient, *callerID.Account, cfg.Region),
@@ -484,6 +756,20 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 
 						// SSM
 						adapters.NewSSMParameterAdapter(ssmClient, *callerID.Account, cfg.Region),
+						adapters.NewSSMDocumentAdapter(ssmClient, *callerID.Account, cfg.Region),
+						adapters.NewSSMAssociationAdapter(ssmClient, *callerID.Account, cfg.Region),
+						adapters.NewSSMManagedInstanceAdapter(ssmClient, *callerID.Account, cfg.Region),
+						adapters.NewSSMMaintenanceWindowAdapter(ssmClient, *callerID.Account, cfg.Region),
+						adapters.NewSSMOpsItemAdapter(ssmClient, *callerID.Account, cfg.Region),
+
+						// Incident Manager
+						adapters.NewSSMIncidentsResponsePlanAdapter(ssmincidentsClient, *callerID.Account, cfg.Region),
+
+						// GuardDuty
+						adapters.NewGuardDutyDetectorAdapter(guarddutyClient, *callerID.Account, cfg.Region),
+
+						// Security Hub
+						adapters.NewSecurityHubHubAdapter(securityhubClient, *callerID.Account, cfg.Region),
 					}
 
 					err = e.AddAdapters(configuredAdapters...)
@@ -510,7 +796,8 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 							adapters.NewCloudfrontStreamingDistributionAdapter(cloudfrontClient, *callerID.Account),
 
 							// S3
-							adapters.NewS3Adapter(cfg, *callerID.Account),
+							adapters.NewFindingsAdapter(adapters.NewS3Adapter(cfg, *callerID.Account), accountFindingsCounter),
+							adapters.NewS3MultiRegionAccessPointAdapter(s3controlMultiRegionClient, *callerID.Account),
 
 							// Networkmanager
 							adapters.NewNetworkManagerGlobalNetworkAdapter(networkmanagerClient, *callerID.Account),
@@ -524,12 +811,38 @@ func InitializeAwsSourceEngine(ctx context.Context, ec *discovery.EngineConfig,
 							adapters.NewIAMPolicyAdapter(iamClient, *callerID.Account),
 							adapters.NewIAMGroupAdapter(iamClient, *callerID.Account),
 							adapters.NewIAMInstanceProfileAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMRoleAdapter(iamClient, *callerID.Account),
-							adapters.NewIAMUserAdapter(iamClient, *callerID.Account),
+							adapters.NewFindingsAdapter(adapters.NewIAMRoleAdapter(iamClient, *callerID.Account), accountFindingsCounter),
+							adapters.NewFindingsAdapter(adapters.NewIAMUserAdapter(iamClient, *callerID.Account), accountFindingsCounter),
+
+							// Global Accelerator
+							adapters.NewGlobalAcceleratorAcceleratorAdapter(globalacceleratorClient, *callerID.Account),
+							adapters.NewGlobalAcceleratorListenerAdapter(globalacceleratorClient, *callerID.Account),
+							adapters.NewGlobalAcceleratorEndpointGroupAdapter(globalacceleratorClient, *callerID.Account),
 						)
 						if err != nil {
 							return err
 						}
+
+						// Organizations can only be queried from the
+						// management account, in member accounts every query
+						// fails with AccessDenied
+						org, err := organizationsClient.DescribeOrganization(configCtx, &awsorganizations.DescribeOrganizationInput{})
+						if err != nil {
+							log.WithError(err).WithFields(log.Fields{
+								"region": cfg.Region,
+							}).Info("Not adding Organizations adapters, could not describe the organization")
+						} else if org.Organization != nil && aws.ToString(org.Organization.MasterAccountId) == *callerID.Account {
+							err = e.AddAdapters(
+								adapters.NewOrganizationsAccountAdapter(organizationsClient, *callerID.Account),
+								adapters.NewOrganizationsDelegatedAdministratorAdapter(organizationsClient, *callerID.Account),
+								adapters.NewOrganizationsOrganizationalUnitAdapter(organizationsClient, *callerID.Account),
+								adapters.NewOrganizationsPolicyAdapter(organizationsClient, *callerID.Account),
+								adapters.NewOrganizationsRootAdapter(organizationsClient, *callerID.Account),
+							)
+							if err != nil {
+								return err
+							}
+						}
 					}
 					return nil
 				})